@@ -255,6 +255,7 @@ var (
 		string(rewardsmoduletypes.ProviderRewardsDistributionPool):       {authtypes.Burner, authtypes.Staking},
 		string(rewardsmoduletypes.ProvidersRewardsAllocationPool):        {authtypes.Minter, authtypes.Staking},
 		dualstakingmoduletypes.ModuleName:                                {authtypes.Burner, authtypes.Staking},
+		conflictmoduletypes.ModuleName:                                   {authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -634,6 +635,7 @@ func New(
 		app.EpochstorageKeeper,
 		app.SpecKeeper,
 		app.StakingKeeper,
+		app.DualstakingKeeper,
 	)
 	conflictModule := conflictmodule.NewAppModule(appCodec, app.ConflictKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -46,6 +46,9 @@ func CreateLavaVisorInitCobraCommand() *cobra.Command {
 	cmdLavavisorInit.Flags().Bool("auto-download", false, "Automatically download missing binaries")
 	cmdLavavisorInit.Flags().Bool("auto-start", false, "Executes start cmd automatically after init is completed")
 	cmdLavavisorInit.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	addVerificationFlags(cmdLavavisorInit)
+	// used by --auto-start, the started services are supervised by systemd
+	cmdLavavisorInit.Flags().Duration(RollbackGracePeriodFlag, processmanager.DefaultRollbackGracePeriod, "roll back to the previous binary if the process fails within this period after an upgrade, 0 disables")
 
 	return cmdLavavisorInit
 }
@@ -88,12 +91,23 @@ func LavavisorInit(cmd *cobra.Command) error {
 	}
 	utils.LavaFormatInfo("[Lavavisor] Initializing the environment", utils.Attribute{Key: "Version", Value: protocolConsensusVersion.Version.ProviderMin})
 
+	upgradeOptions, err := getUpgradeOptions(cmd)
+	if err != nil {
+		return err
+	}
+	fetcher := upgradeOptions.BinaryFetcher(lavavisorFetcher.LavavisorPath(), lavavisorFetcher)
+
 	// fetcher returns binaryPath (according to selected min or target version)
-	binaryPath, err := lavavisorFetcher.FetchProtocolBinary(protocolConsensusVersion.Version)
+	binaryPath, err := fetcher.FetchProtocolBinary(protocolConsensusVersion.Version)
 	if err != nil {
 		return utils.LavaFormatError("[Lavavisor] Protocol binary couldn't be fetched", nil)
 	}
 
+	err = upgradeOptions.Verifier.Verify(binaryPath)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] Protocol binary verification failed", err)
+	}
+
 	// linker
 	binaryLinker := processmanager.ProtocolBinaryLinker{Fetcher: lavavisorFetcher}
 	err = binaryLinker.CreateLink(binaryPath)
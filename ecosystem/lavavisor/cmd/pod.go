@@ -40,6 +40,9 @@ provider example:
 	cmdLavavisorPod.Flags().String(flags.FlagChainID, app.Name, "network chain id")
 	cmdLavavisorPod.Flags().String("cmd", "", "the command to execute")
 	cmdLavavisorPod.MarkFlagRequired("cmd")
+	addVerificationFlags(cmdLavavisorPod)
+	cmdLavavisorPod.Flags().Duration(RollbackGracePeriodFlag, processmanager.DefaultRollbackGracePeriod, "roll back to the previous binary if the process fails within this period after an upgrade, 0 disables")
+	addSupervisionFlags(cmdLavavisorPod)
 	return cmdLavavisorPod
 }
 
@@ -66,12 +69,21 @@ func LavavisorPod(cmd *cobra.Command) error {
 		utils.LavaFormatFatal("failed to create tx factory", err)
 	}
 
+	upgradeOptions, err := getUpgradeOptions(cmd)
+	if err != nil {
+		return err
+	}
+	supervisionOptions, err := getSupervisionOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	lavavisor := LavaVisor{}
-	err = lavavisor.PodStart(ctx, txFactory, clientCtx, runCommand, dir, keyRingPassword)
+	err = lavavisor.PodStart(ctx, txFactory, clientCtx, runCommand, dir, keyRingPassword, upgradeOptions, supervisionOptions)
 	return err
 }
 
-func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, runCommand string, lavavisorDir string, keyRingPassword *processmanager.KeyRingPassword) (err error) {
+func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, runCommand string, lavavisorDir string, keyRingPassword *processmanager.KeyRingPassword, upgradeOptions processmanager.UpgradeOptions, supervisionOptions processmanager.SupervisionOptions) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -100,7 +112,7 @@ func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientC
 	if err != nil {
 		return err
 	}
-	binaryFetcher.FetchProtocolBinary(version.Version)
+	upgradeOptions.BinaryFetcher(lavavisorPath, &binaryFetcher).FetchProtocolBinary(version.Version)
 	// Select most recent version set by init command (in the range of min-target version)
 	selectedVersion, _ := SelectMostRecentVersionFromDir(lavavisorPath, version.Version)
 	if err != nil {
@@ -110,7 +122,7 @@ func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientC
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitorProcessPodFlow(selectedVersion, lavavisorPath, runCommand)
+	versionMonitor := processmanager.NewVersionMonitorProcessPodFlow(selectedVersion, lavavisorPath, runCommand, upgradeOptions)
 
 	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
 
@@ -121,7 +133,7 @@ func (lv *LavaVisor) PodStart(ctx context.Context, txFactory tx.Factory, clientC
 		}
 	}()
 
-	versionMonitor.StartProcess(keyRingPassword)
+	versionMonitor.StartProcess(keyRingPassword, supervisionOptions)
 
 	// tear down
 	select {
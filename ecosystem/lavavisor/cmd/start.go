@@ -38,10 +38,11 @@ type LavaVisor struct {
 }
 
 type Config struct {
-	Services []string `yaml:"services"`
+	Services     []string `yaml:"services"`
+	BinarySource string   `yaml:"binary-source"` // a directory or an http(s) mirror of the protocol binaries, the flag takes precedence
 }
 
-func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, services []string) (err error) {
+func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, services []string, upgradeOptions processmanager.UpgradeOptions) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -73,7 +74,7 @@ func (lv *LavaVisor) Start(ctx context.Context, txFactory tx.Factory, clientCtx
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitor(selectedVersion, lavavisorPath, services, autoDownload)
+	versionMonitor := processmanager.NewVersionMonitor(selectedVersion, lavavisorPath, services, autoDownload, upgradeOptions)
 
 	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
 
@@ -129,6 +130,8 @@ func CreateLavaVisorStartCobraCommand() *cobra.Command {
 	cmdLavavisorStart.Flags().String("directory", os.ExpandEnv("~/"), "Protocol Flags Directory")
 	cmdLavavisorStart.Flags().Bool("auto-download", false, "Automatically download missing binaries")
 	cmdLavavisorStart.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	addVerificationFlags(cmdLavavisorStart)
+	cmdLavavisorStart.Flags().Duration(RollbackGracePeriodFlag, processmanager.DefaultRollbackGracePeriod, "roll back to the previous binary if the services fail within this period after an upgrade, 0 disables")
 	return cmdLavavisorStart
 }
 
@@ -178,9 +181,17 @@ func LavavisorStart(cmd *cobra.Command) error {
 		return utils.LavaFormatError("[Lavavisor] directory does not exist", nil, utils.Attribute{Key: "lavavisorServicesDir", Value: lavavisorServicesDir})
 	}
 
+	upgradeOptions, err := getUpgradeOptions(cmd)
+	if err != nil {
+		return err
+	}
+	if upgradeOptions.BinarySource == "" {
+		upgradeOptions.BinarySource = config.BinarySource
+	}
+
 	// Start lavavisor version monitor process
 	lavavisor := LavaVisor{}
-	err = lavavisor.Start(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, config.Services)
+	err = lavavisor.Start(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, config.Services, upgradeOptions)
 	return err
 }
 
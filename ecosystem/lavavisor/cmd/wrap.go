@@ -12,13 +12,26 @@ import (
 	processmanager "github.com/lavanet/lava/ecosystem/lavavisor/pkg/process"
 	lvstatetracker "github.com/lavanet/lava/ecosystem/lavavisor/pkg/state"
 	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
-const KeyRingPasswordFlag = "enter-keyring-password"
+const (
+	KeyRingPasswordFlag     = "enter-keyring-password"
+	VerifyBinaryFlag        = "verify-binary"
+	ChecksumManifestUrlFlag = "checksum-manifest-url"
+	SignaturePublicKeyFlag  = "signature-public-key"
+	RollbackGracePeriodFlag = "rollback-grace-period"
+	BinarySourceFlag        = "binary-source"
+	HealthProbeUrlFlag      = "health-probe-url"
+	HealthProbeIntervalFlag = "health-probe-interval"
+	HealthProbeFailuresFlag = "health-probe-failures"
+	StatusListenAddressFlag = "status-listen-address"
+	DrainTimeoutFlag        = "drain-timeout"
+)
 
 func CreateLavaVisorWrapCobraCommand() *cobra.Command {
 	cmdLavavisorWrap := &cobra.Command{
@@ -44,9 +57,82 @@ provider example:
 	cmdLavavisorWrap.Flags().String(flags.FlagChainID, app.Name, "network chain id")
 	cmdLavavisorWrap.Flags().String("cmd", "", "the command to execute")
 	cmdLavavisorWrap.MarkFlagRequired("cmd")
+	addVerificationFlags(cmdLavavisorWrap)
+	cmdLavavisorWrap.Flags().Duration(RollbackGracePeriodFlag, processmanager.DefaultRollbackGracePeriod, "roll back to the previous binary if the process fails within this period after an upgrade, 0 disables")
+	addSupervisionFlags(cmdLavavisorWrap)
 	return cmdLavavisorWrap
 }
 
+// addSupervisionFlags configure the supervision of the single lavap process of wrap and pod, the services started by
+// init --auto-start and start are restarted by systemd and aren't probed or listed in the status
+func addSupervisionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(HealthProbeUrlFlag, "", "url probed to check the process is healthy, e.g. http://127.0.0.1:3333"+common.DEFAULT_HEALTH_PATH+" or the metrics endpoint. the process is restarted when it fails consecutive probes")
+	cmd.Flags().Duration(HealthProbeIntervalFlag, processmanager.DefaultHealthProbeInterval, "interval between health probes")
+	cmd.Flags().Int(HealthProbeFailuresFlag, processmanager.DefaultHealthProbeFailures, "consecutive failed health probes before the process is restarted")
+	cmd.Flags().String(StatusListenAddressFlag, "", "address to serve the status of the wrapped process on /status, e.g. 127.0.0.1:7790. disabled if empty")
+	cmd.Flags().Duration(DrainTimeoutFlag, processmanager.DefaultDrainTimeout, "time the process gets to exit after SIGTERM before it is killed")
+}
+
+func getSupervisionOptions(cmd *cobra.Command) (processmanager.SupervisionOptions, error) {
+	options := processmanager.SupervisionOptions{}
+	var err error
+	if options.HealthProbeUrl, err = cmd.Flags().GetString(HealthProbeUrlFlag); err != nil {
+		return options, err
+	}
+	if options.HealthProbeInterval, err = cmd.Flags().GetDuration(HealthProbeIntervalFlag); err != nil {
+		return options, err
+	}
+	if options.HealthProbeFailures, err = cmd.Flags().GetInt(HealthProbeFailuresFlag); err != nil {
+		return options, err
+	}
+	if options.StatusListenAddress, err = cmd.Flags().GetString(StatusListenAddressFlag); err != nil {
+		return options, err
+	}
+	if options.DrainTimeout, err = cmd.Flags().GetDuration(DrainTimeoutFlag); err != nil {
+		return options, err
+	}
+	return options, nil
+}
+
+func addVerificationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(BinarySourceFlag, "", "a local directory or an http(s) mirror to fetch the protocol binaries from instead of github, with an optional "+processmanager.MirrorIndexFileName)
+	cmd.Flags().Bool(VerifyBinaryFlag, false, "verify the protocol binaries against a sha256 checksum manifest before linking them")
+	cmd.Flags().String(ChecksumManifestUrlFlag, processmanager.DefaultChecksumManifestUrl, "url of the checksum manifest, %s is replaced with the version. used when the version directory has no "+processmanager.ChecksumManifestFileName)
+	cmd.Flags().String(SignaturePublicKeyFlag, "", "PEM public key (ed25519, ecdsa or rsa) the manifest must be signed with, the signature is taken from "+processmanager.ChecksumManifestFileName+processmanager.ChecksumSignatureExtension)
+}
+
+func getBinaryVerifier(cmd *cobra.Command) (*processmanager.BinaryVerifier, error) {
+	enabled, err := cmd.Flags().GetBool(VerifyBinaryFlag)
+	if err != nil {
+		return nil, err
+	}
+	manifestUrl, err := cmd.Flags().GetString(ChecksumManifestUrlFlag)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyPath, err := cmd.Flags().GetString(SignaturePublicKeyFlag)
+	if err != nil {
+		return nil, err
+	}
+	return processmanager.NewBinaryVerifier(processmanager.BinaryVerificationConfig{Enabled: enabled, ManifestUrl: manifestUrl, PublicKeyPath: publicKeyPath})
+}
+
+func getUpgradeOptions(cmd *cobra.Command) (processmanager.UpgradeOptions, error) {
+	verifier, err := getBinaryVerifier(cmd)
+	if err != nil {
+		return processmanager.UpgradeOptions{}, err
+	}
+	rollbackGracePeriod, err := cmd.Flags().GetDuration(RollbackGracePeriodFlag)
+	if err != nil {
+		return processmanager.UpgradeOptions{}, err
+	}
+	binarySource, err := cmd.Flags().GetString(BinarySourceFlag)
+	if err != nil {
+		return processmanager.UpgradeOptions{}, err
+	}
+	return processmanager.UpgradeOptions{BinarySource: binarySource, Verifier: verifier, RollbackGracePeriod: rollbackGracePeriod}, nil
+}
+
 func getKeyringPassword(cmd *cobra.Command) *processmanager.KeyRingPassword {
 	password, err := cmd.Flags().GetBool(KeyRingPasswordFlag)
 	if err != nil {
@@ -103,12 +189,21 @@ func LavavisorWrap(cmd *cobra.Command) error {
 		return err
 	}
 
+	upgradeOptions, err := getUpgradeOptions(cmd)
+	if err != nil {
+		return err
+	}
+	supervisionOptions, err := getSupervisionOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	lavavisor := LavaVisor{}
-	err = lavavisor.Wrap(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, runCommand, keyRingPassword)
+	err = lavavisor.Wrap(ctx, txFactory, clientCtx, lavavisorPath, autoDownload, runCommand, keyRingPassword, upgradeOptions, supervisionOptions)
 	return err
 }
 
-func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, runCommand string, keyringPassword *processmanager.KeyRingPassword) (err error) {
+func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, lavavisorPath string, autoDownload bool, runCommand string, keyringPassword *processmanager.KeyRingPassword, upgradeOptions processmanager.UpgradeOptions, supervisionOptions processmanager.SupervisionOptions) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
@@ -140,7 +235,7 @@ func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx c
 	}
 
 	// Initialize version monitor with selected most recent version
-	versionMonitor := processmanager.NewVersionMonitorProcessWrapFlow(selectedVersion, lavavisorPath, autoDownload, runCommand)
+	versionMonitor := processmanager.NewVersionMonitorProcessWrapFlow(selectedVersion, lavavisorPath, autoDownload, runCommand, upgradeOptions)
 
 	lavavisorStateTracker.RegisterForVersionUpdates(ctx, version.Version, versionMonitor)
 
@@ -151,7 +246,7 @@ func (lv *LavaVisor) Wrap(ctx context.Context, txFactory tx.Factory, clientCtx c
 		}
 	}()
 
-	versionMonitor.StartProcess(keyringPassword)
+	versionMonitor.StartProcess(keyringPassword, supervisionOptions)
 
 	// tear down
 	select {
@@ -25,6 +25,10 @@ func (pbf *ProtocolBinaryFetcher) SetCurrentRunningVersion(currentVersion string
 	pbf.CurrentRunningVersion = currentVersion
 }
 
+func (pbf *ProtocolBinaryFetcher) LavavisorPath() string {
+	return pbf.lavavisorPath
+}
+
 func (pbf *ProtocolBinaryFetcher) SetupLavavisorDir(dir string) error {
 	lavavisorPath, err := pbf.buildLavavisorPath(dir)
 	if err != nil {
@@ -0,0 +1,197 @@
+package processmanager
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	lvutil "github.com/lavanet/lava/ecosystem/lavavisor/pkg/util"
+	"github.com/lavanet/lava/utils"
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	"gopkg.in/yaml.v2"
+)
+
+const MirrorIndexFileName = "index.yml"
+
+// MirrorIndex lists the binaries of a mirror, a version missing from the index is looked for in v<version>/lavap
+type MirrorIndex struct {
+	Versions []MirrorIndexEntry `yaml:"versions"`
+}
+
+type MirrorIndexEntry struct {
+	Version string `yaml:"version"`
+	Path    string `yaml:"path"`   // relative to the mirror root
+	Sha256  string `yaml:"sha256"` // optional, checked when copying the binary
+}
+
+// ProtocolBinaryFetcherFromMirror fetches the protocol binaries from a local directory or an internal http mirror,
+// for hosts that can't reach github
+type ProtocolBinaryFetcherFromMirror struct {
+	lavavisorPath         string
+	source                string          // a directory or an http(s) url
+	verifier              *BinaryVerifier // checks binaries without a checksum in the index before they are run
+	CurrentRunningVersion string
+}
+
+func NewProtocolBinaryFetcherFromMirror(lavavisorPath string, source string, verifier *BinaryVerifier) *ProtocolBinaryFetcherFromMirror {
+	return &ProtocolBinaryFetcherFromMirror{lavavisorPath: lavavisorPath, source: source, verifier: verifier}
+}
+
+func (pbf *ProtocolBinaryFetcherFromMirror) SetCurrentRunningVersion(currentVersion string) {
+	pbf.CurrentRunningVersion = currentVersion
+}
+
+func (pbf *ProtocolBinaryFetcherFromMirror) FetchProtocolBinary(protocolConsensusVersion *protocoltypes.Version) (selectedBinaryPath string, err error) {
+	if pbf.lavavisorPath == "" {
+		return "", utils.LavaFormatError("[Lavavisor] The lavavisor path is not initialized. Should not get here!", nil)
+	}
+
+	var currentRunningVersion *lvutil.SemanticVer
+	if pbf.CurrentRunningVersion != "" {
+		currentRunningVersion = lvutil.ParseToSemanticVersion(pbf.CurrentRunningVersion)
+	}
+	currentVersion := lvutil.ParseToSemanticVersion(protocolConsensusVersion.ProviderTarget)
+	minVersion := lvutil.ParseToSemanticVersion(protocolConsensusVersion.ProviderMin)
+
+	// the index is read once per fetch, a mirror without an index uses the default layout
+	index, err := pbf.readIndex()
+	if err != nil {
+		utils.LavaFormatInfo("[Lavavisor] Mirror has no index, using the v<version>/lavap layout", utils.Attribute{Key: "source", Value: pbf.source})
+		index = &MirrorIndex{}
+	}
+
+	for ; !lvutil.IsVersionLessThan(currentVersion, minVersion); lvutil.DecrementVersion(currentVersion) {
+		if currentRunningVersion != nil && (lvutil.IsVersionEqual(currentRunningVersion, currentVersion) || lvutil.IsVersionGreaterThan(currentRunningVersion, currentVersion)) {
+			return "", utils.LavaFormatError("[Lavavisor] Failed upgrading flow, couldn't fetch the new binary from the mirror.", nil)
+		}
+		version := lvutil.FormatFromSemanticVersion(currentVersion)
+		utils.LavaFormatInfo("[Lavavisor] Trying to fetch from mirror", utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "source", Value: pbf.source})
+		versionDir := filepath.Join(pbf.lavavisorPath, "upgrades", "v"+version)
+		binaryPath := filepath.Join(versionDir, "lavap")
+		if existingVersion, _ := GetBinaryVersion(binaryPath); existingVersion != "" {
+			utils.LavaFormatInfo("found requested version", utils.Attribute{Key: "version", Value: existingVersion})
+			return binaryPath, nil
+		}
+		err = pbf.copyFromMirror(index.entry(version), versionDir)
+		if err == nil {
+			utils.LavaFormatInfo("[Lavavisor] Protocol binary with target version has been successfully set! path: " + binaryPath)
+			return binaryPath, nil
+		}
+		utils.LavaFormatWarning("[Lavavisor] Failed fetching version from mirror", err, utils.Attribute{Key: "version", Value: version})
+	}
+	return "", utils.LavaFormatError("[Lavavisor] Failed to fetch protocol binary from the mirror for both target and min versions", nil)
+}
+
+func (index *MirrorIndex) entry(version string) MirrorIndexEntry {
+	for _, entry := range index.Versions {
+		if strings.TrimPrefix(entry.Version, "v") == version {
+			return entry
+		}
+	}
+	return MirrorIndexEntry{Version: version, Path: path.Join("v"+version, "lavap")}
+}
+
+func (pbf *ProtocolBinaryFetcherFromMirror) isRemote() bool {
+	return strings.HasPrefix(pbf.source, "http://") || strings.HasPrefix(pbf.source, "https://")
+}
+
+// open returns a reader of a file relative to the mirror root
+func (pbf *ProtocolBinaryFetcherFromMirror) open(relativePath string) (io.ReadCloser, error) {
+	if !pbf.isRemote() {
+		return os.Open(filepath.Join(pbf.source, filepath.FromSlash(relativePath)))
+	}
+	fileUrl, err := url.JoinPath(pbf.source, relativePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.Get(fileUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("bad HTTP status %s for %s", resp.Status, fileUrl)
+	}
+	return resp.Body, nil
+}
+
+func (pbf *ProtocolBinaryFetcherFromMirror) readIndex() (*MirrorIndex, error) {
+	reader, err := pbf.open(MirrorIndexFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	index := &MirrorIndex{}
+	err = yaml.Unmarshal(data, index)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed parsing mirror index", err, utils.Attribute{Key: "source", Value: pbf.source})
+	}
+	return index, nil
+}
+
+// copyFromMirror writes the binary to the version directory, it is moved into place only after it was fully copied and checked
+func (pbf *ProtocolBinaryFetcherFromMirror) copyFromMirror(entry MirrorIndexEntry, versionDir string) error {
+	reader, err := pbf.open(entry.Path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	err = os.MkdirAll(versionDir, 0o755)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] Failed creating directory", err, utils.Attribute{Key: "dir", Value: versionDir})
+	}
+	binaryPath := filepath.Join(versionDir, "lavap")
+	tempPath := binaryPath + ".download"
+	out, err := os.OpenFile(tempPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, reader)
+	closeErr := out.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return utils.LavaFormatError("[Lavavisor] failed copying binary from mirror", err, utils.Attribute{Key: "path", Value: entry.Path})
+	}
+
+	if entry.Sha256 != "" {
+		checksum, err := fileChecksum(tempPath)
+		if err != nil || checksum != strings.ToLower(entry.Sha256) {
+			os.Remove(tempPath)
+			return utils.LavaFormatError("[Lavavisor] mirror binary checksum mismatch", err,
+				utils.Attribute{Key: "path", Value: entry.Path},
+				utils.Attribute{Key: "expected", Value: entry.Sha256},
+				utils.Attribute{Key: "actual", Value: checksum},
+			)
+		}
+	} else {
+		// the binary is run to read its version, so it is checked against the manifest first
+		err = pbf.verifier.verifyFile(tempPath, versionDir, strings.TrimPrefix(entry.Version, "v"))
+		if err != nil {
+			os.Remove(tempPath)
+			return err
+		}
+	}
+	if version, _ := GetBinaryVersion(tempPath); version == "" {
+		os.Remove(tempPath)
+		return utils.LavaFormatError("[Lavavisor] mirror binary is not a valid executable", nil, utils.Attribute{Key: "path", Value: entry.Path})
+	}
+	err = os.Rename(tempPath, binaryPath)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] failed to move mirror binary", err)
+	}
+	utils.LavaFormatInfo("[Lavavisor] Fetched binary from mirror", utils.Attribute{Key: "path", Value: binaryPath})
+	return nil
+}
@@ -0,0 +1,99 @@
+package processmanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLavap returns a script printing the version, that touches marker when run
+func fakeLavap(version string, marker string) []byte {
+	return []byte("#!/bin/sh\ntouch " + marker + "\necho " + version + "\n")
+}
+
+func writeMirrorFile(t *testing.T, mirror string, relativePath string, content []byte) {
+	path := filepath.Join(mirror, filepath.FromSlash(relativePath))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, content, 0o755))
+}
+
+func sha256Hex(content []byte) string {
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:])
+}
+
+var mirrorTestVersion = &protocoltypes.Version{ProviderTarget: "1.2.3", ProviderMin: "1.2.2"}
+
+func TestMirrorFetcherDefaultLayout(t *testing.T) {
+	mirror := t.TempDir()
+	lavavisorPath := t.TempDir()
+	writeMirrorFile(t, mirror, "v1.2.3/lavap", fakeLavap("1.2.3", filepath.Join(t.TempDir(), "ran")))
+
+	fetcher := NewProtocolBinaryFetcherFromMirror(lavavisorPath, mirror, nil)
+	binaryPath, err := fetcher.FetchProtocolBinary(mirrorTestVersion)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(lavavisorPath, "upgrades", "v1.2.3", "lavap"), binaryPath)
+	version, err := GetBinaryVersion(binaryPath)
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", version)
+}
+
+func TestMirrorFetcherIndexOverHttp(t *testing.T) {
+	mirror := t.TempDir()
+	binary := fakeLavap("1.2.2", filepath.Join(t.TempDir(), "ran"))
+	writeMirrorFile(t, mirror, "binaries/lavap-1.2.2", binary)
+	// the target version has a corrupted binary, so the min version is fetched
+	writeMirrorFile(t, mirror, "binaries/lavap-1.2.3", []byte("corrupted"))
+	writeMirrorFile(t, mirror, MirrorIndexFileName, []byte("versions:\n"+
+		"  - version: v1.2.3\n    path: binaries/lavap-1.2.3\n    sha256: "+sha256Hex(fakeLavap("1.2.3", "ran"))+"\n"+
+		"  - version: v1.2.2\n    path: binaries/lavap-1.2.2\n    sha256: "+sha256Hex(binary)+"\n"))
+	server := httptest.NewServer(http.FileServer(http.Dir(mirror)))
+	defer server.Close()
+
+	lavavisorPath := t.TempDir()
+	fetcher := NewProtocolBinaryFetcherFromMirror(lavavisorPath, server.URL, nil)
+	binaryPath, err := fetcher.FetchProtocolBinary(mirrorTestVersion)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(lavavisorPath, "upgrades", "v1.2.2", "lavap"), binaryPath)
+
+	// the mismatching binary was not left behind
+	_, err = os.Stat(filepath.Join(lavavisorPath, "upgrades", "v1.2.3", "lavap"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(lavavisorPath, "upgrades", "v1.2.3", "lavap.download"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestMirrorFetcherVerifiesBeforeRunning(t *testing.T) {
+	mirror := t.TempDir()
+	lavavisorPath := t.TempDir()
+	marker := filepath.Join(t.TempDir(), "ran")
+	binary := fakeLavap("1.2.3", marker)
+	writeMirrorFile(t, mirror, "v1.2.3/lavap", binary)
+	versionDir := filepath.Join(lavavisorPath, "upgrades", "v1.2.3")
+	require.NoError(t, os.MkdirAll(versionDir, 0o755))
+	manifestPath := filepath.Join(versionDir, ChecksumManifestFileName)
+	require.NoError(t, os.WriteFile(manifestPath, []byte(sha256Hex([]byte("other"))+"  lavap\n"), 0o644))
+
+	verifier, err := NewBinaryVerifier(BinaryVerificationConfig{Enabled: true})
+	require.NoError(t, err)
+	fetcher := NewProtocolBinaryFetcherFromMirror(lavavisorPath, mirror, verifier)
+	_, err = fetcher.FetchProtocolBinary(mirrorTestVersion)
+	require.Error(t, err)
+	// the unverified binary was never run
+	_, err = os.Stat(marker)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, os.WriteFile(manifestPath, []byte(sha256Hex(binary)+"  lavap\n"), 0o644))
+	binaryPath, err := fetcher.FetchProtocolBinary(mirrorTestVersion)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(versionDir, "lavap"), binaryPath)
+	_, err = os.Stat(marker)
+	require.NoError(t, err)
+}
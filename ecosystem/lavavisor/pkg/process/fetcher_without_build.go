@@ -12,6 +12,11 @@ import (
 	protocoltypes "github.com/lavanet/lava/x/protocol/types"
 )
 
+// the name of the lavap binary in the github release assets
+func lavapReleaseAssetName(version string) string {
+	return fmt.Sprintf("lavap-v%s-linux-amd64", version)
+}
+
 type ProtocolBinaryFetcherWithoutBuild struct {
 	lavavisorPath         string
 	CurrentRunningVersion string
@@ -168,7 +173,7 @@ func (pbf *ProtocolBinaryFetcherWithoutBuild) downloadBinaryFromGithub(version,
 		return utils.LavaFormatError("[Lavavisor] failed to clean up binary directory", err)
 	}
 	// URL might need to be updated based on the actual GitHub repository
-	url := fmt.Sprintf("https://github.com/lavanet/lava/releases/download/v%s/lavap-v%s-linux-amd64", version, version)
+	url := fmt.Sprintf("https://github.com/lavanet/lava/releases/download/v%s/%s", version, lavapReleaseAssetName(version))
 	utils.LavaFormatInfo("[Lavavisor] Fetching the source from: ", utils.Attribute{Key: "URL", Value: url})
 	// Send the request
 	resp, err := http.Get(url)
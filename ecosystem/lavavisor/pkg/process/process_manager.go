@@ -47,6 +47,11 @@ func StartProcess(process string) error {
 	return nil
 }
 
+// IsProcessActive returns whether the systemd service is running
+func IsProcessActive(process string) bool {
+	return exec.Command("systemctl", "is-active", "--quiet", process).Run() == nil
+}
+
 func GetBinaryVersion(binaryPath string) (string, error) {
 	cmd := exec.Command(binaryPath, "version")
 	output, err := cmd.Output()
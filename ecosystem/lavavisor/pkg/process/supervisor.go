@@ -0,0 +1,142 @@
+package processmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	DefaultHealthProbeInterval = 30 * time.Second
+	DefaultHealthProbeFailures = 3
+	DefaultDrainTimeout        = 30 * time.Second
+	initialRestartBackoff      = time.Second
+	maxRestartBackoff          = 5 * time.Minute
+	// a process that ran this long before crashing is restarted without backoff
+	stableRunDuration = 10 * time.Minute
+	// the process isn't probed until it had time to start listening
+	healthProbeStartupGrace = time.Minute
+)
+
+// SupervisionOptions configure how the wrapped lavap process is kept running. Only the single process of the wrap and pod
+// flows is supervised, the services of the start flow are managed by systemd
+type SupervisionOptions struct {
+	HealthProbeUrl      string // probed with GET, a process failing HealthProbeFailures probes in a row is restarted. empty disables probing
+	HealthProbeInterval time.Duration
+	HealthProbeFailures int
+	StatusListenAddress string        // serves the status of the process on /status, empty disables it
+	DrainTimeout        time.Duration // how long a stopped process gets to exit after SIGTERM before it is killed
+}
+
+func (so SupervisionOptions) withDefaults() SupervisionOptions {
+	if so.HealthProbeInterval <= 0 {
+		so.HealthProbeInterval = DefaultHealthProbeInterval
+	}
+	if so.HealthProbeFailures <= 0 {
+		so.HealthProbeFailures = DefaultHealthProbeFailures
+	}
+	if so.DrainTimeout <= 0 {
+		so.DrainTimeout = DefaultDrainTimeout
+	}
+	return so
+}
+
+// restartBackoff returns how long to wait before restarting a process that crashed after running for ranFor,
+// and the backoff of the next crash
+func restartBackoff(backoff time.Duration, ranFor time.Duration) (delay time.Duration, next time.Duration) {
+	if ranFor > stableRunDuration {
+		backoff = initialRestartBackoff
+	}
+	next = backoff * 2
+	if next > maxRestartBackoff {
+		next = maxRestartBackoff
+	}
+	return backoff, next
+}
+
+// ProcessStatus is the state of a supervised process, as served by the status endpoint
+type ProcessStatus struct {
+	Command   string    `json:"command"`
+	Binary    string    `json:"binary"`
+	Version   string    `json:"version"`
+	Pid       int       `json:"pid"`
+	Running   bool      `json:"running"`
+	Healthy   bool      `json:"healthy"`
+	StartedAt time.Time `json:"started_at"`
+	Uptime    string    `json:"uptime"`
+	Restarts  uint64    `json:"restarts"`
+	LastExit  string    `json:"last_exit,omitempty"`
+}
+
+// Status returns the status of the supervised process, as a list so more processes can be added to it
+func (vm *VersionMonitor) Status() []ProcessStatus {
+	vm.processLock.Lock()
+	defer vm.processLock.Unlock()
+	status := vm.processStatus
+	if status.Running {
+		status.Uptime = time.Since(status.StartedAt).Round(time.Second).String()
+	}
+	status.Restarts = vm.crashes.Load()
+	return []ProcessStatus{status}
+}
+
+func (vm *VersionMonitor) serveStatus(listenAddress string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"processes": vm.Status()})
+	})
+	utils.LavaFormatInfo("[Lavavisor] serving processes status", utils.Attribute{Key: "address", Value: listenAddress})
+	err := http.ListenAndServe(listenAddress, mux)
+	if err != nil {
+		utils.LavaFormatError("[Lavavisor] status server failed", err, utils.Attribute{Key: "address", Value: listenAddress})
+	}
+}
+
+// probeHealth restarts the process when it fails consecutive health probes
+func (vm *VersionMonitor) probeHealth(options SupervisionOptions, stop <-chan struct{}) {
+	client := &http.Client{Timeout: options.HealthProbeInterval}
+	ticker := time.NewTicker(options.HealthProbeInterval)
+	defer ticker.Stop()
+	failures := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		vm.processLock.Lock()
+		running, startedAt, generation := vm.processStatus.Running, vm.processStatus.StartedAt, vm.subprocessGeneration.Load()
+		vm.processLock.Unlock()
+		if !running || time.Since(startedAt) < healthProbeStartupGrace {
+			failures = 0
+			continue
+		}
+		healthy := probe(client, options.HealthProbeUrl)
+		if healthy {
+			failures = 0
+		} else {
+			failures++
+			utils.LavaFormatWarning("[Lavavisor] Health probe failed", nil, utils.Attribute{Key: "url", Value: options.HealthProbeUrl}, utils.Attribute{Key: "failures", Value: failures})
+		}
+		vm.processLock.Lock()
+		vm.processStatus.Healthy = healthy
+		vm.processLock.Unlock()
+		if failures >= options.HealthProbeFailures {
+			failures = 0
+			utils.LavaFormatError("[Lavavisor] Subprocess is unhealthy, restarting it", nil, utils.Attribute{Key: "url", Value: options.HealthProbeUrl})
+			vm.killSubprocess(generation)
+		}
+	}
+}
+
+func probe(client *http.Client, url string) bool {
+	resp, err := client.Get(url)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
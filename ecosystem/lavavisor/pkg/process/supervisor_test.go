@@ -0,0 +1,135 @@
+package processmanager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	backoff := initialRestartBackoff
+	delays := []time.Duration{}
+	for i := 0; i < 12; i++ {
+		var delay time.Duration
+		delay, backoff = restartBackoff(backoff, time.Second)
+		delays = append(delays, delay)
+	}
+	require.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second,
+		64 * time.Second, 128 * time.Second, 256 * time.Second, maxRestartBackoff, maxRestartBackoff, maxRestartBackoff,
+	}, delays)
+
+	// a process that ran long enough before crashing is restarted right away
+	delay, backoff := restartBackoff(maxRestartBackoff, stableRunDuration+time.Second)
+	require.Equal(t, initialRestartBackoff, delay)
+	require.Equal(t, 2*initialRestartBackoff, backoff)
+}
+
+// newSupervisedMonitor returns a monitor of a fake lavap running script
+func newSupervisedMonitor(t *testing.T, script string, options SupervisionOptions) *VersionMonitor {
+	binaryPath := filepath.Join(t.TempDir(), "lavap")
+	content := "#!/bin/sh\nif [ \"$1\" = \"version\" ]; then echo 1.0.0; exit 0; fi\n" + script + "\n"
+	require.NoError(t, os.WriteFile(binaryPath, []byte(content), 0o755))
+	return &VersionMonitor{
+		BinaryPath:  binaryPath,
+		exited:      make(chan uint64, 10),
+		supervision: options.withDefaults(),
+	}
+}
+
+func waitRunning(t *testing.T, vm *VersionMonitor, running bool) {
+	require.Eventually(t, func() bool { return vm.Status()[0].Running == running }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStopSubprocessDrain(t *testing.T) {
+	// the process exits on SIGTERM
+	vm := newSupervisedMonitor(t, "trap 'exit 0' TERM\nwhile true; do sleep 0.1; done", SupervisionOptions{DrainTimeout: time.Minute})
+	go vm.startSubprocess(nil)
+	waitRunning(t, vm, true)
+	require.Equal(t, "1.0.0", vm.Status()[0].Version)
+	start := time.Now()
+	vm.StopSubprocess()
+	require.Less(t, time.Since(start), 10*time.Second)
+	waitRunning(t, vm, false)
+	require.Equal(t, "exited", vm.Status()[0].LastExit)
+	// a stopped process isn't a crash
+	require.Zero(t, vm.crashes.Load())
+	require.Empty(t, vm.exited)
+
+	// the process ignores SIGTERM, so it is killed after the drain timeout
+	drainTimeout := 300 * time.Millisecond
+	vm = newSupervisedMonitor(t, "trap '' TERM\nwhile true; do sleep 0.1; done", SupervisionOptions{DrainTimeout: drainTimeout})
+	go vm.startSubprocess(nil)
+	waitRunning(t, vm, true)
+	start = time.Now()
+	vm.StopSubprocess()
+	require.GreaterOrEqual(t, time.Since(start), drainTimeout)
+	waitRunning(t, vm, false)
+	require.Equal(t, "signal: killed", vm.Status()[0].LastExit)
+	require.Zero(t, vm.crashes.Load())
+}
+
+func TestCrashedSubprocessReported(t *testing.T) {
+	vm := newSupervisedMonitor(t, "exit 3", SupervisionOptions{})
+	vm.startSubprocess(nil)
+	select {
+	case generation := <-vm.exited:
+		require.Equal(t, uint64(1), generation)
+	case <-time.After(5 * time.Second):
+		t.Fatal("crash wasn't reported")
+	}
+	status := vm.Status()[0]
+	require.False(t, status.Running)
+	require.Equal(t, uint64(1), status.Restarts)
+	require.Equal(t, "exit status 3", status.LastExit)
+}
+
+func TestHealthProbeRestartsUnhealthySubprocess(t *testing.T) {
+	var unhealthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unhealthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	options := SupervisionOptions{HealthProbeUrl: server.URL, HealthProbeInterval: 20 * time.Millisecond, HealthProbeFailures: 2}
+	vm := newSupervisedMonitor(t, "exec sleep 60", options)
+	go vm.startSubprocess(nil)
+	waitRunning(t, vm, true)
+	defer vm.StopSubprocess()
+	stop := make(chan struct{})
+	defer close(stop)
+	go vm.probeHealth(vm.supervision, stop)
+
+	// within the startup grace period the process isn't probed
+	unhealthy.Store(true)
+	time.Sleep(10 * options.HealthProbeInterval)
+	require.Zero(t, vm.crashes.Load())
+
+	// past the grace period, a healthy process is left running
+	unhealthy.Store(false)
+	vm.processLock.Lock()
+	vm.processStatus.StartedAt = time.Now().Add(-2 * healthProbeStartupGrace)
+	vm.processLock.Unlock()
+	time.Sleep(10 * options.HealthProbeInterval)
+	require.Zero(t, vm.crashes.Load())
+	require.True(t, vm.Status()[0].Healthy)
+
+	// consecutive failed probes kill the process, and its exit is handled as a crash so it is restarted
+	unhealthy.Store(true)
+	select {
+	case generation := <-vm.exited:
+		require.Equal(t, uint64(1), generation)
+	case <-time.After(5 * time.Second):
+		t.Fatal("unhealthy process wasn't restarted")
+	}
+	require.Equal(t, uint64(1), vm.crashes.Load())
+	require.False(t, vm.Status()[0].Healthy)
+}
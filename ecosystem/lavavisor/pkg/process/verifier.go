@@ -0,0 +1,261 @@
+package processmanager
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	ChecksumManifestFileName   = "checksums.txt"
+	ChecksumSignatureExtension = ".sig"
+	// the checksums published with each release, %s is the version
+	DefaultChecksumManifestUrl = "https://github.com/lavanet/lava/releases/download/v%s/" + ChecksumManifestFileName
+)
+
+type BinaryVerificationConfig struct {
+	Enabled       bool
+	ManifestUrl   string // a url template of the manifest with the version as %s, used when the version directory has no manifest
+	PublicKeyPath string // a PEM public key, when set the manifest must have a valid detached signature
+}
+
+// BinaryVerifier checks protocol binaries against a SHA-256 manifest (sha256sum format) before they are linked,
+// so a corrupted or tampered binary is never run. The manifest is taken from the version directory, where it can be
+// placed for binaries built from source, or downloaded from the configured url. A downloaded manifest is kept in the
+// version directory only once its signature is verified.
+type BinaryVerifier struct {
+	config    BinaryVerificationConfig
+	publicKey crypto.PublicKey
+}
+
+// NewBinaryVerifier returns nil if verification is disabled
+func NewBinaryVerifier(config BinaryVerificationConfig) (*BinaryVerifier, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	verifier := &BinaryVerifier{config: config}
+	if config.PublicKeyPath != "" {
+		publicKey, err := loadPublicKey(config.PublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		verifier.publicKey = publicKey
+	}
+	utils.LavaFormatInfo("[Lavavisor] protocol binary verification enabled",
+		utils.Attribute{Key: "manifestUrl", Value: config.ManifestUrl},
+		utils.Attribute{Key: "publicKey", Value: config.PublicKeyPath},
+	)
+	return verifier, nil
+}
+
+func loadPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed reading signature public key", err, utils.Attribute{Key: "path", Value: path})
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, utils.LavaFormatError("[Lavavisor] signature public key is not PEM encoded", nil, utils.Attribute{Key: "path", Value: path})
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed parsing signature public key", err, utils.Attribute{Key: "path", Value: path})
+	}
+	switch publicKey.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, utils.LavaFormatError("[Lavavisor] unsupported signature public key type", nil, utils.Attribute{Key: "type", Value: fmt.Sprintf("%T", publicKey)})
+	}
+}
+
+// Verify checks a binary of the upgrades directory (upgrades/v<version>/lavap) against its version's manifest
+func (bv *BinaryVerifier) Verify(binaryPath string) error {
+	versionDir := filepath.Dir(binaryPath)
+	version := strings.TrimPrefix(filepath.Base(versionDir), "v")
+	return bv.verifyFile(binaryPath, versionDir, version)
+}
+
+// verifyFile checks a file holding the lavap binary of the version, it doesn't have to be in its final path
+func (bv *BinaryVerifier) verifyFile(binaryPath string, versionDir string, version string) error {
+	if bv == nil {
+		return nil
+	}
+	manifest, err := bv.getManifest(versionDir, version)
+	if err != nil {
+		return err
+	}
+	if bv.publicKey != nil {
+		signature, err := bv.getSignature(versionDir, version)
+		if err != nil {
+			return err
+		}
+		err = verifySignature(bv.publicKey, manifest.data, signature.data)
+		if err != nil {
+			return utils.LavaFormatError("[Lavavisor] checksum manifest signature verification failed", err, utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "source", Value: manifest.source})
+		}
+		// only downloads with a valid signature are kept, an unsigned download is fetched again on every check
+		// so a corrupted or intercepted one isn't trusted on later runs
+		err = manifest.save()
+		if err == nil {
+			err = signature.save()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	utils.LavaFormatInfo("[Lavavisor] using checksum manifest", utils.Attribute{Key: "version", Value: version}, utils.Attribute{Key: "source", Value: manifest.source}, utils.Attribute{Key: "signed", Value: bv.publicKey != nil})
+	expected, err := findChecksum(manifest.data, []string{"lavap", lavapReleaseAssetName(version)})
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] binary checksum verification failed", err, utils.Attribute{Key: "version", Value: version})
+	}
+	actual, err := fileChecksum(binaryPath)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] failed hashing binary", err, utils.Attribute{Key: "path", Value: binaryPath})
+	}
+	if actual != expected {
+		return utils.LavaFormatError("[Lavavisor] binary checksum mismatch", nil,
+			utils.Attribute{Key: "path", Value: binaryPath},
+			utils.Attribute{Key: "expected", Value: expected},
+			utils.Attribute{Key: "actual", Value: actual},
+		)
+	}
+	utils.LavaFormatInfo("[Lavavisor] binary checksum verified", utils.Attribute{Key: "path", Value: binaryPath}, utils.Attribute{Key: "sha256", Value: actual})
+	return nil
+}
+
+func (bv *BinaryVerifier) getManifest(versionDir string, version string) (*verificationFile, error) {
+	return bv.getVerificationFile(filepath.Join(versionDir, ChecksumManifestFileName), bv.config.ManifestUrl, version)
+}
+
+func (bv *BinaryVerifier) getSignature(versionDir string, version string) (*verificationFile, error) {
+	url := bv.config.ManifestUrl
+	if url != "" {
+		url += ChecksumSignatureExtension
+	}
+	return bv.getVerificationFile(filepath.Join(versionDir, ChecksumManifestFileName+ChecksumSignatureExtension), url, version)
+}
+
+// verificationFile is a manifest or signature read from the version directory, or downloaded when it is missing there
+type verificationFile struct {
+	data    []byte
+	path    string
+	source  string // the path or url the file was taken from
+	fetched bool
+}
+
+// save writes a downloaded file to the version directory, where it is used on later checks instead of downloading it again
+func (vf *verificationFile) save() error {
+	if !vf.fetched {
+		return nil
+	}
+	err := os.WriteFile(vf.path, vf.data, 0o644)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] failed saving verification file", err, utils.Attribute{Key: "path", Value: vf.path})
+	}
+	vf.fetched = false
+	return nil
+}
+
+// getVerificationFile reads the file from the version directory, or downloads it if it is missing there
+func (bv *BinaryVerifier) getVerificationFile(path string, urlTemplate string, version string) (*verificationFile, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return &verificationFile{data: data, path: path, source: path}, nil
+	}
+	if !os.IsNotExist(err) || urlTemplate == "" {
+		return nil, utils.LavaFormatError("[Lavavisor] failed reading verification file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	url := urlTemplate
+	if strings.Contains(url, "%s") {
+		url = fmt.Sprintf(urlTemplate, version)
+	}
+	utils.LavaFormatInfo("[Lavavisor] Fetching verification file", utils.Attribute{Key: "URL", Value: url})
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed fetching verification file", err, utils.Attribute{Key: "URL", Value: url})
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, utils.LavaFormatError("[Lavavisor] bad HTTP status", nil, utils.Attribute{Key: "status", Value: resp.Status}, utils.Attribute{Key: "URL", Value: url})
+	}
+	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, utils.LavaFormatError("[Lavavisor] failed reading verification file", err, utils.Attribute{Key: "URL", Value: url})
+	}
+	return &verificationFile{data: data, path: path, source: url, fetched: true}, nil
+}
+
+// findChecksum returns the checksum of the first of names found in a sha256sum formatted manifest
+func findChecksum(manifest []byte, names []string) (string, error) {
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		// sha256sum marks files hashed in binary mode with a '*'
+		name := filepath.Base(strings.TrimPrefix(fields[1], "*"))
+		checksums[name] = strings.ToLower(fields[0])
+	}
+	for _, name := range names {
+		if checksum, ok := checksums[name]; ok {
+			return checksum, nil
+		}
+	}
+	return "", fmt.Errorf("no checksum in the manifest for %s", strings.Join(names, ", "))
+}
+
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// verifySignature checks a detached signature of data, raw or base64 encoded
+func verifySignature(publicKey crypto.PublicKey, data []byte, signature []byte) error {
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature))); err == nil {
+		signature = decoded
+	}
+	digest := sha256.Sum256(data)
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return fmt.Errorf("invalid ed25519 signature")
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return fmt.Errorf("invalid ecdsa signature")
+		}
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return nil
+}
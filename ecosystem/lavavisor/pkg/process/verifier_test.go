@@ -0,0 +1,250 @@
+package processmanager
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protocoltypes "github.com/lavanet/lava/x/protocol/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindChecksum(t *testing.T) {
+	manifest := []byte("AABB  lavap\n" +
+		"ccdd *dist/lavap-v1.2.3-linux-amd64\n" +
+		"malformed line\n" +
+		"\n")
+
+	checksum, err := findChecksum(manifest, []string{"lavap"})
+	require.NoError(t, err)
+	require.Equal(t, "aabb", checksum)
+
+	// binary mode marker and directories are stripped from the names
+	checksum, err = findChecksum(manifest, []string{"missing", "lavap-v1.2.3-linux-amd64"})
+	require.NoError(t, err)
+	require.Equal(t, "ccdd", checksum)
+
+	_, err = findChecksum(manifest, []string{"missing"})
+	require.Error(t, err)
+}
+
+func sign(t *testing.T, privateKey crypto.Signer, data []byte) []byte {
+	if _, ok := privateKey.(ed25519.PrivateKey); ok {
+		signature, err := privateKey.Sign(rand.Reader, data, crypto.Hash(0))
+		require.NoError(t, err)
+		return signature
+	}
+	digest := sha256.Sum256(data)
+	signature, err := privateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	return signature
+}
+
+func signingKeys(t *testing.T) map[string]crypto.Signer {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return map[string]crypto.Signer{"ed25519": ed25519Key, "ecdsa": ecdsaKey, "rsa": rsaKey}
+}
+
+func TestVerifySignature(t *testing.T) {
+	data := []byte("aabb  lavap\n")
+	for name, privateKey := range signingKeys(t) {
+		t.Run(name, func(t *testing.T) {
+			signature := sign(t, privateKey, data)
+			require.NoError(t, verifySignature(privateKey.Public(), data, signature))
+			encoded := base64.StdEncoding.EncodeToString(signature) + "\n"
+			require.NoError(t, verifySignature(privateKey.Public(), data, []byte(encoded)))
+
+			require.Error(t, verifySignature(privateKey.Public(), []byte("ccdd  lavap\n"), signature))
+			otherKey := signingKeys(t)[name]
+			require.Error(t, verifySignature(otherKey.Public(), data, signature))
+		})
+	}
+}
+
+// writeVersion creates upgrades/v<version>/lavap with a manifest, and returns the binary path
+func writeVersion(t *testing.T, lavavisorPath string, version string, content []byte, manifest []byte) string {
+	versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+version)
+	require.NoError(t, os.MkdirAll(versionDir, 0o755))
+	binaryPath := filepath.Join(versionDir, "lavap")
+	require.NoError(t, os.WriteFile(binaryPath, content, 0o755))
+	if manifest != nil {
+		require.NoError(t, os.WriteFile(filepath.Join(versionDir, ChecksumManifestFileName), manifest, 0o644))
+	}
+	return binaryPath
+}
+
+func writePublicKey(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.pub")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644))
+	return path
+}
+
+func TestBinaryVerifierVerify(t *testing.T) {
+	content := []byte("binary")
+	digest := sha256.Sum256(content)
+	manifest := []byte(hex.EncodeToString(digest[:]) + "  lavap\n")
+	lavavisorPath := t.TempDir()
+
+	verifier, err := NewBinaryVerifier(BinaryVerificationConfig{Enabled: true})
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(writeVersion(t, lavavisorPath, "1.0.0", content, manifest)))
+	// a tampered binary
+	require.Error(t, verifier.Verify(writeVersion(t, lavavisorPath, "1.0.1", []byte("tampered"), manifest)))
+	// no manifest and no url to fetch it from
+	require.Error(t, verifier.Verify(writeVersion(t, lavavisorPath, "1.0.2", content, nil)))
+
+	privateKey := signingKeys(t)["ed25519"]
+	verifier, err = NewBinaryVerifier(BinaryVerificationConfig{Enabled: true, PublicKeyPath: writePublicKey(t, privateKey.Public())})
+	require.NoError(t, err)
+	binaryPath := writeVersion(t, lavavisorPath, "1.1.0", content, manifest)
+	// the manifest must be signed
+	require.Error(t, verifier.Verify(binaryPath))
+	signaturePath := filepath.Join(filepath.Dir(binaryPath), ChecksumManifestFileName+ChecksumSignatureExtension)
+	require.NoError(t, os.WriteFile(signaturePath, sign(t, privateKey, []byte("other manifest")), 0o644))
+	require.Error(t, verifier.Verify(binaryPath))
+	require.NoError(t, os.WriteFile(signaturePath, sign(t, privateKey, manifest), 0o644))
+	require.NoError(t, verifier.Verify(binaryPath))
+
+	// disabled verification accepts anything
+	verifier, err = NewBinaryVerifier(BinaryVerificationConfig{})
+	require.NoError(t, err)
+	require.Nil(t, verifier)
+	require.NoError(t, verifier.Verify(filepath.Join(lavavisorPath, "missing")))
+}
+
+func TestBinaryVerifierDownloadedManifest(t *testing.T) {
+	content := []byte("binary")
+	digest := sha256.Sum256(content)
+	manifest := []byte(hex.EncodeToString(digest[:]) + "  lavap\n")
+	privateKey := signingKeys(t)["ed25519"]
+	files := map[string][]byte{
+		"/v1.0.0/" + ChecksumManifestFileName:                              manifest,
+		"/v1.0.0/" + ChecksumManifestFileName + ChecksumSignatureExtension: sign(t, privateKey, manifest),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, found := files[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+	manifestUrl := server.URL + "/v%s/" + ChecksumManifestFileName
+	lavavisorPath := t.TempDir()
+	binaryPath := writeVersion(t, lavavisorPath, "1.0.0", content, nil)
+	manifestPath := filepath.Join(filepath.Dir(binaryPath), ChecksumManifestFileName)
+
+	// an unsigned download is used but not kept
+	verifier, err := NewBinaryVerifier(BinaryVerificationConfig{Enabled: true, ManifestUrl: manifestUrl})
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(binaryPath))
+	_, err = os.Stat(manifestPath)
+	require.True(t, os.IsNotExist(err))
+
+	// a download with an invalid signature is rejected and not kept
+	config := BinaryVerificationConfig{Enabled: true, ManifestUrl: manifestUrl, PublicKeyPath: writePublicKey(t, signingKeys(t)["ed25519"].Public())}
+	verifier, err = NewBinaryVerifier(config)
+	require.NoError(t, err)
+	require.Error(t, verifier.Verify(binaryPath))
+	_, err = os.Stat(manifestPath)
+	require.True(t, os.IsNotExist(err))
+
+	// a download with a valid signature is kept with its signature
+	config.PublicKeyPath = writePublicKey(t, privateKey.Public())
+	verifier, err = NewBinaryVerifier(config)
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(binaryPath))
+	saved, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	require.Equal(t, manifest, saved)
+	_, err = os.Stat(manifestPath + ChecksumSignatureExtension)
+	require.NoError(t, err)
+}
+
+type staticFetcher struct {
+	binaryPath string
+}
+
+func (sf *staticFetcher) SetCurrentRunningVersion(currentVersion string) {}
+
+func (sf *staticFetcher) FetchProtocolBinary(protocolConsensusVersion *protocoltypes.Version) (string, error) {
+	return sf.binaryPath, nil
+}
+
+// newTestVersionMonitor returns a monitor of a wrapped process that isn't running, so restarts are no-ops
+func newTestVersionMonitor(lavavisorPath string, binaryPath string, verifier *BinaryVerifier) *VersionMonitor {
+	return &VersionMonitor{
+		BinaryPath:          binaryPath,
+		LavavisorPath:       lavavisorPath,
+		allowNilLinker:      true,
+		isWrapProcess:       true,
+		verifier:            verifier,
+		rollbackGracePeriod: DefaultRollbackGracePeriod,
+		rolledBackBinaries:  map[string]struct{}{},
+	}
+}
+
+func TestUpgradeVerificationFailureKeepsPreviousBinary(t *testing.T) {
+	content := []byte("binary")
+	digest := sha256.Sum256(content)
+	manifest := []byte(hex.EncodeToString(digest[:]) + "  lavap\n")
+	lavavisorPath := t.TempDir()
+	previousBinaryPath := writeVersion(t, lavavisorPath, "1.0.0", content, manifest)
+	upgradeBinaryPath := writeVersion(t, lavavisorPath, "1.1.0", []byte("tampered"), manifest)
+
+	verifier, err := NewBinaryVerifier(BinaryVerificationConfig{Enabled: true})
+	require.NoError(t, err)
+	vm := newTestVersionMonitor(lavavisorPath, previousBinaryPath, verifier)
+	vm.protocolBinaryFetcher = &staticFetcher{binaryPath: upgradeBinaryPath}
+	vm.lastKnownVersion = &protocoltypes.Version{ProviderTarget: "1.1.0", ProviderMin: "1.0.0"}
+
+	require.Error(t, vm.handleUpdateTrigger("1.0.0"))
+	require.Equal(t, previousBinaryPath, vm.BinaryPath)
+
+	// once the binary is replaced with a valid one the upgrade goes through
+	require.NoError(t, os.WriteFile(upgradeBinaryPath, content, 0o755))
+	require.NoError(t, vm.handleUpdateTrigger("1.0.0"))
+	require.Equal(t, upgradeBinaryPath, vm.BinaryPath)
+}
+
+func TestUpgradeRollback(t *testing.T) {
+	lavavisorPath := t.TempDir()
+	previousBinaryPath := writeVersion(t, lavavisorPath, "1.0.0", []byte("binary"), nil)
+	upgradeBinaryPath := writeVersion(t, lavavisorPath, "1.1.0", []byte("crashing binary"), nil)
+	vm := newTestVersionMonitor(lavavisorPath, upgradeBinaryPath, nil)
+	vm.protocolBinaryFetcher = &staticFetcher{binaryPath: upgradeBinaryPath}
+	vm.lastKnownVersion = &protocoltypes.Version{ProviderTarget: "1.1.0", ProviderMin: "1.0.0"}
+
+	crashesBefore := vm.crashes.Load()
+	require.True(t, vm.processesHealthy(crashesBefore))
+	vm.crashes.Add(1)
+	require.False(t, vm.processesHealthy(crashesBefore))
+
+	require.Error(t, vm.rollback(previousBinaryPath))
+	require.Equal(t, previousBinaryPath, vm.BinaryPath)
+
+	// the rolled back binary isn't upgraded to again
+	require.Error(t, vm.handleUpdateTrigger("1.0.0"))
+	require.Equal(t, previousBinaryPath, vm.BinaryPath)
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -23,6 +24,26 @@ type ProtocolBinaryFetcherInf interface {
 	FetchProtocolBinary(protocolConsensusVersion *protocoltypes.Version) (selectedBinaryPath string, err error)
 }
 
+const (
+	DefaultRollbackGracePeriod = time.Minute
+	upgradeHealthCheckInterval = 5 * time.Second
+)
+
+// UpgradeOptions configure where the binaries of an upgrade come from and protect the processes from a bad binary
+type UpgradeOptions struct {
+	BinarySource        string          // a directory or an http(s) mirror to fetch the binaries from instead of github
+	Verifier            *BinaryVerifier // verifies binaries before they are linked, nil disables verification
+	RollbackGracePeriod time.Duration   // roll back to the previous binary if the processes fail within this period after an upgrade, 0 disables
+}
+
+// BinaryFetcher returns the mirror fetcher if a binary source is configured, otherwise the default fetcher
+func (uo UpgradeOptions) BinaryFetcher(lavavisorPath string, defaultFetcher ProtocolBinaryFetcherInf) ProtocolBinaryFetcherInf {
+	if uo.BinarySource != "" {
+		return NewProtocolBinaryFetcherFromMirror(lavavisorPath, uo.BinarySource, uo.Verifier)
+	}
+	return defaultFetcher
+}
+
 type KeyRingPassword struct {
 	Password   bool   // whether the password is required
 	Passphrase string // the password
@@ -43,9 +64,20 @@ type VersionMonitor struct {
 	LaunchedServices      bool // indicates whether version was matching or not so we can decide wether to launch services
 	onGoingCmd            *exec.Cmd
 	command               []string
+	verifier              *BinaryVerifier
+	rollbackGracePeriod   time.Duration
+	rolledBackBinaries    map[string]struct{} // binaries that failed after an upgrade, not upgraded to again
+	subprocessGeneration  atomic.Uint64       // counts the started subprocesses
+	stoppedGeneration     atomic.Uint64       // the last subprocess stopped by lavavisor, its exit is not a crash
+	crashes               atomic.Uint64       // subprocess exits lavavisor didn't ask for
+	processLock           sync.Mutex          // guards onGoingCmd, processDone and processStatus
+	processDone           chan struct{}       // closed when the running subprocess exits
+	processStatus         ProcessStatus
+	exited                chan uint64 // the generations of the crashed subprocesses
+	supervision           SupervisionOptions
 }
 
-func NewVersionMonitor(initVersion string, lavavisorPath string, processes []string, autoDownload bool) *VersionMonitor {
+func NewVersionMonitor(initVersion string, lavavisorPath string, processes []string, autoDownload bool, upgradeOptions UpgradeOptions) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -60,9 +92,12 @@ func NewVersionMonitor(initVersion string, lavavisorPath string, processes []str
 		LavavisorPath:         lavavisorPath,
 		processes:             processes,
 		autoDownload:          autoDownload,
-		protocolBinaryFetcher: fetcher,
+		protocolBinaryFetcher: upgradeOptions.BinaryFetcher(lavavisorPath, fetcher),
 		protocolBinaryLinker:  &ProtocolBinaryLinker{Fetcher: fetcher},
 		lock:                  sync.Mutex{},
+		verifier:              upgradeOptions.Verifier,
+		rollbackGracePeriod:   upgradeOptions.RollbackGracePeriod,
+		rolledBackBinaries:    map[string]struct{}{},
 	}
 }
 
@@ -82,17 +117,34 @@ func (vm *VersionMonitor) handleUpdateTrigger(currentBinaryVersion string) error
 	}
 	versionDir := filepath.Join(vm.LavavisorPath, "upgrades", "v"+vm.lastKnownVersion.ProviderTarget)
 	binaryPath := filepath.Join(versionDir, "lavap")
+	if _, rolledBack := vm.rolledBackBinaries[binaryPath]; rolledBack {
+		return utils.LavaFormatError("[Lavavisor] Skipping upgrade to a binary that was rolled back, replace it and restart lavavisor to retry", nil, utils.Attribute{Key: "binary", Value: binaryPath})
+	}
+	previousBinaryPath := vm.BinaryPath
 	vm.BinaryPath = binaryPath // updating new binary path for validating new binary
 
 	err = vm.createLink()
+	if err != nil {
+		vm.BinaryPath = previousBinaryPath
+		return err
+	}
+	err = vm.TriggerRestartProcess()
 	if err != nil {
 		return err
 	}
-	return vm.TriggerRestartProcess()
+	return vm.watchUpgrade(previousBinaryPath)
 }
 
-// create link to the golang go env path of "lavap"
+// verify the binary and create link to the golang go env path of "lavap"
 func (vm *VersionMonitor) createLink() error {
+	err := vm.verifier.Verify(vm.BinaryPath)
+	if err != nil {
+		return utils.LavaFormatError("[Lavavisor] Binary verification failed, not linking it", err, utils.Attribute{Key: "binary", Value: vm.BinaryPath})
+	}
+	return vm.linkBinary()
+}
+
+func (vm *VersionMonitor) linkBinary() error {
 	if vm.protocolBinaryLinker == nil { // this happens in the pods flow where we don't link and don't validate golang installations
 		if vm.allowNilLinker {
 			return nil
@@ -110,7 +162,7 @@ func (vm *VersionMonitor) createLink() error {
 // create a link for lavap from the binary path and restart the services
 func (vm *VersionMonitor) TriggerRestartProcess() error {
 	if vm.isWrapProcess {
-		if vm.onGoingCmd != nil {
+		if vm.hasSubprocess() {
 			utils.LavaFormatInfo("[Lavavisor] triggering vm.restart")
 			vm.restart <- struct{}{}
 			utils.LavaFormatInfo("[Lavavisor] done vm.restart")
@@ -151,6 +203,61 @@ func (vm *VersionMonitor) TriggerRestartProcess() error {
 	return nil
 }
 
+// watchUpgrade rolls back to the previous binary if the processes don't stay healthy for the grace period after an upgrade
+func (vm *VersionMonitor) watchUpgrade(previousBinaryPath string) error {
+	if vm.rollbackGracePeriod <= 0 || previousBinaryPath == "" || previousBinaryPath == vm.BinaryPath {
+		return nil
+	}
+	if (vm.isWrapProcess && !vm.hasSubprocess()) || (!vm.isWrapProcess && len(vm.processes) == 0) {
+		return nil // nothing was restarted
+	}
+	utils.LavaFormatInfo("[Lavavisor] Watching the upgraded processes", utils.Attribute{Key: "gracePeriod", Value: vm.rollbackGracePeriod})
+	crashesBefore := vm.crashes.Load()
+	deadline := time.Now().Add(vm.rollbackGracePeriod)
+	for time.Now().Before(deadline) {
+		time.Sleep(upgradeHealthCheckInterval)
+		if !vm.processesHealthy(crashesBefore) {
+			return vm.rollback(previousBinaryPath)
+		}
+	}
+	utils.LavaFormatInfo("[Lavavisor] Upgraded processes stayed healthy through the grace period", utils.Attribute{Key: "binary", Value: vm.BinaryPath})
+	return nil
+}
+
+func (vm *VersionMonitor) processesHealthy(crashesBefore uint64) bool {
+	if vm.isWrapProcess {
+		// the subprocess is restarted when it crashes, so count the crashes instead of checking it is running
+		return vm.crashes.Load() == crashesBefore
+	}
+	for _, process := range vm.processes {
+		if !IsProcessActive(process) {
+			utils.LavaFormatWarning("[Lavavisor] Process is not active after the upgrade", nil, utils.Attribute{Key: "Process", Value: process})
+			return false
+		}
+	}
+	return true
+}
+
+func (vm *VersionMonitor) rollback(previousBinaryPath string) error {
+	failedBinaryPath := vm.BinaryPath
+	utils.LavaFormatWarning("[Lavavisor] Upgraded processes failed within the grace period, rolling back to the previous binary", nil,
+		utils.Attribute{Key: "failed", Value: failedBinaryPath},
+		utils.Attribute{Key: "previous", Value: previousBinaryPath},
+	)
+	vm.rolledBackBinaries[failedBinaryPath] = struct{}{}
+	vm.BinaryPath = previousBinaryPath
+	// the previous binary was verified when it was linked
+	err := vm.linkBinary()
+	if err != nil {
+		return err
+	}
+	err = vm.TriggerRestartProcess()
+	if err != nil {
+		return err
+	}
+	return utils.LavaFormatError("[Lavavisor] Upgrade rolled back", nil, utils.Attribute{Key: "failed", Value: failedBinaryPath})
+}
+
 func (vm *VersionMonitor) validateLinkPointsToTheRightTarget() error {
 	if vm.protocolBinaryLinker == nil { // this happens in the pods flow where we don't link and don't validate golang installations
 		if vm.allowNilLinker {
@@ -227,21 +334,51 @@ func (vm *VersionMonitor) ValidateProtocolVersion(incoming *updaters.ProtocolVer
 	return nil
 }
 
-func (vm *VersionMonitor) StartProcess(keyringPassword *KeyRingPassword) {
+// StartProcess runs the wrapped lavap process and keeps it running: it is restarted with a backoff when it exits
+// or fails its health probes, and on upgrades. SIGTERM and interrupts stop it gracefully.
+func (vm *VersionMonitor) StartProcess(keyringPassword *KeyRingPassword, supervisionOptions SupervisionOptions) {
+	vm.supervision = supervisionOptions.withDefaults()
+	vm.exited = make(chan uint64, 10)
 	// Create a channel to capture OS signals (e.g., Ctrl+C)
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	if vm.supervision.StatusListenAddress != "" {
+		go vm.serveStatus(vm.supervision.StatusListenAddress)
+	}
+	if vm.supervision.HealthProbeUrl != "" {
+		go vm.probeHealth(vm.supervision, stop)
+	}
 
 	// Start subprocess in a Goroutine
 	go vm.startSubprocess(keyringPassword)
 
+	backoff := initialRestartBackoff
+	var restartTimer <-chan time.Time
 	// Main loop for monitoring the subprocess
 	for {
 		select {
 		case <-vm.restart:
 			utils.LavaFormatInfo("[Lavavisor] Received restart signal, restarting subprocess...")
+			restartTimer = nil
 			vm.StopSubprocess()
 			go vm.startSubprocess(keyringPassword)
+		case generation := <-vm.exited:
+			if generation != vm.subprocessGeneration.Load() {
+				continue // a subprocess that was already replaced
+			}
+			vm.processLock.Lock()
+			ranFor := time.Since(vm.processStatus.StartedAt)
+			vm.processLock.Unlock()
+			var delay time.Duration
+			delay, backoff = restartBackoff(backoff, ranFor)
+			utils.LavaFormatWarning("[Lavavisor] Subprocess crashed, restarting it", nil, utils.Attribute{Key: "backoff", Value: delay}, utils.Attribute{Key: "ranFor", Value: ranFor})
+			restartTimer = time.After(delay)
+		case <-restartTimer:
+			restartTimer = nil
+			go vm.startSubprocess(keyringPassword)
 		case sig := <-sigCh:
 			utils.LavaFormatInfo("[Lavavisor] Received signal:", utils.Attribute{Key: "signal", Value: sig})
 			vm.StopSubprocess()
@@ -250,15 +387,56 @@ func (vm *VersionMonitor) StartProcess(keyringPassword *KeyRingPassword) {
 	}
 }
 
+func (vm *VersionMonitor) hasSubprocess() bool {
+	vm.processLock.Lock()
+	defer vm.processLock.Unlock()
+	return vm.onGoingCmd != nil
+}
+
+// StopSubprocess forwards SIGTERM to the subprocess and kills it if it doesn't exit within the drain timeout
 func (vm *VersionMonitor) StopSubprocess() {
-	if vm.onGoingCmd != nil && vm.onGoingCmd.Process != nil {
-		utils.LavaFormatInfo("[Lavavisor] Stopping old subprocess...")
-		if err := vm.onGoingCmd.Process.Kill(); err != nil {
+	vm.processLock.Lock()
+	cmd, done := vm.onGoingCmd, vm.processDone
+	vm.stoppedGeneration.Store(vm.subprocessGeneration.Load())
+	vm.processLock.Unlock()
+	if cmd == nil || cmd.Process == nil || done == nil {
+		return
+	}
+	drainTimeout := vm.supervision.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+	select {
+	case <-done:
+		return // already exited
+	default:
+	}
+	utils.LavaFormatInfo("[Lavavisor] Stopping old subprocess...", utils.Attribute{Key: "drainTimeout", Value: drainTimeout})
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		utils.LavaFormatError("[Lavavisor] Error sending SIGTERM to subprocess", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(drainTimeout):
+		utils.LavaFormatWarning("[Lavavisor] Subprocess didn't exit within the drain timeout, killing it", nil)
+		if err := cmd.Process.Kill(); err != nil {
 			utils.LavaFormatError("[Lavavisor] Error stopping subprocess", err)
 		}
 	}
 }
 
+// killSubprocess kills an unhealthy subprocess, its exit is handled as a crash so it is restarted
+func (vm *VersionMonitor) killSubprocess(generation uint64) {
+	vm.processLock.Lock()
+	defer vm.processLock.Unlock()
+	if vm.onGoingCmd == nil || vm.onGoingCmd.Process == nil || generation != vm.subprocessGeneration.Load() {
+		return
+	}
+	if err := vm.onGoingCmd.Process.Kill(); err != nil {
+		utils.LavaFormatError("[Lavavisor] Error killing subprocess", err)
+	}
+}
+
 func (vm *VersionMonitor) startSubprocess(keyringPassword *KeyRingPassword) {
 	// make sure the subprocess wont continue running if we run into a panic.
 	defer func() {
@@ -269,69 +447,128 @@ func (vm *VersionMonitor) startSubprocess(keyringPassword *KeyRingPassword) {
 	}()
 
 	utils.LavaFormatInfo("[Lavavisor] Starting subprocess...")
-	vm.onGoingCmd = exec.Command(vm.BinaryPath, vm.command...)
+	cmd := exec.Command(vm.BinaryPath, vm.command...)
+	done := make(chan struct{})
+	vm.processLock.Lock()
+	generation := vm.subprocessGeneration.Add(1)
+	vm.onGoingCmd = cmd
+	vm.processDone = done
+	vm.processLock.Unlock()
+	defer close(done)
 
 	// Set up output redirection so you can see the subprocess's output
-	vm.onGoingCmd.Stdout = os.Stdout
-	// vm.onGoingCmd.Stderr = os.Stderr
+	cmd.Stdout = os.Stdout
+	// cmd.Stderr = os.Stderr
 
-	foundPasswordTrigger := make(chan struct{})
+	foundPasswordTrigger := make(chan struct{}, 1)
 	processStart := common.ProcessStartLogText
-	stderrPipe, err := vm.onGoingCmd.StderrPipe()
+	stderrPipe, err := cmd.StderrPipe()
 	if err != nil {
 		utils.LavaFormatError("[Lavavisor] Error obtaining stderr pipe:", err)
 		return
 	}
 
 	// Interaction with the command's Stdin
-	stdin, err := vm.onGoingCmd.StdinPipe()
+	stdin, err := cmd.StdinPipe()
 	if err != nil {
 		fmt.Println("Error obtaining stdin:", err)
 		return
 	}
 
+	stderrClosed := make(chan struct{})
 	go func() {
+		defer close(stderrClosed)
 		scanner := bufio.NewScanner(stderrPipe)
 		for scanner.Scan() {
 			line := scanner.Text()
 			fmt.Println(line)
 			if strings.Contains(line, processStart) {
-				foundPasswordTrigger <- struct{}{}
+				select {
+				case foundPasswordTrigger <- struct{}{}:
+				default:
+				}
 			}
 		}
 	}()
 
-	if err := vm.onGoingCmd.Start(); err != nil {
+	if err := cmd.Start(); err != nil {
 		utils.LavaFormatError("[Lavavisor] Error starting subprocess:", err)
+		vm.subprocessExited(generation, err)
+		return
+	}
+	version, _ := GetBinaryVersion(vm.BinaryPath)
+	vm.processLock.Lock()
+	vm.processStatus = ProcessStatus{
+		Command:   strings.Join(cmd.Args, " "),
+		Binary:    vm.BinaryPath,
+		Version:   version,
+		Pid:       cmd.Process.Pid,
+		Running:   true,
+		Healthy:   true,
+		StartedAt: time.Now(),
+		LastExit:  vm.processStatus.LastExit,
+	}
+	vm.processLock.Unlock()
+
+	select {
+	case <-foundPasswordTrigger:
+		// wait to make sure process is waiting for password
+		time.Sleep(time.Second * 3)
+	case <-stderrClosed:
+		// the process exited before it started
 	}
-
-	<-foundPasswordTrigger
-	// wait to make sure process is waiting for password
-	time.Sleep(time.Second * 3)
 
 	if keyringPassword != nil && keyringPassword.Password {
 		// Send input to the command
 		_, err = stdin.Write([]byte(keyringPassword.Passphrase + "\n"))
 		if err != nil {
 			fmt.Println("Error writing to stdin:", err)
-			return
+		} else {
+			utils.LavaFormatInfo("[Lavavisor] entered keyring-os password.")
 		}
-		utils.LavaFormatInfo("[Lavavisor] entered keyring-os password.")
 	}
 	stdin.Close() // Flush the input stream (this sends the input to the process)
 
-	if err := vm.onGoingCmd.Wait(); err != nil {
-		if strings.Contains(err.Error(), "signal: killed") {
-			utils.LavaFormatInfo("[Lavavisor] Subprocess stopped due to sig killed.")
-		} else {
-			utils.LavaFormatError("[Lavavisor] Subprocess exited with error", err)
-		}
+	err = cmd.Wait()
+	if vm.stoppedGeneration.Load() == generation {
+		utils.LavaFormatInfo("[Lavavisor] Subprocess stopped.", utils.Attribute{Key: "exit", Value: err})
+		vm.processLock.Lock()
+		vm.processStatus.Running = false
+		vm.processStatus.Healthy = false
+		vm.processStatus.LastExit = exitDescription(err)
+		vm.processLock.Unlock()
+		return
+	}
+	if err != nil {
+		utils.LavaFormatError("[Lavavisor] Subprocess exited with error", err)
 	} else {
 		utils.LavaFormatInfo("[Lavavisor] Subprocess exited without error.")
 	}
+	vm.subprocessExited(generation, err)
+}
+
+// subprocessExited records a subprocess exit lavavisor didn't ask for and has it restarted
+func (vm *VersionMonitor) subprocessExited(generation uint64, err error) {
+	vm.crashes.Add(1)
+	vm.processLock.Lock()
+	vm.processStatus.Running = false
+	vm.processStatus.Healthy = false
+	vm.processStatus.LastExit = exitDescription(err)
+	vm.processLock.Unlock()
+	select {
+	case vm.exited <- generation:
+	default:
+	}
+}
+
+func exitDescription(err error) string {
+	if err == nil {
+		return "exited"
+	}
+	return err.Error()
 }
 
-func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string, autoDownload bool, command string) *VersionMonitor {
+func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string, autoDownload bool, command string, upgradeOptions UpgradeOptions) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -348,16 +585,19 @@ func NewVersionMonitorProcessWrapFlow(initVersion string, lavavisorPath string,
 		BinaryPath:            binaryPath,
 		LavavisorPath:         lavavisorPath,
 		autoDownload:          autoDownload,
-		protocolBinaryFetcher: fetcher,
+		protocolBinaryFetcher: upgradeOptions.BinaryFetcher(lavavisorPath, fetcher),
 		protocolBinaryLinker:  &ProtocolBinaryLinker{Fetcher: fetcher},
 		lock:                  sync.Mutex{},
 		isWrapProcess:         true,
 		restart:               make(chan struct{}),
 		command:               strings.Fields(command),
+		verifier:              upgradeOptions.Verifier,
+		rollbackGracePeriod:   upgradeOptions.RollbackGracePeriod,
+		rolledBackBinaries:    map[string]struct{}{},
 	}
 }
 
-func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, command string) *VersionMonitor {
+func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, command string, upgradeOptions UpgradeOptions) *VersionMonitor {
 	var binaryPath string
 	if initVersion != "" { // handle case if not found valid lavap at all
 		versionDir := filepath.Join(lavavisorPath, "upgrades", "v"+initVersion)
@@ -372,11 +612,14 @@ func NewVersionMonitorProcessPodFlow(initVersion string, lavavisorPath string, c
 	return &VersionMonitor{
 		BinaryPath:            binaryPath,
 		LavavisorPath:         lavavisorPath,
-		protocolBinaryFetcher: fetcher,
+		protocolBinaryFetcher: upgradeOptions.BinaryFetcher(lavavisorPath, fetcher),
 		allowNilLinker:        true,
 		lock:                  sync.Mutex{},
 		isWrapProcess:         true,
 		restart:               make(chan struct{}),
 		command:               strings.Fields(command),
+		verifier:              upgradeOptions.Verifier,
+		rollbackGracePeriod:   upgradeOptions.RollbackGracePeriod,
+		rolledBackBinaries:    map[string]struct{}{},
 	}
 }
@@ -0,0 +1,297 @@
+package badgegenerator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lavanet/lava/utils"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	AuthorizationHeaderKey = "authorization"
+	bearerPrefix           = "bearer "
+	// the rate limiters of the unauthenticated clients are reset when there are more than this
+	maxRateLimitedClients = 100000
+)
+
+type AuthConfiguration struct {
+	JwksFilePath string // a JSON Web Key Set with the public keys of the identity provider
+	SharedSecret string // a secret for HMAC signed tokens
+	Issuer       string // if set, the iss claim must match
+	Audience     string // if set, the aud claim must contain it
+	ProjectClaim string // the claim holding the project id of the user
+	UserClaim    string // the claim holding the user id
+	// serve requests without a token using the project id in the request, rate limited per client
+	AllowUnauthenticated     bool
+	UnauthenticatedRateLimit float64 // requests per second per client
+	UnauthenticatedBurst     int
+	// if set, the client address is taken from this header (e.g. X-Forwarded-For) instead of the
+	// connection's peer address. Only set it behind a trusted proxy that sets the header
+	ClientIpHeader string
+}
+
+// AuthClaims are the values the badge server takes from a validated token
+type AuthClaims struct {
+	ProjectId string
+	UserId    string
+}
+
+// Authenticator validates the bearer JWT sent with badge requests. Requests without a token are rejected,
+// or rate limited per client if unauthenticated requests are allowed
+type Authenticator struct {
+	config        AuthConfiguration
+	keys          map[string]crypto.PublicKey // kid -> key from the jwks file
+	validMethods  []string
+	limitersLock  sync.Mutex
+	limiters      map[string]*rate.Limiter
+	parserOptions []jwt.ParserOption
+}
+
+// NewAuthenticator returns nil if neither a jwks file nor a shared secret are configured, so authentication is disabled
+func NewAuthenticator(config AuthConfiguration) (*Authenticator, error) {
+	if config.JwksFilePath == "" && config.SharedSecret == "" {
+		return nil, nil
+	}
+	if config.ProjectClaim == "" || config.UserClaim == "" {
+		return nil, utils.LavaFormatError("auth project and user claims must be set", nil)
+	}
+	authenticator := &Authenticator{
+		config:   config,
+		limiters: map[string]*rate.Limiter{},
+	}
+	if config.JwksFilePath != "" {
+		keys, err := loadJwks(config.JwksFilePath)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.keys = keys
+		authenticator.validMethods = append(authenticator.validMethods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+	if config.SharedSecret != "" {
+		authenticator.validMethods = append(authenticator.validMethods, "HS256", "HS384", "HS512")
+	}
+	authenticator.parserOptions = []jwt.ParserOption{jwt.WithValidMethods(authenticator.validMethods)}
+	if config.Issuer != "" {
+		authenticator.parserOptions = append(authenticator.parserOptions, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		authenticator.parserOptions = append(authenticator.parserOptions, jwt.WithAudience(config.Audience))
+	}
+	utils.LavaFormatInfo("badge requests authentication enabled",
+		utils.Attribute{Key: "jwks", Value: config.JwksFilePath},
+		utils.Attribute{Key: "sharedSecret", Value: config.SharedSecret != ""},
+		utils.Attribute{Key: "allowUnauthenticated", Value: config.AllowUnauthenticated},
+	)
+	return authenticator, nil
+}
+
+// Authenticate returns the claims of the token in the request metadata. It returns nil claims
+// for an allowed unauthenticated request, and an error if the request must be rejected
+func (a *Authenticator) Authenticate(ctx context.Context) (*AuthClaims, error) {
+	clientAddress := a.clientAddress(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization := md.Get(AuthorizationHeaderKey)
+	if len(authorization) == 0 || authorization[0] == "" {
+		if !a.config.AllowUnauthenticated {
+			return nil, utils.LavaFormatWarning("rejected badge request without a token", nil, utils.Attribute{Key: "ip", Value: clientAddress})
+		}
+		if !a.getLimiter(clientAddress).Allow() {
+			return nil, utils.LavaFormatWarning("rate limited unauthenticated badge request", nil, utils.Attribute{Key: "ip", Value: clientAddress})
+		}
+		return nil, nil
+	}
+	if len(authorization[0]) <= len(bearerPrefix) || !strings.EqualFold(authorization[0][:len(bearerPrefix)], bearerPrefix) {
+		return nil, utils.LavaFormatWarning("rejected badge request with an invalid authorization header", nil, utils.Attribute{Key: "ip", Value: clientAddress})
+	}
+	return a.validateToken(authorization[0][len(bearerPrefix):])
+}
+
+func (a *Authenticator) validateToken(tokenString string) (*AuthClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, a.getKey, a.parserOptions...)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("rejected badge request with an invalid token", err)
+	}
+	// tokens must expire, otherwise a leaked token can be used forever
+	if _, found := claims["exp"]; !found {
+		return nil, utils.LavaFormatWarning("rejected badge request with a token without expiration", nil)
+	}
+	projectId, _ := claims[a.config.ProjectClaim].(string)
+	userId, _ := claims[a.config.UserClaim].(string)
+	if projectId == "" || userId == "" {
+		return nil, utils.LavaFormatWarning("rejected badge request with a token missing the project or user claims", nil,
+			utils.Attribute{Key: "projectClaim", Value: a.config.ProjectClaim},
+			utils.Attribute{Key: "userClaim", Value: a.config.UserClaim},
+		)
+	}
+	return &AuthClaims{ProjectId: projectId, UserId: userId}, nil
+}
+
+func (a *Authenticator) getKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return []byte(a.config.SharedSecret), nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+	key, found := a.keys[kid]
+	if !found {
+		return nil, fmt.Errorf("unknown token key id %q", kid)
+	}
+	return key, nil
+}
+
+// clientAddress returns the address unauthenticated requests are rate limited by: the gRPC peer
+// address, or the configured forwarding header of a trusted proxy. Client controlled headers
+// (like the Referer) must not be used, otherwise clients can bypass the rate limit
+func (a *Authenticator) clientAddress(ctx context.Context) string {
+	if a != nil && a.config.ClientIpHeader != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(a.config.ClientIpHeader); len(values) > 0 {
+			// the trusted proxy appends the address it received the request from, so the last one is the client's
+			forwarded := strings.Split(values[len(values)-1], ",")
+			if address := strings.TrimSpace(forwarded[len(forwarded)-1]); address != "" {
+				return address
+			}
+		}
+	}
+	clientPeer, ok := peer.FromContext(ctx)
+	if !ok || clientPeer.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(clientPeer.Addr.String())
+	if err != nil {
+		return clientPeer.Addr.String()
+	}
+	return host
+}
+
+// userId returns the user whose epoch cu limit a badge request is counted in: the token's user of an authenticated
+// request, and the client address of an unauthenticated one since its badge address is chosen by the client
+func (a *Authenticator) userId(ctx context.Context, claims *AuthClaims) string {
+	if claims != nil {
+		return claims.UserId
+	}
+	return unauthenticatedUserPrefix + a.clientAddress(ctx)
+}
+
+func (a *Authenticator) getLimiter(clientAddress string) *rate.Limiter {
+	a.limitersLock.Lock()
+	defer a.limitersLock.Unlock()
+	limiter, found := a.limiters[clientAddress]
+	if !found {
+		if len(a.limiters) >= maxRateLimitedClients {
+			a.limiters = map[string]*rate.Limiter{}
+		}
+		limiter = rate.NewLimiter(rate.Limit(a.config.UnauthenticatedRateLimit), a.config.UnauthenticatedBurst)
+		a.limiters[clientAddress] = limiter
+	}
+	return limiter
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func loadJwks(path string) (map[string]crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed reading jwks file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err = json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed parsing jwks file", err, utils.Attribute{Key: "path", Value: path})
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid key in jwks file", err, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "kid", Value: jwk.Kid})
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, utils.LavaFormatError("no signing keys in jwks file", nil, utils.Attribute{Key: "path", Value: path})
+	}
+	return keys, nil
+}
+
+func decodeBase64Int(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBase64Int(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64Int(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBase64Int(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64Int(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %q", jwk.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
@@ -0,0 +1,144 @@
+package badgegenerator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeaderKey, "Bearer "+token))
+}
+
+func TestAuthenticatorSharedSecret(t *testing.T) {
+	authenticator, err := NewAuthenticator(AuthConfiguration{SharedSecret: "secret", Issuer: "issuer", ProjectClaim: "project_id", UserClaim: "sub"})
+	require.NoError(t, err)
+
+	sign := func(claims jwt.MapClaims, secret string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		require.NoError(t, err)
+		return token
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	claims, err := authenticator.Authenticate(contextWithToken(sign(jwt.MapClaims{"sub": "user", "project_id": "project", "iss": "issuer", "exp": exp}, "secret")))
+	require.NoError(t, err)
+	require.Equal(t, &AuthClaims{ProjectId: "project", UserId: "user"}, claims)
+
+	for name, token := range map[string]string{
+		"wrong secret":  sign(jwt.MapClaims{"sub": "user", "project_id": "project", "iss": "issuer", "exp": exp}, "other"),
+		"wrong issuer":  sign(jwt.MapClaims{"sub": "user", "project_id": "project", "iss": "other", "exp": exp}, "secret"),
+		"expired":       sign(jwt.MapClaims{"sub": "user", "project_id": "project", "iss": "issuer", "exp": time.Now().Add(-time.Hour).Unix()}, "secret"),
+		"no expiration": sign(jwt.MapClaims{"sub": "user", "project_id": "project", "iss": "issuer"}, "secret"),
+		"no project":    sign(jwt.MapClaims{"sub": "user", "iss": "issuer", "exp": exp}, "secret"),
+	} {
+		_, err := authenticator.Authenticate(contextWithToken(token))
+		require.Error(t, err, name)
+	}
+
+	// unauthenticated requests are rejected unless allowed
+	_, err = authenticator.Authenticate(context.Background())
+	require.Error(t, err)
+}
+
+func TestAuthenticatorJwks(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks := map[string]interface{}{"keys": []map[string]string{{
+		"kid": "key1",
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(privateKey.X.Bytes()),
+		"y":   base64.RawURLEncoding.EncodeToString(privateKey.Y.Bytes()),
+	}}}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksPath, data, 0o600))
+
+	authenticator, err := NewAuthenticator(AuthConfiguration{JwksFilePath: jwksPath, ProjectClaim: "project_id", UserClaim: "sub"})
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "user", "project_id": "project", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "key1"
+	signed, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+	claims, err := authenticator.Authenticate(contextWithToken(signed))
+	require.NoError(t, err)
+	require.Equal(t, "user", claims.UserId)
+
+	// a token signed with the secret algorithm can't be validated with the jwks keys
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user", "project_id": "project", "exp": time.Now().Add(time.Hour).Unix()}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(contextWithToken(hmacToken))
+	require.Error(t, err)
+}
+
+func contextFromPeer(ctx context.Context, address string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 1234}})
+}
+
+func TestAuthenticatorUnauthenticatedRateLimit(t *testing.T) {
+	authenticator, err := NewAuthenticator(AuthConfiguration{
+		SharedSecret: "secret", ProjectClaim: "project_id", UserClaim: "sub",
+		AllowUnauthenticated: true, UnauthenticatedRateLimit: 0.001, UnauthenticatedBurst: 2,
+	})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		claims, err := authenticator.Authenticate(contextFromPeer(context.Background(), "1.1.1.1"))
+		require.NoError(t, err)
+		require.Nil(t, claims)
+	}
+	_, err = authenticator.Authenticate(contextFromPeer(context.Background(), "1.1.1.1"))
+	require.Error(t, err)
+	// a client controlled header doesn't change the client address
+	refererCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RefererHeaderKey, "3.3.3.3", "x-forwarded-for", "3.3.3.3"))
+	_, err = authenticator.Authenticate(contextFromPeer(refererCtx, "1.1.1.1"))
+	require.Error(t, err)
+	// other clients have their own limit
+	_, err = authenticator.Authenticate(contextFromPeer(context.Background(), "2.2.2.2"))
+	require.NoError(t, err)
+}
+
+func TestAuthenticatorClientIpHeader(t *testing.T) {
+	authenticator, err := NewAuthenticator(AuthConfiguration{
+		SharedSecret: "secret", ProjectClaim: "project_id", UserClaim: "sub",
+		AllowUnauthenticated: true, UnauthenticatedRateLimit: 0.001, UnauthenticatedBurst: 1,
+		ClientIpHeader: "X-Forwarded-For",
+	})
+	require.NoError(t, err)
+	forwardedCtx := func(forwardedFor string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", forwardedFor))
+		return contextFromPeer(ctx, "10.0.0.1") // the proxy
+	}
+
+	require.Equal(t, "2.2.2.2", authenticator.clientAddress(forwardedCtx("1.1.1.1, 2.2.2.2")))
+	require.Equal(t, "10.0.0.1", authenticator.clientAddress(contextFromPeer(context.Background(), "10.0.0.1")))
+
+	// unauthenticated requests are counted in the user limit of their client address, whatever badge address they ask for
+	require.Equal(t, unauthenticatedUserPrefix+"2.2.2.2", authenticator.userId(forwardedCtx("1.1.1.1, 2.2.2.2"), nil))
+	require.Equal(t, "user", authenticator.userId(forwardedCtx("2.2.2.2"), &AuthClaims{ProjectId: "project", UserId: "user"}))
+	var noAuthenticator *Authenticator
+	require.Equal(t, unauthenticatedUserPrefix+"10.0.0.1", noAuthenticator.userId(forwardedCtx("2.2.2.2"), nil))
+
+	// clients behind the same proxy are limited separately
+	_, err = authenticator.Authenticate(forwardedCtx("1.1.1.1"))
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(forwardedCtx("1.1.1.1"))
+	require.Error(t, err)
+	_, err = authenticator.Authenticate(forwardedCtx("2.2.2.2"))
+	require.NoError(t, err)
+}
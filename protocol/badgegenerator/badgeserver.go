@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -79,6 +80,18 @@ func CreateBadgeGeneratorCobraCommand() *cobra.Command {
 	cmd.Flags().Int("epoch-interval", 30, "--epoch-interval=30")
 	cmd.Flags().String("port", "8080", "--port=8080")
 	cmd.Flags().String("metrics-port", "8081", "--metrics-port=8081")
+	cmd.Flags().String("ledger-path", DefaultLedgerPath, "directory of the ledger of the cu allocated per user and epoch, kept in memory if empty")
+	cmd.Flags().String("auth-jwks-file", "", "validate the bearer JWT of badge requests with the keys in this JSON Web Key Set file")
+	cmd.Flags().String("auth-shared-secret", "", "validate the bearer JWT of badge requests with this HMAC secret (prefer the BADGE_AUTH_SHARED_SECRET env variable)")
+	cmd.Flags().String("auth-issuer", "", "if set, the iss claim of the tokens must match it")
+	cmd.Flags().String("auth-audience", "", "if set, the aud claim of the tokens must contain it")
+	cmd.Flags().String("auth-project-claim", "project_id", "the token claim holding the project id")
+	cmd.Flags().String("auth-user-claim", "sub", "the token claim holding the user id")
+	cmd.Flags().Bool("auth-allow-unauthenticated", false, "serve requests without a token, rate limited per client ip")
+	cmd.Flags().Float64("auth-unauthenticated-rate-limit", 1, "the requests per second allowed per client ip for requests without a token")
+	cmd.Flags().Int("auth-unauthenticated-burst", 5, "the burst of requests allowed per client ip for requests without a token")
+	cmd.Flags().String("auth-client-ip-header", "", "take the client ip of unauthenticated requests from this header (e.g. X-Forwarded-For) instead of the connection, only set it behind a trusted proxy")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 	cmd.Flags().String(flags.FlagChainID, app.Name, "network chain id")
 	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 
@@ -91,12 +104,11 @@ func bindFlags(cmd *cobra.Command, v *viper.Viper) {
 
 		// Apply the viper config value to the flag when the flag is not set and viper has a value
 		if f.Changed {
-			val, _ := cmd.Flags().GetString(f.Name)
-			v.Set(configName, val)
+			v.Set(configName, f.Value.String())
 		} else {
 			val := v.GetString(configName)
 			if val == "" {
-				v.Set(configName, f.Value)
+				v.Set(configName, f.Value.String())
 			}
 		}
 	})
@@ -111,15 +123,28 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	defaultGeolocation := v.GetInt(DefaultGeolocationEnvironmentVariable)
 	countriesFilePath := v.GetString(CountriesFilePathEnvironmentVariable)
 	ipFilePath := v.GetString(IpFilePathEnvironmentVariable)
-	ipService, err := InitIpService(defaultGeolocation, countriesFilePath, ipFilePath)
+	ipV6FilePath := v.GetString(IpV6FilePathEnvironmentVariable)
+	ipService, err := InitIpService(defaultGeolocation, countriesFilePath, ipFilePath, ipV6FilePath)
 	if err != nil {
 		utils.LavaFormatFatal("Error initializing ip service", err)
 	}
 	grpcUrl := v.GetString(GrpcUrlEnvironmentVariable)
 	chainId := v.GetString(LavaChainIDEnvironmentVariable)
 	userData := v.GetString(UserDataEnvironmentVariable)
+	ledgerPath := v.GetString(LedgerPathEnvironmentVariable)
 
-	server, err := NewServer(ipService, grpcUrl, chainId, userData)
+	ledger, err := NewCuLedger(ledgerPath)
+	if err != nil {
+		utils.LavaFormatFatal("Error opening cu ledger", err)
+	}
+	defer ledger.Close()
+
+	authenticator, err := NewAuthenticator(getAuthConfiguration(v))
+	if err != nil {
+		utils.LavaFormatFatal("Error initializing authentication", err)
+	}
+
+	server, err := NewServer(ipService, grpcUrl, chainId, userData, ledger, authenticator)
 	if err != nil {
 		utils.LavaFormatFatal("Error in server creation", err)
 	}
@@ -129,6 +154,10 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	if err != nil {
 		utils.LavaFormatFatal("Error initiating client to lava", err)
 	}
+	err = server.LoadSigningKeys(clientCtx)
+	if err != nil {
+		utils.LavaFormatFatal("Error loading projects signing keys", err)
+	}
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, clientCtx)
 	stateTracker, err := NewBadgeStateTracker(ctx, clientCtx, lavaChainFetcher, chainId)
 	if err != nil {
@@ -148,7 +177,7 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 	handler := func(resp http.ResponseWriter, req *http.Request) {
 		// Set CORS headers
 		resp.Header().Set("Access-Control-Allow-Origin", "*")
-		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web")
+		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web,Authorization")
 
 		wrappedServer.ServeHTTP(resp, req)
 	}
@@ -164,3 +193,28 @@ func RunBadgeServer(cmd *cobra.Command, v *viper.Viper) {
 		utils.LavaFormatFatal("Http Server failed to start", err)
 	}
 }
+
+func getAuthConfiguration(v *viper.Viper) AuthConfiguration {
+	// flags are bound to viper as strings (see bindFlags)
+	allowUnauthenticated, _ := strconv.ParseBool(v.GetString(AuthAllowUnauthenticatedVariable))
+	rateLimit, err := strconv.ParseFloat(v.GetString(AuthUnauthenticatedRateLimitVariable), 64)
+	if err != nil {
+		utils.LavaFormatFatal("invalid unauthenticated rate limit", err)
+	}
+	burst, err := strconv.Atoi(v.GetString(AuthUnauthenticatedBurstVariable))
+	if err != nil {
+		utils.LavaFormatFatal("invalid unauthenticated burst", err)
+	}
+	return AuthConfiguration{
+		JwksFilePath:             v.GetString(AuthJwksFileEnvironmentVariable),
+		SharedSecret:             v.GetString(AuthSharedSecretEnvironmentVariable),
+		Issuer:                   v.GetString(AuthIssuerEnvironmentVariable),
+		Audience:                 v.GetString(AuthAudienceEnvironmentVariable),
+		ProjectClaim:             v.GetString(AuthProjectClaimEnvironmentVariable),
+		UserClaim:                v.GetString(AuthUserClaimEnvironmentVariable),
+		AllowUnauthenticated:     allowUnauthenticated,
+		UnauthenticatedRateLimit: rateLimit,
+		UnauthenticatedBurst:     burst,
+		ClientIpHeader:           v.GetString(AuthClientIpHeaderVariable),
+	}
+}
@@ -0,0 +1,37 @@
+package badgegenerator
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindFlags(t *testing.T) {
+	cmd := CreateBadgeGeneratorCobraCommand()
+	require.NoError(t, cmd.Flags().Set("auth-allow-unauthenticated", "true"))
+	require.NoError(t, cmd.Flags().Set("auth-unauthenticated-rate-limit", "2.5"))
+	require.NoError(t, cmd.Flags().Set("auth-unauthenticated-burst", "7"))
+	require.NoError(t, cmd.Flags().Set("auth-issuer", "issuer"))
+
+	v := viper.New()
+	bindFlags(cmd, v)
+
+	config := getAuthConfiguration(v)
+	require.True(t, config.AllowUnauthenticated)
+	require.Equal(t, 2.5, config.UnauthenticatedRateLimit)
+	require.Equal(t, 7, config.UnauthenticatedBurst)
+	require.Equal(t, "issuer", config.Issuer)
+	// unchanged flags get their defaults
+	require.Equal(t, "project_id", config.ProjectClaim)
+	require.Equal(t, 30, v.GetInt("EPOCH_INTERVAL"))
+
+	// values from the config (or the environment) are not overridden by unchanged flags
+	cmd = CreateBadgeGeneratorCobraCommand()
+	v = viper.New()
+	v.Set(AuthUnauthenticatedBurstVariable, "9")
+	bindFlags(cmd, v)
+	config = getAuthConfiguration(v)
+	require.Equal(t, 9, config.UnauthenticatedBurst)
+	require.False(t, config.AllowUnauthenticated)
+}
@@ -9,8 +9,25 @@ const (
 	DefaultGeolocationEnvironmentVariable = "DEFAULT_GEOLOCATION"
 	CountriesFilePathEnvironmentVariable  = "COUNTRIES_FILE_PATH"
 	IpFilePathEnvironmentVariable         = "IP_FILE_PATH"
+	IpV6FilePathEnvironmentVariable       = "IP_V6_FILE_PATH"
+	LedgerPathEnvironmentVariable         = "LEDGER_PATH"
+	AuthJwksFileEnvironmentVariable       = "AUTH_JWKS_FILE"
+	AuthSharedSecretEnvironmentVariable   = "AUTH_SHARED_SECRET"
+	AuthIssuerEnvironmentVariable         = "AUTH_ISSUER"
+	AuthAudienceEnvironmentVariable       = "AUTH_AUDIENCE"
+	AuthProjectClaimEnvironmentVariable   = "AUTH_PROJECT_CLAIM"
+	AuthUserClaimEnvironmentVariable      = "AUTH_USER_CLAIM"
+	AuthAllowUnauthenticatedVariable      = "AUTH_ALLOW_UNAUTHENTICATED"
+	AuthUnauthenticatedRateLimitVariable  = "AUTH_UNAUTHENTICATED_RATE_LIMIT"
+	AuthUnauthenticatedBurstVariable      = "AUTH_UNAUTHENTICATED_BURST"
+	AuthClientIpHeaderVariable            = "AUTH_CLIENT_IP_HEADER"
 )
 
 const DefaultProjectId = "default"
 
+const DefaultLedgerPath = "badge_ledger"
+
 const RefererHeaderKey = "Referer"
+
+// the ledger user of unauthenticated requests is their client address with this prefix, so it can't be a token's user
+const unauthenticatedUserPrefix = "address:"
@@ -0,0 +1,142 @@
+package badgegenerator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/utils"
+)
+
+// ledger entries are only needed while their epoch is active, this is long enough for any epoch length
+const LedgerEntryTTL = 7 * 24 * time.Hour
+
+var (
+	ErrProjectEpochCuLimitExceeded = errors.New("project epoch cu limit exceeded")
+	ErrUserEpochCuLimitExceeded    = errors.New("user epoch cu limit exceeded")
+)
+
+// CuLedger persists the CU the badge server allocated to each badge, user and project per epoch, so the
+// badges given to a user or to all users of a project never add up to more than they can use in the epoch,
+// also across restarts of the server.
+type CuLedger struct {
+	lock sync.Mutex
+	db   *badger.DB
+}
+
+// NewCuLedger opens the ledger stored in path, or an in-memory ledger if path is empty
+func NewCuLedger(path string) (*CuLedger, error) {
+	options := badger.DefaultOptions(path).WithLogger(nil)
+	if path == "" {
+		options = options.WithInMemory(true)
+	}
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening badge server cu ledger", err, utils.Attribute{Key: "path", Value: path})
+	}
+	return &CuLedger{db: db}, nil
+}
+
+func ledgerProjectKey(projectAddress string, epoch uint64) []byte {
+	return []byte(fmt.Sprintf("project/%s/%d", projectAddress, epoch))
+}
+
+func ledgerUserKey(projectAddress string, epoch uint64, userId string) []byte {
+	return []byte(fmt.Sprintf("user/%s/%d/%s", projectAddress, epoch, userId))
+}
+
+func ledgerBadgeKey(projectAddress string, epoch uint64, badgeAddress string) []byte {
+	return []byte(fmt.Sprintf("badge/%s/%d/%s", projectAddress, epoch, badgeAddress))
+}
+
+func getLedgerValue(txn *badger.Txn, key []byte) (value uint64, found bool, err error) {
+	item, err := txn.Get(key)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	err = item.Value(func(val []byte) error {
+		if len(val) != 8 {
+			return fmt.Errorf("invalid ledger value length %d", len(val))
+		}
+		value = binary.BigEndian.Uint64(val)
+		return nil
+	})
+	return value, err == nil, err
+}
+
+func setLedgerValue(txn *badger.Txn, key []byte, value uint64) error {
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, value)
+	return txn.SetEntry(badger.NewEntry(key, val).WithTTL(LedgerEntryTTL))
+}
+
+// Allocate reserves badgeCu for the badge of userId in the project's epoch and returns the CU allocated to the badge.
+// A badge that already got CU in this epoch gets the same allocation again without counting it twice, since
+// the chain tracks the usage of a badge per epoch. A new badge is refused with ErrUserEpochCuLimitExceeded or
+// ErrProjectEpochCuLimitExceeded if it would take the user or the project over their epoch limit.
+func (cl *CuLedger) Allocate(projectAddress string, userId string, badgeAddress string, epoch uint64, badgeCu uint64, userEpochCuLimit uint64, projectEpochCuLimit uint64) (allocated uint64, err error) {
+	cl.lock.Lock()
+	defer cl.lock.Unlock()
+
+	err = cl.db.Update(func(txn *badger.Txn) error {
+		badgeKey := ledgerBadgeKey(projectAddress, epoch, badgeAddress)
+		badgeAllocated, found, err := getLedgerValue(txn, badgeKey)
+		if err != nil {
+			return err
+		}
+		if found {
+			allocated = badgeAllocated
+			return nil
+		}
+
+		userKey := ledgerUserKey(projectAddress, epoch, userId)
+		userAllocated, _, err := getLedgerValue(txn, userKey)
+		if err != nil {
+			return err
+		}
+		if userAllocated+badgeCu > userEpochCuLimit {
+			return ErrUserEpochCuLimitExceeded
+		}
+		projectKey := ledgerProjectKey(projectAddress, epoch)
+		projectAllocated, _, err := getLedgerValue(txn, projectKey)
+		if err != nil {
+			return err
+		}
+		if projectAllocated+badgeCu > projectEpochCuLimit {
+			return ErrProjectEpochCuLimitExceeded
+		}
+		err = setLedgerValue(txn, projectKey, projectAllocated+badgeCu)
+		if err != nil {
+			return err
+		}
+		err = setLedgerValue(txn, userKey, userAllocated+badgeCu)
+		if err != nil {
+			return err
+		}
+		allocated = badgeCu
+		return setLedgerValue(txn, badgeKey, badgeCu)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return allocated, nil
+}
+
+// ProjectAllocated returns the CU allocated to all the users of the project in the epoch
+func (cl *CuLedger) ProjectAllocated(projectAddress string, epoch uint64) (allocated uint64, err error) {
+	err = cl.db.View(func(txn *badger.Txn) error {
+		allocated, _, err = getLedgerValue(txn, ledgerProjectKey(projectAddress, epoch))
+		return err
+	})
+	return allocated, err
+}
+
+func (cl *CuLedger) Close() error {
+	return cl.db.Close()
+}
@@ -0,0 +1,59 @@
+package badgegenerator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCuLedgerAllocate(t *testing.T) {
+	ledger, err := NewCuLedger("")
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	allocated, err := ledger.Allocate("project", "user1", "badge1", 10, 100, 100, 250)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), allocated)
+
+	// the same badge in the same epoch gets the same allocation without using more of the user's and project's cu
+	allocated, err = ledger.Allocate("project", "user1", "badge1", 10, 100, 100, 250)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), allocated)
+
+	// another badge of the same user would take the user over its limit
+	_, err = ledger.Allocate("project", "user1", "badge2", 10, 100, 100, 250)
+	require.ErrorIs(t, err, ErrUserEpochCuLimitExceeded)
+
+	allocated, err = ledger.Allocate("project", "user2", "badge2", 10, 100, 100, 250)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), allocated)
+
+	projectAllocated, err := ledger.ProjectAllocated("project", 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), projectAllocated)
+
+	// a third user would take the project over its limit
+	_, err = ledger.Allocate("project", "user3", "badge3", 10, 100, 100, 250)
+	require.ErrorIs(t, err, ErrProjectEpochCuLimitExceeded)
+
+	// a new epoch and other projects are tracked separately
+	_, err = ledger.Allocate("project", "user3", "badge3", 11, 100, 100, 250)
+	require.NoError(t, err)
+	_, err = ledger.Allocate("project2", "user3", "badge3", 10, 100, 100, 250)
+	require.NoError(t, err)
+}
+
+func TestCuLedgerPersistence(t *testing.T) {
+	path := t.TempDir()
+	ledger, err := NewCuLedger(path)
+	require.NoError(t, err)
+	_, err = ledger.Allocate("project", "user1", "badge1", 10, 100, 100, 150)
+	require.NoError(t, err)
+	require.NoError(t, ledger.Close())
+
+	ledger, err = NewCuLedger(path)
+	require.NoError(t, err)
+	defer ledger.Close()
+	_, err = ledger.Allocate("project", "user2", "badge2", 10, 100, 100, 150)
+	require.ErrorIs(t, err, ErrProjectEpochCuLimitExceeded)
+}
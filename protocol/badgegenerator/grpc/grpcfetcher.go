@@ -72,3 +72,30 @@ func (fetcher *GRPCFetcher) FetchPairings(chainId, userId string) (*pairingtypes
 
 	return consumerResponse, nil
 }
+
+// FetchProjectEpochCuLimit returns the CU a project can use in the epoch that started at epochBlock, as enforced by the chain
+func (fetcher *GRPCFetcher) FetchProjectEpochCuLimit(chainId, projectAddress string, epochBlock uint64) (uint64, error) {
+	grpcClient := pairingtypes.NewQueryClient(fetcher.GrpcConn)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), DefaultRequestTimeout)
+	defer cancelFunc()
+
+	userEntryResponse, err := grpcClient.UserEntry(
+		ctx,
+		&pairingtypes.QueryUserEntryRequest{
+			Address: projectAddress,
+			ChainID: chainId,
+			Block:   epochBlock,
+		},
+		retry.WithCodes(codes.DeadlineExceeded),
+		retry.WithBackoff(retry.BackoffLinear(100*time.Millisecond)),
+		retry.WithMax(3))
+	if err != nil {
+		return 0, utils.LavaFormatError("Fetching project epoch cu limit failed.",
+			err,
+			utils.Attribute{Key: "chainId", Value: chainId},
+			utils.Attribute{Key: "projectAddress", Value: projectAddress},
+			utils.Attribute{Key: "epoch", Value: epochBlock})
+	}
+
+	return userEntryResponse.MaxCU, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/netip"
 	"os"
 	"sort"
 	"strconv"
@@ -19,11 +20,13 @@ type IpService struct {
 	DefaultGeolocation int
 	CountryCsvFilePath string
 	IpTsvFilePath      string
+	IpV6TsvFilePath    string
 	IpCountryData      *[]*IpData
+	IpV6CountryData    *[]*IpV6Data
 }
 
-func InitIpService(defaultGeolocation int, countriesFilePath, ipFilePath string) (*IpService, error) {
-	service := IpService{DefaultGeolocation: defaultGeolocation, CountryCsvFilePath: countriesFilePath, IpTsvFilePath: ipFilePath}
+func InitIpService(defaultGeolocation int, countriesFilePath, ipFilePath, ipV6FilePath string) (*IpService, error) {
+	service := IpService{DefaultGeolocation: defaultGeolocation, CountryCsvFilePath: countriesFilePath, IpTsvFilePath: ipFilePath, IpV6TsvFilePath: ipV6FilePath}
 
 	err := service.ReadIpTsvFileData()
 	if err != nil {
@@ -67,18 +70,68 @@ func (service *IpService) ReadIpTsvFileData() error {
 		utils.LavaFormatError("error reading country data.", err)
 		return err
 	}
+	getGeolocation := func(countryCode string) int {
+		geolocation, exist := (*counties)[countryCode]
+		if !exist {
+			geolocation = service.DefaultGeolocation
+		}
+		return geolocation
+	}
+
+	var result []*IpData
 	if len(service.IpTsvFilePath) == 0 {
 		utils.LavaFormatWarning("badge is not configured correctly- missing ip tsv file path.", nil)
-		service.IpCountryData = &[]*IpData{}
-		return nil
+	} else {
+		err = readIpTsvFile(service.IpTsvFilePath, func(rowData string) {
+			ipData, err := convertRowToIpModel(rowData)
+			if err != nil {
+				utils.LavaFormatWarning("error reading ip data", err)
+				return
+			}
+			ipData.Geolocation = getGeolocation(ipData.CountryCode)
+			result = append(result, ipData)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].FromIp < result[j].FromIp
+	})
+	service.IpCountryData = &result
+
+	var resultV6 []*IpV6Data
+	if len(service.IpV6TsvFilePath) == 0 {
+		utils.LavaFormatInfo("no ipv6 tsv file path, ipv6 clients will get the default geolocation")
+	} else {
+		err = readIpTsvFile(service.IpV6TsvFilePath, func(rowData string) {
+			ipData, err := convertRowToIpV6Model(rowData)
+			if err != nil {
+				utils.LavaFormatWarning("error reading ipv6 data", err)
+				return
+			}
+			ipData.Geolocation = getGeolocation(ipData.CountryCode)
+			resultV6 = append(resultV6, ipData)
+		})
+		if err != nil {
+			return err
+		}
 	}
-	file, err := os.Open(service.IpTsvFilePath)
+	sort.Slice(resultV6, func(i, j int) bool {
+		return resultV6[i].FromIp.Less(resultV6[j].FromIp)
+	})
+	service.IpV6CountryData = &resultV6
+	return nil
+}
+
+// readIpTsvFile calls handleRow with every row of an ip2asn tsv file
+func readIpTsvFile(filePath string, handleRow func(rowData string)) error {
+	file, err := os.Open(filePath)
 	if err != nil {
-		utils.LavaFormatError("error opening ip file.", err)
+		utils.LavaFormatError("error opening ip file.", err, utils.Attribute{Key: "path", Value: filePath})
 		return err
 	}
 	defer file.Close()
-	var result []*IpData
 	// Create a new CSV reader
 	reader := csv.NewReader(file)
 	// Read and print each row
@@ -94,27 +147,23 @@ func (service *IpService) ReadIpTsvFileData() error {
 			}
 		}
 		for _, rowData := range row {
-			ipData, err := convertRowToIpModel(rowData)
-			if err != nil {
-				utils.LavaFormatWarning("error reading ip data", err)
-				continue
-			}
-			geolocation, exist := (*counties)[ipData.CountryCode]
-			if !exist {
-				geolocation = service.DefaultGeolocation
-			}
-			ipData.Geolocation = geolocation
-			result = append(result, ipData)
+			handleRow(rowData)
 		}
 	}
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].FromIp < result[j].FromIp
-	})
-	service.IpCountryData = &result
 	return nil
 }
 
+// SearchForIp returns the ip range of an ipv4 or ipv6 address. For ipv6 only the country code and geolocation are set
 func (service *IpService) SearchForIp(toSearchIp string) (*IpData, error) {
+	toSearchIp = strings.Trim(toSearchIp, "[]")
+	ip := net.ParseIP(toSearchIp)
+	if ip != nil && ip.To4() == nil {
+		ipV6Data, err := service.SearchForIpV6(ip)
+		if err != nil {
+			return nil, err
+		}
+		return &IpData{CountryCode: ipV6Data.CountryCode, Geolocation: ipV6Data.Geolocation}, nil
+	}
 	if len(*service.IpCountryData) == 0 {
 		return nil, fmt.Errorf("ip servive not configured correctly")
 	}
@@ -135,13 +184,10 @@ func (service *IpService) SearchForIp(toSearchIp string) (*IpData, error) {
 		}
 	}
 
-	if low == len(haystack) {
-		return nil, fmt.Errorf("ip not found")
-	}
-	if needle >= haystack[low].FromIp && needle <= haystack[low].ToIP {
+	if low < len(haystack) && needle >= haystack[low].FromIp && needle <= haystack[low].ToIP {
 		return haystack[low], nil
 	}
-	if needle >= haystack[high].FromIp && needle <= haystack[high].ToIP {
+	if high >= 0 && needle >= haystack[high].FromIp && needle <= haystack[high].ToIP {
 		return haystack[high], nil
 	}
 
@@ -155,6 +201,58 @@ func (service *IpService) SearchForIp(toSearchIp string) (*IpData, error) {
 	return nil, fmt.Errorf("ip not found")
 }
 
+func (service *IpService) SearchForIpV6(ip net.IP) (*IpV6Data, error) {
+	if service.IpV6CountryData == nil || len(*service.IpV6CountryData) == 0 {
+		return nil, fmt.Errorf("ipv6 data not configured")
+	}
+	needle, ok := netip.AddrFromSlice(ip.To16())
+	if !ok {
+		return nil, fmt.Errorf("invalid ipv6 address")
+	}
+	haystack := *service.IpV6CountryData
+	// the first range that starts after the needle, the needle can only be in the range before it
+	index := sort.Search(len(haystack), func(i int) bool {
+		return needle.Less(haystack[i].FromIp)
+	})
+	if index == 0 {
+		return nil, fmt.Errorf("ip not found")
+	}
+	ipData := haystack[index-1]
+	if needle.Compare(ipData.ToIp) > 0 {
+		return nil, fmt.Errorf("ip not found")
+	}
+	return ipData, nil
+}
+
+func convertRowToIpV6Model(rowData string) (*IpV6Data, error) {
+	ipStringDatas := strings.Split(rowData, "\t")
+	var fromIp, toIp, countryCode string
+	if len(ipStringDatas) == 4 {
+		ipSorce := strings.Split(ipStringDatas[0], " ")
+		if len(ipSorce) != 2 {
+			return nil, fmt.Errorf("unexpeted ip range on  tsv data format. expected 2 separated with space(' ')")
+		}
+		fromIp, toIp, countryCode = ipSorce[0], ipSorce[1], ipStringDatas[2]
+	} else if len(ipStringDatas) == 5 {
+		fromIp, toIp, countryCode = ipStringDatas[0], ipStringDatas[1], ipStringDatas[3]
+	} else {
+		return nil, fmt.Errorf("invalid tsv data format. expected 4")
+	}
+	fromIpData, err := convertStringToIpV6(fromIp)
+	if err != nil {
+		return nil, err
+	}
+	toIpData, err := convertStringToIpV6(toIp)
+	if err != nil {
+		return nil, err
+	}
+	return &IpV6Data{
+		FromIp:      fromIpData,
+		ToIp:        toIpData,
+		CountryCode: countryCode,
+	}, nil
+}
+
 func convertRowToIpModel(rowData string) (*IpData, error) {
 	convertRowWith4tabs := func(ipStringDatas []string) (*IpData, error) {
 		ipSorce := strings.Split(ipStringDatas[0], " ")
@@ -212,3 +310,12 @@ func convertStringToIpInt(sc string) (int64, error) {
 	number, err := ipconv.IPv4ToInt(ip)
 	return int64(number), err
 }
+
+// convertStringToIpV6 parses an ip as a 128 bit address, ipv4 addresses are mapped to ipv6
+func convertStringToIpV6(sc string) (netip.Addr, error) {
+	ip, err := netip.ParseAddr(sc)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom16(ip.As16()), nil
+}
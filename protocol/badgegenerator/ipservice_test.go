@@ -0,0 +1,41 @@
+package badgegenerator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpServiceSearchForIp(t *testing.T) {
+	dir := t.TempDir()
+	countriesPath := filepath.Join(dir, "countries.csv")
+	require.NoError(t, os.WriteFile(countriesPath, []byte("US;United States;NA;1\nDE;Germany;EU;2\n"), 0o600))
+	ipPath := filepath.Join(dir, "ip2asn-v4.tsv")
+	require.NoError(t, os.WriteFile(ipPath, []byte("1.0.0.0\t1.0.0.255\t13335\tUS\tCLOUDFLARENET\n2.0.0.0\t2.0.0.255\t3320\tDE\tDTAG\n"), 0o600))
+	ipV6Path := filepath.Join(dir, "ip2asn-v6.tsv")
+	require.NoError(t, os.WriteFile(ipV6Path, []byte("2001:200::\t2001:200:ffff:ffff:ffff:ffff:ffff:ffff\t2500\tUS\tWIDE\n2003::\t2003:ff:ffff:ffff:ffff:ffff:ffff:ffff\t3320\tDE\tDTAG\n"), 0o600))
+
+	service, err := InitIpService(3, countriesPath, ipPath, ipV6Path)
+	require.NoError(t, err)
+
+	for ip, geolocation := range map[string]int{
+		"1.0.0.10":               1,
+		"2.0.0.10":               2,
+		"2001:200::1":            1,
+		"2003:12:3456::1":        2,
+		"[2003:ff:ffff::1]":      2,
+		"::ffff:2.0.0.1":         2,
+		"2001:200:ffff:ffff::ff": 1,
+	} {
+		ipData, err := service.SearchForIp(ip)
+		require.NoError(t, err, ip)
+		require.Equal(t, geolocation, ipData.Geolocation, ip)
+	}
+
+	for _, ip := range []string{"0.0.0.1", "3.0.0.1", "2001:100::1", "2001:201::", "2004::1"} {
+		_, err := service.SearchForIp(ip)
+		require.Error(t, err, ip)
+	}
+}
@@ -1,13 +1,23 @@
 package badgegenerator
 
-import "github.com/lavanet/lava/x/pairing/types"
+import (
+	"net/netip"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/x/pairing/types"
+)
 
 type ProjectConfiguration struct {
-	ProjectPublicKey  string                                    `json:"project_public_key"`
-	ProjectPrivateKey string                                    `json:"private_key"`
-	EpochsMaxCu       int64                                     `json:"epochs_max_cu"`
-	UpdatedEpoch      map[string]uint64                         `json:"update_epoch,omitempty"`
-	PairingList       map[string]*types.QueryGetPairingResponse `json:"pairing_list,omitempty"`
+	ProjectPublicKey string `json:"project_public_key"`
+	KeyName          string `json:"key_name"` // the name of the project's signing key in the keyring
+	EpochsMaxCu      int64  `json:"epochs_max_cu"`
+	// optional cap on the CU allocated to all the project's users per epoch, below the project's limit on chain
+	ProjectEpochCuLimit uint64 `json:"project_epoch_cu_limit,omitempty"`
+	// optional cap on the CU allocated to all the badges of a user per epoch, defaults to a single badge (epochs_max_cu)
+	UserEpochCuLimit uint64                                    `json:"user_epoch_cu_limit,omitempty"`
+	UpdatedEpoch     map[string]uint64                         `json:"update_epoch,omitempty"`
+	PairingList      map[string]*types.QueryGetPairingResponse `json:"pairing_list,omitempty"`
+	privateKey       *btcSecp256k1.PrivateKey
 }
 
 type UserBadgeItem struct {
@@ -24,3 +34,10 @@ type IpData struct {
 	CountryCode string
 	Geolocation int
 }
+
+type IpV6Data struct {
+	FromIp      netip.Addr
+	ToIp        netip.Addr
+	CountryCode string
+	Geolocation int
+}
@@ -2,15 +2,15 @@ package badgegenerator
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
 
 	"google.golang.org/grpc/metadata"
 
-	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/lavanet/lava/protocol/badgegenerator/grpc"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
@@ -32,14 +32,21 @@ type Server struct {
 	stateTracker          *BadgeStateTracker
 	specs                 map[string]spectypes.Spec // holding the specs for all chains
 	specLock              sync.RWMutex
+	ledger                *CuLedger
+	authenticator         *Authenticator    // nil if requests are not authenticated
+	projectLimits         map[string]uint64 // project address/spec/epoch -> the project's epoch cu limit on chain
+	projectLimitsLock     sync.Mutex
 }
 
-func NewServer(ipService *IpService, grpcUrl, chainId, userData string) (*Server, error) {
+func NewServer(ipService *IpService, grpcUrl, chainId, userData string, ledger *CuLedger, authenticator *Authenticator) (*Server, error) {
 	server := &Server{
 		ProjectsConfiguration: map[string]map[string]*ProjectConfiguration{},
 		ChainId:               chainId,
 		IpService:             ipService,
 		specs:                 map[string]spectypes.Spec{},
+		ledger:                ledger,
+		authenticator:         authenticator,
+		projectLimits:         map[string]uint64{},
 	}
 
 	if userData != "" {
@@ -60,6 +67,37 @@ func NewServer(ipService *IpService, grpcUrl, chainId, userData string) (*Server
 	return server, nil
 }
 
+// LoadSigningKeys loads the signing key of every project from the keyring, so private keys are never part of the configuration
+func (s *Server) LoadSigningKeys(clientCtx client.Context) error {
+	for geolocation, projects := range s.ProjectsConfiguration {
+		for projectId, projectData := range projects {
+			attributes := []utils.Attribute{{Key: "geolocation", Value: geolocation}, {Key: "ProjectId", Value: projectId}, {Key: "keyName", Value: projectData.KeyName}}
+			if projectData.KeyName == "" {
+				return utils.LavaFormatError("project is missing key_name", nil, attributes...)
+			}
+			privateKey, err := sigs.GetPrivKey(clientCtx, projectData.KeyName)
+			if err != nil {
+				return utils.LavaFormatError("failed getting project private key from keyring", err, attributes...)
+			}
+			keyRecord, err := clientCtx.Keyring.Key(projectData.KeyName)
+			if err != nil {
+				return utils.LavaFormatError("failed getting project key from keyring", err, attributes...)
+			}
+			address, err := keyRecord.GetAddress()
+			if err != nil {
+				return utils.LavaFormatError("failed getting project key address", err, attributes...)
+			}
+			if projectData.ProjectPublicKey == "" {
+				projectData.ProjectPublicKey = address.String()
+			} else if projectData.ProjectPublicKey != address.String() {
+				return utils.LavaFormatError("project_public_key does not match the address of the key", nil, append(attributes, utils.Attribute{Key: "address", Value: address.String()})...)
+			}
+			projectData.privateKey = privateKey
+		}
+	}
+	return nil
+}
+
 func (s *Server) GetUniqueName() string {
 	return "badge_server"
 }
@@ -80,6 +118,10 @@ func (s *Server) SetSpec(specUpdate spectypes.Spec) {
 func (s *Server) UpdateEpoch(epoch uint64) {
 	utils.LavaFormatDebug("Got epoch update", utils.Attribute{Key: "epoch", Value: epoch})
 	atomic.StoreUint64(&s.epoch, epoch)
+	// the limits are fetched per epoch, drop the ones of the previous epochs
+	s.projectLimitsLock.Lock()
+	s.projectLimits = map[string]uint64{}
+	s.projectLimitsLock.Unlock()
 }
 
 func (s *Server) GetEpoch() uint64 {
@@ -124,14 +166,33 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 	if len(clientAddress) > 0 {
 		ipAddress = clientAddress[0]
 	}
+	var claims *AuthClaims
+	if s.authenticator != nil {
+		claims, err = s.authenticator.Authenticate(ctx)
+		if err != nil {
+			s.metrics.AddRequest(false)
+			return nil, err
+		}
+		if claims != nil {
+			// the project of an authenticated request is taken from the token, not from the request
+			req.ProjectId = claims.ProjectId
+		}
+	}
+	userId := s.authenticator.userId(ctx, claims)
 	projectData, err := s.validateRequest(ipAddress, req)
 	if err != nil {
 		s.metrics.AddRequest(false)
 		return nil, err
 	}
+	epoch := s.GetEpoch()
+	cuAllocation, err := s.allocateCu(req, userId, projectData, epoch)
+	if err != nil {
+		s.metrics.AddRequest(false)
+		return nil, err
+	}
 	badge := pairingtypes.Badge{
-		CuAllocation: uint64(projectData.EpochsMaxCu),
-		Epoch:        s.GetEpoch(),
+		CuAllocation: cuAllocation,
+		Epoch:        epoch,
 		Address:      req.BadgeAddress,
 		LavaChainId:  s.ChainId,
 		VirtualEpoch: s.stateTracker.GetLatestVirtualEpoch(),
@@ -149,7 +210,7 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 		return nil, err
 	}
 
-	err = signTheResponse(projectData.ProjectPrivateKey, &result)
+	err = signTheResponse(projectData, &result)
 	if err != nil {
 		s.metrics.AddRequest(false)
 		return nil, err
@@ -158,6 +219,63 @@ func (s *Server) GenerateBadge(ctx context.Context, req *pairingtypes.GenerateBa
 	return &result, nil
 }
 
+// getProjectEpochCuLimit returns the CU the project can use in the epoch, the lower of its limit on chain and its configured limit
+func (s *Server) getProjectEpochCuLimit(specId string, projectData *ProjectConfiguration, epoch uint64) (uint64, error) {
+	key := fmt.Sprintf("%s/%s/%d", projectData.ProjectPublicKey, specId, epoch)
+	s.projectLimitsLock.Lock()
+	limit, found := s.projectLimits[key]
+	s.projectLimitsLock.Unlock()
+	if !found {
+		var err error
+		limit, err = s.grpcFetcher.FetchProjectEpochCuLimit(specId, projectData.ProjectPublicKey, epoch)
+		if err != nil {
+			return 0, err
+		}
+		s.projectLimitsLock.Lock()
+		s.projectLimits[key] = limit
+		s.projectLimitsLock.Unlock()
+	}
+	if projectData.ProjectEpochCuLimit > 0 && projectData.ProjectEpochCuLimit < limit {
+		limit = projectData.ProjectEpochCuLimit
+	}
+	return limit, nil
+}
+
+// allocateCu records the badge's CU in the ledger and returns the CU to put in the badge
+func (s *Server) allocateCu(req *pairingtypes.GenerateBadgeRequest, userId string, projectData *ProjectConfiguration, epoch uint64) (uint64, error) {
+	if projectData.EpochsMaxCu <= 0 {
+		return 0, utils.LavaFormatError("invalid project configuration, epochs_max_cu must be positive", nil,
+			utils.Attribute{Key: "ProjectId", Value: req.ProjectId})
+	}
+	projectLimit, err := s.getProjectEpochCuLimit(req.SpecId, projectData, epoch)
+	if err != nil {
+		return 0, err
+	}
+	userLimit := projectData.UserEpochCuLimit
+	if userLimit == 0 {
+		userLimit = uint64(projectData.EpochsMaxCu)
+	}
+	allocated, err := s.ledger.Allocate(projectData.ProjectPublicKey, userId, req.BadgeAddress, epoch, uint64(projectData.EpochsMaxCu), userLimit, projectLimit)
+	if err != nil {
+		attributes := []utils.Attribute{
+			{Key: "BadgeAddress", Value: req.BadgeAddress},
+			{Key: "UserId", Value: userId},
+			{Key: "ProjectId", Value: req.ProjectId},
+			{Key: "epoch", Value: epoch},
+			{Key: "projectEpochCuLimit", Value: projectLimit},
+			{Key: "userEpochCuLimit", Value: userLimit},
+		}
+		if errors.Is(err, ErrProjectEpochCuLimitExceeded) {
+			return 0, utils.LavaFormatWarning("refusing badge, the project's epoch cu limit would be exceeded", err, attributes...)
+		}
+		if errors.Is(err, ErrUserEpochCuLimitExceeded) {
+			return 0, utils.LavaFormatWarning("refusing badge, the user's epoch cu limit would be exceeded", err, attributes...)
+		}
+		return 0, utils.LavaFormatError("failed allocating badge cu in the ledger", err, attributes...)
+	}
+	return allocated, nil
+}
+
 func (s *Server) validateRequest(clientAddress string, in *pairingtypes.GenerateBadgeRequest) (*ProjectConfiguration, error) {
 	if in == nil {
 		err := fmt.Errorf("invalid request, no input data provided")
@@ -268,10 +386,11 @@ func (s *Server) addPairingListToResponse(request *pairingtypes.GenerateBadgeReq
 }
 
 // note this update the signature of the response
-func signTheResponse(privateKeyString string, response *pairingtypes.GenerateBadgeResponse) error {
-	privateKeyBytes, _ := hex.DecodeString(privateKeyString)
-	privateKey, _ := btcSecp256k1.PrivKeyFromBytes(btcSecp256k1.S256(), privateKeyBytes)
-	signature, err := sigs.Sign(privateKey, *response.Badge)
+func signTheResponse(projectData *ProjectConfiguration, response *pairingtypes.GenerateBadgeResponse) error {
+	if projectData.privateKey == nil {
+		return utils.LavaFormatError("project signing key was not loaded", nil, utils.Attribute{Key: "keyName", Value: projectData.KeyName})
+	}
+	signature, err := sigs.Sign(projectData.privateKey, *response.Badge)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 	epochstorage "github.com/lavanet/lava/x/epochstorage/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -20,16 +21,17 @@ type PolicyInf interface {
 }
 
 type BaseChainParser struct {
-	taggedApis      map[spectypes.FUNCTION_TAG]TaggedContainer
-	spec            spectypes.Spec
-	rwLock          sync.RWMutex
-	serverApis      map[ApiKey]ApiContainer
-	apiCollections  map[CollectionKey]*spectypes.ApiCollection
-	headers         map[ApiKey]*spectypes.Header
-	verifications   map[VerificationKey][]VerificationContainer
-	allowedAddons   map[string]bool
-	extensionParser extensionslib.ExtensionParser
-	active          bool
+	taggedApis        map[spectypes.FUNCTION_TAG]TaggedContainer
+	spec              spectypes.Spec
+	rwLock            sync.RWMutex
+	serverApis        map[ApiKey]ApiContainer
+	apiCollections    map[CollectionKey]*spectypes.ApiCollection
+	headers           map[ApiKey]*spectypes.Header
+	verifications     map[VerificationKey][]VerificationContainer
+	allowedAddons     map[string]bool
+	extensionParser   extensionslib.ExtensionParser
+	active            bool
+	blockHashResolver BlockHashResolver
 }
 
 func (bcp *BaseChainParser) Activate() {
@@ -40,6 +42,50 @@ func (bcp *BaseChainParser) Active() bool {
 	return bcp.active
 }
 
+func (bcp *BaseChainParser) SetBlockHashResolver(resolver BlockHashResolver) {
+	bcp.rwLock.Lock()
+	defer bcp.rwLock.Unlock()
+	bcp.blockHashResolver = resolver
+}
+
+// parseRequestedBlock parses the requested block from the params, a requested block hash is resolved to its height
+// a hash that can't be resolved is NOT_APPLICABLE as before
+func (bcp *BaseChainParser) parseRequestedBlock(rpcInput parser.RPCInput, blockParser spectypes.BlockParser) (int64, error) {
+	requestedBlock, blockHash, err := parser.ParseBlockOrHashFromParams(rpcInput, blockParser)
+	if err != nil || blockHash == "" {
+		return requestedBlock, err
+	}
+	bcp.rwLock.RLock()
+	resolver := bcp.blockHashResolver
+	bcp.rwLock.RUnlock()
+	if resolver == nil {
+		return spectypes.NOT_APPLICABLE, nil
+	}
+	for _, encodedHash := range parser.BlockHashEncodings(blockHash) {
+		if blockNum, found := resolver.GetBlockNumByHash(encodedHash); found {
+			return blockNum, nil
+		}
+	}
+	utils.LavaFormatDebug("requested block hash not found", utils.Attribute{Key: "hash", Value: blockHash}, utils.Attribute{Key: "chain", Value: bcp.spec.Index})
+	return spectypes.NOT_APPLICABLE, nil
+}
+
+// parseRequestedBlockRange parses the earliest block of an api that requests a range of blocks, e.g. eth_getLogs fromBlock
+// and toBlock, and returns the latest and earliest blocks of the range. if the earliest block can't be parsed the range
+// is only the requested block
+func (bcp *BaseChainParser) parseRequestedBlockRange(rpcInput parser.RPCInput, earliestBlockParsing spectypes.BlockParser, requestedBlock int64) (latestRequestedBlock int64, earliestRequestedBlock int64) {
+	earliestRequestedBlock, err := bcp.parseRequestedBlock(rpcInput, earliestBlockParsing)
+	if err != nil {
+		utils.LavaFormatError("ParseBlockFromParams failed parsing earliest block", err, utils.Attribute{Key: "chain", Value: bcp.spec.Name}, utils.Attribute{Key: "earliestBlockParsing", Value: earliestBlockParsing})
+		return requestedBlock, requestedBlock
+	}
+	if earliestRequestedBlock == 0 {
+		// a zero earliest block means it wasn't set, the genesis block is the earliest block
+		earliestRequestedBlock = spectypes.EARLIEST_BLOCK
+	}
+	return CompareRequestedBlockInBatch(requestedBlock, earliestRequestedBlock)
+}
+
 func (bcp *BaseChainParser) UpdateBlockTime(newBlockTime time.Duration) {
 	bcp.rwLock.Lock()
 	defer bcp.rwLock.Unlock()
@@ -1,6 +1,9 @@
 package chainlib
 
-import "github.com/lavanet/lava/protocol/common"
+import (
+	"github.com/lavanet/lava/protocol/common"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
 
 func ShouldSendToAllProviders(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.Stateful == common.CONSISTENCY_SELECT_ALLPROVIDERS
@@ -14,6 +17,11 @@ func IsSubscription(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.Subscription
 }
 
+// IsStreamingApiInterface returns whether subscriptions of the api interface are node streams, unlike the json-rpc subscriptions
+func IsStreamingApiInterface(apiInterface string) bool {
+	return apiInterface == spectypes.APIInterfaceGrpc || apiInterface == spectypes.APIInterfaceRest
+}
+
 func IsHangingApi(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.HangingApi
 }
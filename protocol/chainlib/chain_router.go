@@ -7,8 +7,10 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type chainRouterEntry struct {
@@ -64,8 +66,14 @@ func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if err != nil {
 		return nil, "", nil, common.NodeUrl{}, "", err
 	}
-	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
 	proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
+	ctx, span := metrics.StartSpan(ctx, "SendNodeMsg",
+		attribute.String("lava.chain_id", chainId),
+		attribute.String("lava.addon", addon),
+		attribute.StringSlice("lava.extensions", extensions),
+	)
+	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	metrics.EndSpan(span, err)
 	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
 }
 
@@ -25,6 +25,8 @@ func NewChainParser(apiInterface string) (chainParser ChainParser, err error) {
 		return NewRestChainParser()
 	case spectypes.APIInterfaceGrpc:
 		return NewGrpcChainParser()
+	case spectypes.APIInterfaceGraphQL:
+		return NewGraphQLChainParser()
 	}
 	return nil, fmt.Errorf("chainParser for apiInterface (%s) not found", apiInterface)
 }
@@ -46,6 +48,8 @@ func NewChainListener(
 		return NewRestChainListener(ctx, listenEndpoint, relaySender, healthReporter, rpcConsumerLogs), nil
 	case spectypes.APIInterfaceGrpc:
 		return NewGrpcChainListener(ctx, listenEndpoint, relaySender, rpcConsumerLogs, chainParser), nil
+	case spectypes.APIInterfaceGraphQL:
+		return NewGraphQLChainListener(ctx, listenEndpoint, relaySender, healthReporter, rpcConsumerLogs), nil
 	}
 	return nil, fmt.Errorf("chainListener for apiInterface (%s) not found", listenEndpoint.ApiInterface)
 }
@@ -63,11 +67,17 @@ type ChainParser interface {
 	SetPolicy(policy PolicyInf, chainId string, apiInterface string) error
 	Active() bool
 	Activate()
+	SetBlockHashResolver(resolver BlockHashResolver)
 	UpdateBlockTime(newBlockTime time.Duration)
 	GetUniqueName() string
 	ExtensionsParser() *extensionslib.ExtensionParser
 }
 
+// BlockHashResolver maps a block hash to its height, used for requests addressing a block by its hash
+type BlockHashResolver interface {
+	GetBlockNumByHash(blockHash string) (blockNum int64, found bool)
+}
+
 type ChainMessage interface {
 	RequestedBlock() (latest int64, earliest int64)
 	UpdateLatestBlockInMessage(latestBlock int64, modifyContent bool) (modified bool)
@@ -127,6 +137,8 @@ func GetChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint *lavas
 		proxyConstructor = NewRestChainProxy
 	case spectypes.APIInterfaceGrpc:
 		proxyConstructor = NewGrpcChainProxy
+	case spectypes.APIInterfaceGraphQL:
+		proxyConstructor = NewGraphQLChainProxy
 	default:
 		return nil, fmt.Errorf("chain proxy for apiInterface (%s) not found", rpcProviderEndpoint.ApiInterface)
 	}
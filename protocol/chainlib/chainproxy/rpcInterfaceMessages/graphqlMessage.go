@@ -0,0 +1,942 @@
+package rpcInterfaceMessages
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	GraphQLOperationQuery        = "query"
+	GraphQLOperationMutation     = "mutation"
+	GraphQLOperationSubscription = "subscription"
+
+	graphQLTypenameField = "__typename"
+	// fragments can spread each other several times, so a small document can expand to a huge operation.
+	// the expansion is bounded by the selections it visits and by the nesting of the fragments
+	maxGraphQLExpandedSelections = 1000
+	maxGraphQLFragmentDepth      = 10
+	// values, types and inline fragments are parsed recursively, so their nesting is bounded to keep a document
+	// from exhausting the stack
+	maxGraphQLNestingDepth = 64
+)
+
+type GraphQLMessage struct {
+	Query                  string                 `json:"query"`
+	OperationName          string                 `json:"operationName,omitempty"`
+	Variables              map[string]interface{} `json:"variables,omitempty"`
+	chainproxy.BaseMessage `json:"-"`
+}
+
+// GraphQLField is a top level field of a graphql operation, it identifies the api used in the spec
+type GraphQLField struct {
+	Alias     string
+	Name      string
+	Arguments map[string]interface{}
+}
+
+// GraphQLOperation is the operation selected for execution out of a graphql document
+type GraphQLOperation struct {
+	Type   string
+	Name   string
+	Fields []GraphQLField
+}
+
+// ParseGraphQLMsg unmarshals a graphql http request body
+func ParseGraphQLMsg(data []byte) (*GraphQLMessage, error) {
+	msg := &GraphQLMessage{}
+	err := json.Unmarshal(data, msg)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(msg.Query) == "" {
+		return nil, fmt.Errorf("graphql request is missing a query")
+	}
+	return msg, nil
+}
+
+// GetParams returns the request variables
+func (gm GraphQLMessage) GetParams() interface{} {
+	if len(gm.Variables) == 0 {
+		return nil
+	}
+	return gm.Variables
+}
+
+func (gm GraphQLMessage) GetResult() json.RawMessage {
+	return nil
+}
+
+func (gm GraphQLMessage) ParseBlock(inp string) (int64, error) {
+	return parser.ParseDefaultBlockParameter(inp)
+}
+
+func (gm *GraphQLMessage) UpdateLatestBlockInMessage(latestBlock uint64, modifyContent bool) (success bool) {
+	return false
+}
+
+// NewParsableRPCInput extracts the data of a graphql response so parsing doesn't depend on the response envelope
+func (gm GraphQLMessage) NewParsableRPCInput(input json.RawMessage) (parser.RPCInput, error) {
+	response := struct {
+		Data   json.RawMessage `json:"data"`
+		Errors json.RawMessage `json:"errors"`
+	}{}
+	err := json.Unmarshal(input, &response)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed unmarshaling GraphQL response", err, utils.Attribute{Key: "input", Value: input})
+	}
+	if len(response.Errors) > 0 && (len(response.Data) == 0 || string(response.Data) == "null") {
+		return nil, utils.LavaFormatError("response is an error message", nil, utils.Attribute{Key: "errors", Value: string(response.Errors)})
+	}
+	return ParsableRPCInput{Result: response.Data}, nil
+}
+
+// ParseOperation returns the operation executed by the request and its top level fields
+func (gm GraphQLMessage) ParseOperation() (*GraphQLOperation, error) {
+	return ParseGraphQLOperation(gm.Query, gm.OperationName, gm.Variables)
+}
+
+// GraphQLFieldInput exposes the arguments of a single top level field for block parsing
+type GraphQLFieldInput struct {
+	Field   GraphQLField
+	Message *GraphQLMessage
+}
+
+func (gfi GraphQLFieldInput) GetParams() interface{} {
+	if len(gfi.Field.Arguments) == 0 {
+		return nil
+	}
+	return gfi.Field.Arguments
+}
+
+func (gfi GraphQLFieldInput) GetResult() json.RawMessage {
+	return nil
+}
+
+func (gfi GraphQLFieldInput) ParseBlock(inp string) (int64, error) {
+	return parser.ParseDefaultBlockParameter(inp)
+}
+
+func (gfi GraphQLFieldInput) GetHeaders() []pairingtypes.Metadata {
+	if gfi.Message == nil {
+		return nil
+	}
+	return gfi.Message.GetHeaders()
+}
+
+// ParseGraphQLOperation parses a graphql document and returns the top level fields of the selected operation
+// fragment spreads and inline fragments on the root selection set are expanded, argument values are resolved
+// including variables, and nested selection sets are skipped since only the root fields identify the api
+func ParseGraphQLOperation(document string, operationName string, variables map[string]interface{}) (*GraphQLOperation, error) {
+	gp := &graphqlParser{lexer: graphqlLexer{source: document}}
+	err := gp.advance()
+	if err != nil {
+		return nil, err
+	}
+	operations := []*graphqlOperationDefinition{}
+	fragments := map[string][]graphqlSelection{}
+	for gp.token.kind != graphqlTokenEOF {
+		if gp.token.kind == graphqlTokenName && gp.token.value == "fragment" {
+			name, selections, err := gp.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := fragments[name]; ok {
+				return nil, fmt.Errorf("graphql fragment %s is defined more than once", name)
+			}
+			fragments[name] = selections
+			continue
+		}
+		operation, err := gp.parseOperationDefinition()
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, operation)
+	}
+
+	var selected *graphqlOperationDefinition
+	switch {
+	case len(operations) == 0:
+		return nil, fmt.Errorf("graphql document has no operations")
+	case operationName != "":
+		for _, operation := range operations {
+			if operation.name == operationName {
+				selected = operation
+				break
+			}
+		}
+		if selected == nil {
+			return nil, fmt.Errorf("graphql operation %s not found in document", operationName)
+		}
+	case len(operations) == 1:
+		selected = operations[0]
+	default:
+		return nil, fmt.Errorf("graphql document has multiple operations, an operationName is required")
+	}
+
+	resolvedVariables := map[string]interface{}{}
+	for name, value := range selected.variableDefaults {
+		resolvedVariables[name] = value
+	}
+	for name, value := range variables {
+		resolvedVariables[name] = value
+	}
+	expander := &graphqlSelectionExpander{fragments: fragments, variables: resolvedVariables, visiting: map[string]struct{}{}, fields: []GraphQLField{}}
+	err = expander.expand(selected.selections, 0)
+	if err != nil {
+		return nil, err
+	}
+	fields := expander.fields
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("graphql operation has no fields")
+	}
+	return &GraphQLOperation{Type: selected.operationType, Name: selected.name, Fields: fields}, nil
+}
+
+// graphqlSelectionExpander collects the fields of a selection set, expanding its fragments
+type graphqlSelectionExpander struct {
+	fragments map[string][]graphqlSelection
+	variables map[string]interface{}
+	visiting  map[string]struct{} // the fragments being expanded, to detect cycles
+	visited   int                 // the selections visited so far, including the ones of repeated fragments
+	fields    []GraphQLField
+}
+
+func (ge *graphqlSelectionExpander) expand(selections []graphqlSelection, depth int) error {
+	if depth > maxGraphQLFragmentDepth {
+		return fmt.Errorf("graphql fragments are nested deeper than %d", maxGraphQLFragmentDepth)
+	}
+	for _, selection := range selections {
+		ge.visited++
+		if ge.visited > maxGraphQLExpandedSelections {
+			return fmt.Errorf("graphql operation expands to more than %d selections", maxGraphQLExpandedSelections)
+		}
+		switch {
+		case selection.field != nil:
+			if selection.field.name == graphQLTypenameField {
+				continue
+			}
+			field := GraphQLField{Alias: selection.field.alias, Name: selection.field.name}
+			if arguments, ok := resolveGraphQLValue(selection.field.arguments, ge.variables).(map[string]interface{}); ok && len(arguments) > 0 {
+				field.Arguments = arguments
+			}
+			ge.fields = append(ge.fields, field)
+		case selection.fragmentSpread != "":
+			fragment, ok := ge.fragments[selection.fragmentSpread]
+			if !ok {
+				return fmt.Errorf("graphql fragment %s is not defined", selection.fragmentSpread)
+			}
+			if _, ok := ge.visiting[selection.fragmentSpread]; ok {
+				return fmt.Errorf("graphql fragment %s spreads itself", selection.fragmentSpread)
+			}
+			ge.visiting[selection.fragmentSpread] = struct{}{}
+			err := ge.expand(fragment, depth+1)
+			delete(ge.visiting, selection.fragmentSpread)
+			if err != nil {
+				return err
+			}
+		default:
+			err := ge.expand(selection.inlineFragment, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// graphqlVariable is a reference to a variable in an argument value, it is resolved once the operation is selected
+type graphqlVariable string
+
+func resolveGraphQLValue(value interface{}, variables map[string]interface{}) interface{} {
+	switch typed := value.(type) {
+	case graphqlVariable:
+		return variables[string(typed)]
+	case []interface{}:
+		resolved := make([]interface{}, len(typed))
+		for idx, item := range typed {
+			resolved[idx] = resolveGraphQLValue(item, variables)
+		}
+		return resolved
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			resolved[key] = resolveGraphQLValue(item, variables)
+		}
+		return resolved
+	default:
+		return value
+	}
+}
+
+type graphqlFieldSelection struct {
+	alias     string
+	name      string
+	arguments map[string]interface{}
+}
+
+// graphqlSelection is one of a field, a fragment spread or an inline fragment
+type graphqlSelection struct {
+	field          *graphqlFieldSelection
+	fragmentSpread string
+	inlineFragment []graphqlSelection
+}
+
+type graphqlOperationDefinition struct {
+	operationType    string
+	name             string
+	variableDefaults map[string]interface{}
+	selections       []graphqlSelection
+}
+
+type graphqlParser struct {
+	lexer graphqlLexer
+	token graphqlToken
+}
+
+func (gp *graphqlParser) advance() error {
+	token, err := gp.lexer.next()
+	if err != nil {
+		return err
+	}
+	gp.token = token
+	return nil
+}
+
+func (gp *graphqlParser) peekPunctuator(punctuator string) bool {
+	return gp.token.kind == graphqlTokenPunctuator && gp.token.value == punctuator
+}
+
+func (gp *graphqlParser) expectPunctuator(punctuator string) error {
+	if !gp.peekPunctuator(punctuator) {
+		return gp.unexpected("expected " + punctuator)
+	}
+	return gp.advance()
+}
+
+func (gp *graphqlParser) expectName() (string, error) {
+	if gp.token.kind != graphqlTokenName {
+		return "", gp.unexpected("expected a name")
+	}
+	name := gp.token.value
+	return name, gp.advance()
+}
+
+func (gp *graphqlParser) checkNestingDepth(depth int) error {
+	if depth > maxGraphQLNestingDepth {
+		return fmt.Errorf("graphql syntax error: nested deeper than %d at position %d", maxGraphQLNestingDepth, gp.token.position)
+	}
+	return nil
+}
+
+func (gp *graphqlParser) unexpected(reason string) error {
+	if gp.token.kind == graphqlTokenEOF {
+		return fmt.Errorf("graphql syntax error: %s, got end of document", reason)
+	}
+	return fmt.Errorf("graphql syntax error: %s, got %q at position %d", reason, gp.token.value, gp.token.position)
+}
+
+func (gp *graphqlParser) parseOperationDefinition() (*graphqlOperationDefinition, error) {
+	operation := &graphqlOperationDefinition{operationType: GraphQLOperationQuery, variableDefaults: map[string]interface{}{}}
+	if gp.peekPunctuator("{") {
+		// query shorthand
+		selections, err := gp.parseSelectionSet(0)
+		if err != nil {
+			return nil, err
+		}
+		operation.selections = selections
+		return operation, nil
+	}
+	if gp.token.kind != graphqlTokenName {
+		return nil, gp.unexpected("expected an operation")
+	}
+	switch gp.token.value {
+	case GraphQLOperationQuery, GraphQLOperationMutation, GraphQLOperationSubscription:
+		operation.operationType = gp.token.value
+	default:
+		return nil, gp.unexpected("unsupported definition")
+	}
+	err := gp.advance()
+	if err != nil {
+		return nil, err
+	}
+	if gp.token.kind == graphqlTokenName {
+		operation.name = gp.token.value
+		err = gp.advance()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if gp.peekPunctuator("(") {
+		err = gp.parseVariableDefinitions(operation.variableDefaults)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = gp.skipDirectives()
+	if err != nil {
+		return nil, err
+	}
+	operation.selections, err = gp.parseSelectionSet(0)
+	if err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+func (gp *graphqlParser) parseFragmentDefinition() (string, []graphqlSelection, error) {
+	// consume the fragment keyword
+	err := gp.advance()
+	if err != nil {
+		return "", nil, err
+	}
+	name, err := gp.expectName()
+	if err != nil {
+		return "", nil, err
+	}
+	if gp.token.kind != graphqlTokenName || gp.token.value != "on" {
+		return "", nil, gp.unexpected("expected a type condition")
+	}
+	err = gp.advance()
+	if err != nil {
+		return "", nil, err
+	}
+	_, err = gp.expectName()
+	if err != nil {
+		return "", nil, err
+	}
+	err = gp.skipDirectives()
+	if err != nil {
+		return "", nil, err
+	}
+	selections, err := gp.parseSelectionSet(0)
+	return name, selections, err
+}
+
+// parseVariableDefinitions reads ($name: Type = default, ...) and stores the default values
+func (gp *graphqlParser) parseVariableDefinitions(defaults map[string]interface{}) error {
+	err := gp.expectPunctuator("(")
+	if err != nil {
+		return err
+	}
+	for !gp.peekPunctuator(")") {
+		err = gp.expectPunctuator("$")
+		if err != nil {
+			return err
+		}
+		name, err := gp.expectName()
+		if err != nil {
+			return err
+		}
+		err = gp.expectPunctuator(":")
+		if err != nil {
+			return err
+		}
+		err = gp.skipType(0)
+		if err != nil {
+			return err
+		}
+		if gp.peekPunctuator("=") {
+			err = gp.advance()
+			if err != nil {
+				return err
+			}
+			value, err := gp.parseValue(true, 0)
+			if err != nil {
+				return err
+			}
+			defaults[name] = value
+		}
+		err = gp.skipDirectives()
+		if err != nil {
+			return err
+		}
+	}
+	return gp.advance()
+}
+
+func (gp *graphqlParser) skipType(depth int) error {
+	err := gp.checkNestingDepth(depth)
+	if err != nil {
+		return err
+	}
+	if gp.peekPunctuator("[") {
+		err = gp.advance()
+		if err != nil {
+			return err
+		}
+		err = gp.skipType(depth + 1)
+		if err != nil {
+			return err
+		}
+		err = gp.expectPunctuator("]")
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := gp.expectName()
+		if err != nil {
+			return err
+		}
+	}
+	if gp.peekPunctuator("!") {
+		return gp.advance()
+	}
+	return nil
+}
+
+func (gp *graphqlParser) skipDirectives() error {
+	for gp.peekPunctuator("@") {
+		err := gp.advance()
+		if err != nil {
+			return err
+		}
+		_, err = gp.expectName()
+		if err != nil {
+			return err
+		}
+		if gp.peekPunctuator("(") {
+			_, err = gp.parseArguments()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (gp *graphqlParser) parseSelectionSet(depth int) ([]graphqlSelection, error) {
+	err := gp.checkNestingDepth(depth)
+	if err != nil {
+		return nil, err
+	}
+	err = gp.expectPunctuator("{")
+	if err != nil {
+		return nil, err
+	}
+	selections := []graphqlSelection{}
+	for !gp.peekPunctuator("}") {
+		selection, err := gp.parseSelection(depth)
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	if len(selections) == 0 {
+		return nil, gp.unexpected("expected a selection")
+	}
+	return selections, gp.advance()
+}
+
+func (gp *graphqlParser) parseSelection(depth int) (graphqlSelection, error) {
+	if gp.peekPunctuator("...") {
+		err := gp.advance()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+		if gp.token.kind == graphqlTokenName && gp.token.value != "on" {
+			// fragment spread
+			name := gp.token.value
+			err = gp.advance()
+			if err != nil {
+				return graphqlSelection{}, err
+			}
+			return graphqlSelection{fragmentSpread: name}, gp.skipDirectives()
+		}
+		if gp.token.kind == graphqlTokenName {
+			// type condition of an inline fragment
+			err = gp.advance()
+			if err != nil {
+				return graphqlSelection{}, err
+			}
+			_, err = gp.expectName()
+			if err != nil {
+				return graphqlSelection{}, err
+			}
+		}
+		err = gp.skipDirectives()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+		selections, err := gp.parseSelectionSet(depth + 1)
+		return graphqlSelection{inlineFragment: selections}, err
+	}
+
+	field := &graphqlFieldSelection{}
+	name, err := gp.expectName()
+	if err != nil {
+		return graphqlSelection{}, err
+	}
+	if gp.peekPunctuator(":") {
+		err = gp.advance()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+		field.alias = name
+		name, err = gp.expectName()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+	}
+	field.name = name
+	if gp.peekPunctuator("(") {
+		field.arguments, err = gp.parseArguments()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+	}
+	err = gp.skipDirectives()
+	if err != nil {
+		return graphqlSelection{}, err
+	}
+	if gp.peekPunctuator("{") {
+		err = gp.skipSelectionSet()
+		if err != nil {
+			return graphqlSelection{}, err
+		}
+	}
+	return graphqlSelection{field: field}, nil
+}
+
+// skipSelectionSet consumes a nested selection set, the lexer validates tokens so strings containing braces are handled
+func (gp *graphqlParser) skipSelectionSet() error {
+	depth := 0
+	for {
+		switch {
+		case gp.token.kind == graphqlTokenEOF:
+			return gp.unexpected("unterminated selection set")
+		case gp.peekPunctuator("{"):
+			depth++
+		case gp.peekPunctuator("}"):
+			depth--
+		}
+		err := gp.advance()
+		if err != nil {
+			return err
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func (gp *graphqlParser) parseArguments() (map[string]interface{}, error) {
+	err := gp.expectPunctuator("(")
+	if err != nil {
+		return nil, err
+	}
+	arguments := map[string]interface{}{}
+	for !gp.peekPunctuator(")") {
+		name, err := gp.expectName()
+		if err != nil {
+			return nil, err
+		}
+		err = gp.expectPunctuator(":")
+		if err != nil {
+			return nil, err
+		}
+		value, err := gp.parseValue(false, 0)
+		if err != nil {
+			return nil, err
+		}
+		arguments[name] = value
+	}
+	return arguments, gp.advance()
+}
+
+// parseValue reads an input value, variables are kept as references unless the value must be constant
+func (gp *graphqlParser) parseValue(constant bool, depth int) (interface{}, error) {
+	err := gp.checkNestingDepth(depth)
+	if err != nil {
+		return nil, err
+	}
+	token := gp.token
+	switch token.kind {
+	case graphqlTokenInt:
+		value, err := strconv.ParseInt(token.value, 10, 64)
+		if err != nil {
+			// integers out of range are kept as is
+			return token.value, gp.advance()
+		}
+		return value, gp.advance()
+	case graphqlTokenFloat:
+		value, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("graphql syntax error: invalid float %s", token.value)
+		}
+		return value, gp.advance()
+	case graphqlTokenString:
+		return token.value, gp.advance()
+	case graphqlTokenName:
+		var value interface{}
+		switch token.value {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			// enum values are represented by their name
+			value = token.value
+		}
+		return value, gp.advance()
+	case graphqlTokenPunctuator:
+		switch token.value {
+		case "$":
+			if constant {
+				return nil, gp.unexpected("variables are not allowed in constant values")
+			}
+			err := gp.advance()
+			if err != nil {
+				return nil, err
+			}
+			name, err := gp.expectName()
+			return graphqlVariable(name), err
+		case "[":
+			err := gp.advance()
+			if err != nil {
+				return nil, err
+			}
+			list := []interface{}{}
+			for !gp.peekPunctuator("]") {
+				item, err := gp.parseValue(constant, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, item)
+			}
+			return list, gp.advance()
+		case "{":
+			err := gp.advance()
+			if err != nil {
+				return nil, err
+			}
+			object := map[string]interface{}{}
+			for !gp.peekPunctuator("}") {
+				name, err := gp.expectName()
+				if err != nil {
+					return nil, err
+				}
+				err = gp.expectPunctuator(":")
+				if err != nil {
+					return nil, err
+				}
+				item, err := gp.parseValue(constant, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				object[name] = item
+			}
+			return object, gp.advance()
+		}
+	}
+	return nil, gp.unexpected("expected a value")
+}
+
+type graphqlTokenKind int
+
+const (
+	graphqlTokenEOF graphqlTokenKind = iota
+	graphqlTokenPunctuator
+	graphqlTokenName
+	graphqlTokenInt
+	graphqlTokenFloat
+	graphqlTokenString
+)
+
+type graphqlToken struct {
+	kind     graphqlTokenKind
+	value    string
+	position int
+}
+
+type graphqlLexer struct {
+	source   string
+	position int
+}
+
+func (gl *graphqlLexer) next() (graphqlToken, error) {
+	gl.skipIgnored()
+	start := gl.position
+	if start >= len(gl.source) {
+		return graphqlToken{kind: graphqlTokenEOF, position: start}, nil
+	}
+	char := gl.source[start]
+	switch {
+	case strings.IndexByte("!$&():=@[]{}|", char) != -1:
+		gl.position++
+		return graphqlToken{kind: graphqlTokenPunctuator, value: string(char), position: start}, nil
+	case char == '.':
+		if strings.HasPrefix(gl.source[start:], "...") {
+			gl.position += 3
+			return graphqlToken{kind: graphqlTokenPunctuator, value: "...", position: start}, nil
+		}
+		return graphqlToken{}, fmt.Errorf("graphql syntax error: unexpected character '.' at position %d", start)
+	case isGraphQLNameStart(char):
+		for gl.position < len(gl.source) && isGraphQLNameContinue(gl.source[gl.position]) {
+			gl.position++
+		}
+		return graphqlToken{kind: graphqlTokenName, value: gl.source[start:gl.position], position: start}, nil
+	case char == '-' || isGraphQLDigit(char):
+		return gl.readNumber()
+	case char == '"':
+		if strings.HasPrefix(gl.source[start:], `"""`) {
+			return gl.readBlockString()
+		}
+		return gl.readString()
+	}
+	return graphqlToken{}, fmt.Errorf("graphql syntax error: unexpected character %q at position %d", char, start)
+}
+
+// skipIgnored skips white space, line terminators, commas, comments and the unicode BOM
+func (gl *graphqlLexer) skipIgnored() {
+	for gl.position < len(gl.source) {
+		switch gl.source[gl.position] {
+		case ' ', '\t', '\n', '\r', ',':
+			gl.position++
+		case '#':
+			for gl.position < len(gl.source) && gl.source[gl.position] != '\n' && gl.source[gl.position] != '\r' {
+				gl.position++
+			}
+		default:
+			if strings.HasPrefix(gl.source[gl.position:], "\uFEFF") {
+				gl.position += len("\uFEFF")
+				continue
+			}
+			return
+		}
+	}
+}
+
+func (gl *graphqlLexer) readNumber() (graphqlToken, error) {
+	start := gl.position
+	kind := graphqlTokenInt
+	if gl.source[gl.position] == '-' {
+		gl.position++
+	}
+	digits := gl.readDigits()
+	if digits == 0 {
+		return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid number at position %d", start)
+	}
+	if gl.position < len(gl.source) && gl.source[gl.position] == '.' {
+		kind = graphqlTokenFloat
+		gl.position++
+		if gl.readDigits() == 0 {
+			return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid number at position %d", start)
+		}
+	}
+	if gl.position < len(gl.source) && (gl.source[gl.position] == 'e' || gl.source[gl.position] == 'E') {
+		kind = graphqlTokenFloat
+		gl.position++
+		if gl.position < len(gl.source) && (gl.source[gl.position] == '+' || gl.source[gl.position] == '-') {
+			gl.position++
+		}
+		if gl.readDigits() == 0 {
+			return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid number at position %d", start)
+		}
+	}
+	if gl.position < len(gl.source) && (isGraphQLNameStart(gl.source[gl.position]) || gl.source[gl.position] == '.') {
+		return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid number at position %d", start)
+	}
+	return graphqlToken{kind: kind, value: gl.source[start:gl.position], position: start}, nil
+}
+
+func (gl *graphqlLexer) readDigits() int {
+	start := gl.position
+	for gl.position < len(gl.source) && isGraphQLDigit(gl.source[gl.position]) {
+		gl.position++
+	}
+	return gl.position - start
+}
+
+func (gl *graphqlLexer) readString() (graphqlToken, error) {
+	start := gl.position
+	gl.position++ // opening quote
+	var value strings.Builder
+	for gl.position < len(gl.source) {
+		char := gl.source[gl.position]
+		switch char {
+		case '"':
+			gl.position++
+			return graphqlToken{kind: graphqlTokenString, value: value.String(), position: start}, nil
+		case '\n', '\r':
+			return graphqlToken{}, fmt.Errorf("graphql syntax error: unterminated string at position %d", start)
+		case '\\':
+			if gl.position+1 >= len(gl.source) {
+				return graphqlToken{}, fmt.Errorf("graphql syntax error: unterminated string at position %d", start)
+			}
+			escaped := gl.source[gl.position+1]
+			gl.position += 2
+			switch escaped {
+			case '"', '\\', '/':
+				value.WriteByte(escaped)
+			case 'b':
+				value.WriteByte('\b')
+			case 'f':
+				value.WriteByte('\f')
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case 'u':
+				if gl.position+4 > len(gl.source) {
+					return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid unicode escape at position %d", gl.position)
+				}
+				code, err := strconv.ParseUint(gl.source[gl.position:gl.position+4], 16, 32)
+				if err != nil {
+					return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid unicode escape at position %d", gl.position)
+				}
+				value.WriteRune(rune(code))
+				gl.position += 4
+			default:
+				return graphqlToken{}, fmt.Errorf("graphql syntax error: invalid escape %q at position %d", escaped, gl.position-1)
+			}
+		default:
+			_, size := utf8.DecodeRuneInString(gl.source[gl.position:])
+			value.WriteString(gl.source[gl.position : gl.position+size])
+			gl.position += size
+		}
+	}
+	return graphqlToken{}, fmt.Errorf("graphql syntax error: unterminated string at position %d", start)
+}
+
+// readBlockString reads a """block string""", the value is kept raw since block strings are not used for api matching
+func (gl *graphqlLexer) readBlockString() (graphqlToken, error) {
+	start := gl.position
+	gl.position += 3
+	for gl.position < len(gl.source) {
+		switch {
+		case strings.HasPrefix(gl.source[gl.position:], `\"""`):
+			gl.position += 4
+		case strings.HasPrefix(gl.source[gl.position:], `"""`):
+			value := strings.ReplaceAll(gl.source[start+3:gl.position], `\"""`, `"""`)
+			gl.position += 3
+			return graphqlToken{kind: graphqlTokenString, value: value, position: start}, nil
+		default:
+			gl.position++
+		}
+	}
+	return graphqlToken{}, fmt.Errorf("graphql syntax error: unterminated block string at position %d", start)
+}
+
+func isGraphQLNameStart(char byte) bool {
+	return char == '_' || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
+
+func isGraphQLNameContinue(char byte) bool {
+	return isGraphQLNameStart(char) || isGraphQLDigit(char)
+}
+
+func isGraphQLDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
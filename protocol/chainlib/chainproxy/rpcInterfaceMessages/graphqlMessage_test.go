@@ -0,0 +1,196 @@
+package rpcInterfaceMessages
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGraphQLOperation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		operationName string
+		variables     map[string]interface{}
+		expectedType  string
+		expected      []GraphQLField
+	}{
+		{
+			name:         "query shorthand",
+			query:        `{ chainIdentifier }`,
+			expectedType: GraphQLOperationQuery,
+			expected:     []GraphQLField{{Name: "chainIdentifier"}},
+		},
+		{
+			name:         "nested selections and typename are skipped",
+			query:        `query { __typename checkpoint { digest transactionBlocks(first: 5) { nodes { digest } } } }`,
+			expectedType: GraphQLOperationQuery,
+			expected:     []GraphQLField{{Name: "checkpoint"}},
+		},
+		{
+			name:         "aliases and arguments",
+			query:        `{ a: checkpoint(id: {sequenceNumber: 1}) { digest } b: checkpoint(id: {sequenceNumber: -2, digest: "x"}) { digest } }`,
+			expectedType: GraphQLOperationQuery,
+			expected: []GraphQLField{
+				{Alias: "a", Name: "checkpoint", Arguments: map[string]interface{}{"id": map[string]interface{}{"sequenceNumber": int64(1)}}},
+				{Alias: "b", Name: "checkpoint", Arguments: map[string]interface{}{"id": map[string]interface{}{"sequenceNumber": int64(-2), "digest": "x"}}},
+			},
+		},
+		{
+			name:         "values",
+			query:        `{ events(filter: {kinds: [A, B], ratio: 1.5e2, strict: true, after: null, note: """ a "block" string """}) { nodes { id } } }`,
+			expectedType: GraphQLOperationQuery,
+			expected: []GraphQLField{{Name: "events", Arguments: map[string]interface{}{"filter": map[string]interface{}{
+				"kinds":  []interface{}{"A", "B"},
+				"ratio":  150.0,
+				"strict": true,
+				"after":  nil,
+				"note":   ` a "block" string `,
+			}}}},
+		},
+		{
+			name:         "variables and their defaults",
+			query:        `query Get($seq: Int = 3, $digest: String!) @cached { checkpoint(id: {sequenceNumber: $seq, digest: $digest}) @include(if: true) { digest } }`,
+			variables:    map[string]interface{}{"digest": "abc"},
+			expectedType: GraphQLOperationQuery,
+			expected:     []GraphQLField{{Name: "checkpoint", Arguments: map[string]interface{}{"id": map[string]interface{}{"sequenceNumber": int64(3), "digest": "abc"}}}},
+		},
+		{
+			name:         "fragments",
+			query:        `query { ...Root ... on Query { epoch { epochId } } } fragment Root on Query { chainIdentifier ...More } fragment More on Query { protocolConfig { protocolVersion } }`,
+			expectedType: GraphQLOperationQuery,
+			expected:     []GraphQLField{{Name: "chainIdentifier"}, {Name: "protocolConfig"}, {Name: "epoch"}},
+		},
+		{
+			name:          "operation name selects the operation",
+			query:         "# comment\nquery A { chainIdentifier }\nmutation B { executeTransactionBlock(txBytes: \"{\", signatures: [\"}\"]) { errors } }",
+			operationName: "B",
+			expectedType:  GraphQLOperationMutation,
+			expected:      []GraphQLField{{Name: "executeTransactionBlock", Arguments: map[string]interface{}{"txBytes": "{", "signatures": []interface{}{"}"}}}},
+		},
+		{
+			name:         "subscription",
+			query:        `subscription { events { nodes { id } } }`,
+			expectedType: GraphQLOperationSubscription,
+			expected:     []GraphQLField{{Name: "events"}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			operation, err := ParseGraphQLOperation(testCase.query, testCase.operationName, testCase.variables)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedType, operation.Type)
+			require.Equal(t, testCase.expected, operation.Fields)
+		})
+	}
+}
+
+func TestParseGraphQLOperationErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		operationName string
+	}{
+		{name: "empty document", query: `# nothing`},
+		{name: "unterminated selection", query: `{ checkpoint { digest }`},
+		{name: "empty selection", query: `{ }`},
+		{name: "unterminated string", query: `{ checkpoint(digest: "abc) { digest } }`},
+		{name: "invalid character", query: `{ checkpoint % }`},
+		{name: "multiple operations without a name", query: `query A { a } query B { b }`},
+		{name: "unknown operation name", query: `query A { a }`, operationName: "B"},
+		{name: "undefined fragment", query: `{ ...Missing }`},
+		{name: "cyclic fragment", query: `{ ...A } fragment A on Query { ...A }`},
+		{name: "only typename", query: `{ __typename }`},
+		{name: "type definition", query: `type Query { a: Int }`},
+		{name: "variable in a default value", query: `query ($a: Int = $b) { a }`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseGraphQLOperation(testCase.query, testCase.operationName, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseGraphQLOperationExpansionCap(t *testing.T) {
+	// each fragment spreads the next one 4 times, so the document expands to 4^depth fields
+	fragmentChain := func(depth int) string {
+		document := `{ ...F0 }`
+		for i := 0; i < depth; i++ {
+			document += fmt.Sprintf(" fragment F%d on Query {%s }", i, strings.Repeat(fmt.Sprintf(" ...F%d", i+1), 4))
+		}
+		return document + fmt.Sprintf(" fragment F%d on Query { checkpoint { digest } }", depth)
+	}
+
+	operation, err := ParseGraphQLOperation(fragmentChain(3), "", nil)
+	require.NoError(t, err)
+	require.Len(t, operation.Fields, 64)
+
+	// a short document expanding to 4^8 fields is rejected
+	start := time.Now()
+	_, err = ParseGraphQLOperation(fragmentChain(8), "", nil)
+	require.ErrorContains(t, err, "selections")
+	require.Less(t, time.Since(start), time.Second)
+
+	// fragments nested too deep
+	document := `{ ...F0 }`
+	for i := 0; i <= maxGraphQLFragmentDepth; i++ {
+		document += fmt.Sprintf(" fragment F%d on Query { ...F%d }", i, i+1)
+	}
+	document += fmt.Sprintf(" fragment F%d on Query { checkpoint { digest } }", maxGraphQLFragmentDepth+1)
+	_, err = ParseGraphQLOperation(document, "", nil)
+	require.ErrorContains(t, err, "nested")
+
+	// too many fields without fragments
+	_, err = ParseGraphQLOperation("{"+strings.Repeat(" checkpoint", maxGraphQLExpandedSelections+1)+" }", "", nil)
+	require.Error(t, err)
+}
+
+func TestParseGraphQLOperationNestingDepth(t *testing.T) {
+	nested := func(open string, inner string, close string, depth int) string {
+		return strings.Repeat(open, depth) + inner + strings.Repeat(close, depth)
+	}
+	documents := map[string]func(depth int) string{
+		"list value":    func(depth int) string { return `{ f(a: ` + nested("[", "1", "]", depth) + `) }` },
+		"object value":  func(depth int) string { return `{ f(a: ` + nested("{a: ", "1", "}", depth) + `) }` },
+		"default value": func(depth int) string { return `query($v: Int = ` + nested("[", "1", "]", depth) + `) { f }` },
+		"list type":     func(depth int) string { return `query($v: ` + nested("[", "Int", "]", depth) + `) { f }` },
+	}
+	for name, document := range documents {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseGraphQLOperation(document(maxGraphQLNestingDepth), "", nil)
+			require.NoError(t, err)
+			_, err = ParseGraphQLOperation(document(maxGraphQLNestingDepth+1), "", nil)
+			require.ErrorContains(t, err, "nested")
+		})
+	}
+
+	// inline fragments are parsed recursively as well, and their expansion is bounded by the fragments depth
+	parserNestingError := fmt.Sprintf("nested deeper than %d", maxGraphQLNestingDepth)
+	_, err := ParseGraphQLOperation(nested("{ ... ", "{ f }", " }", maxGraphQLNestingDepth+1), "", nil)
+	require.ErrorContains(t, err, parserNestingError)
+
+	// documents that would overflow the stack are rejected
+	_, err = ParseGraphQLOperation(`{ f(a: `+strings.Repeat("[", 4_000_000), "", nil)
+	require.ErrorContains(t, err, parserNestingError)
+	_, err = ParseGraphQLOperation(strings.Repeat("{ ... ", 1_000_000), "", nil)
+	require.ErrorContains(t, err, parserNestingError)
+}
+
+func TestGraphQLNewParsableRPCInput(t *testing.T) {
+	msg := GraphQLMessage{Query: "{ chainIdentifier }"}
+	parsable, err := msg.NewParsableRPCInput([]byte(`{"data":{"chainIdentifier":"4c78adac"}}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"chainIdentifier":"4c78adac"}`, string(parsable.GetResult()))
+
+	// partial data is still parsable
+	parsable, err = msg.NewParsableRPCInput([]byte(`{"data":{"chainIdentifier":"4c78adac"},"errors":[{"message":"partial"}]}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"chainIdentifier":"4c78adac"}`, string(parsable.GetResult()))
+
+	_, err = msg.NewParsableRPCInput([]byte(`{"data":null,"errors":[{"message":"failed"}]}`))
+	require.Error(t, err)
+}
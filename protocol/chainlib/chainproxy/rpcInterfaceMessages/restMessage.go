@@ -44,6 +44,11 @@ func (cp RestMessage) GetParams() interface{} {
 	return parameters
 }
 
+// GetBody returns the body of POST requests, used by parser.RPCInputWithBody
+func (cp RestMessage) GetBody() []byte {
+	return cp.Msg
+}
+
 func (rm *RestMessage) UpdateLatestBlockInMessage(latestBlock uint64, modifyContent bool) (success bool) {
 	// return rm.SetLatestBlockWithHeader(latestBlock, modifyContent)
 	// removed until behaviour inconsistency with the cosmos sdk header is solved
@@ -3,6 +3,8 @@ package rpcInterfaceMessages
 import (
 	"testing"
 
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,26 @@ func TestRestMessage(t *testing.T) {
 	}
 }
 
+func TestRestJsonPathBody(t *testing.T) {
+	blockParser := spectypes.BlockParser{
+		ParserArg:  []string{"$.filter.height"},
+		ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+	}
+	restMessage := RestMessage{
+		Msg:      []byte(`{"filter":{"height":"0x10"}}`),
+		Path:     "/cosmos/tx/v1beta1/simulate",
+		SpecPath: "/cosmos/tx/v1beta1/simulate",
+	}
+	block, err := parser.ParseBlockFromParams(restMessage, blockParser)
+	require.NoError(t, err)
+	require.Equal(t, int64(16), block)
+
+	// a GET request has no body
+	restMessage.Msg = nil
+	_, err = parser.ParseBlockFromParams(restMessage, blockParser)
+	require.Error(t, err)
+}
+
 func TestRestParseBlock(t *testing.T) {
 	t.Parallel()
 
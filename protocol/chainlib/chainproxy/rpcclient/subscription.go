@@ -246,6 +246,35 @@ func newClientSubscription(c *Client, namespace string, channel reflect.Value) *
 	return sub
 }
 
+// NewStreamClientSubscription creates a subscription that is not backed by a json-rpc client,
+// but by a stream that is read elsewhere (e.g. a grpc server stream). cancel is called once the
+// subscription is unsubscribed or ended, and the reader of the stream calls EndStream when it's done.
+func NewStreamClientSubscription(cancel func()) *ClientSubscription {
+	sub := &ClientSubscription{
+		quit:        make(chan error),
+		forwardDone: make(chan struct{}),
+		unsubDone:   make(chan struct{}),
+		err:         make(chan error, 1),
+	}
+	go func() {
+		defer close(sub.unsubDone)
+		err := <-sub.quit
+		close(sub.forwardDone)
+		cancel()
+		if err != errUnsubscribed {
+			// a nil error means the stream ended normally
+			sub.err <- err
+		}
+	}()
+	return sub
+}
+
+// EndStream ends a subscription created by NewStreamClientSubscription, the error is delivered on Err.
+// It can safely be called more than once and after Unsubscribe.
+func (sub *ClientSubscription) EndStream(err error) {
+	sub.close(err)
+}
+
 // Err returns the subscription error channel. The intended use of Err is to schedule
 // resubscription when the client connection is closed unexpectedly.
 //
@@ -1,8 +1,11 @@
 package chainlib
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	common "github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/utils"
@@ -320,3 +324,63 @@ func createAndSetupBaseAppListener(cmdFlags common.ConsumerCmdFlags, healthCheck
 
 	return app
 }
+
+// nodeStream reads the messages a node streams for a subscription, like a grpc server stream or a rest event stream
+type nodeStream interface {
+	next() ([]byte, error) // returns io.EOF when the stream ended
+	close()                // called once the stream is done, it interrupts a blocked next
+}
+
+var errFirstStreamMessageTimeout = errors.New("timed out waiting for the first stream message")
+
+// relayNodeStream opens a node stream and reads its first message within the relay timeout, the following messages
+// are sent on ch until the stream ends or the returned subscription is unsubscribed. the stream is opened with a
+// context that is canceled when it's done, and it is closed then
+func relayNodeStream(ctx context.Context, ch chan interface{}, relayTimeout time.Duration, open func(streamCtx context.Context) (nodeStream, error)) (firstMessage []byte, subscription *rpcclient.ClientSubscription, err error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	// the relay timeout applies to the first message only, the stream itself lives until it's unsubscribed
+	firstMessageTimer := time.AfterFunc(relayTimeout, cancel)
+	stream, err := open(streamCtx)
+	if err != nil {
+		firstMessageTimer.Stop()
+		cancel()
+		return nil, nil, err
+	}
+	// reading from the stream is interrupted by closing it
+	go func() {
+		<-streamCtx.Done()
+		stream.close()
+	}()
+
+	firstMessage, err = stream.next()
+	if !firstMessageTimer.Stop() {
+		cancel()
+		return nil, nil, errFirstStreamMessageTimeout
+	}
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	subscription = rpcclient.NewStreamClientSubscription(cancel)
+	go func() {
+		for {
+			data, err := stream.next()
+			if err != nil {
+				if errors.Is(err, io.EOF) || streamCtx.Err() != nil {
+					// the stream ended or was unsubscribed
+					err = nil
+				}
+				subscription.EndStream(err)
+				return
+			}
+			select {
+			case ch <- data:
+			case <-streamCtx.Done():
+				subscription.EndStream(nil)
+				return
+			}
+		}
+	}()
+	return firstMessage, subscription, nil
+}
@@ -1,7 +1,9 @@
 package chainlib
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http/httptest"
 	"testing"
@@ -13,6 +15,7 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMatchSpecApiByName(t *testing.T) {
@@ -391,3 +394,90 @@ func TestGetServiceApis(t *testing.T) {
 		t.Errorf("Expected serverApis length to be 3, but got %d", len(serverApis))
 	}
 }
+
+// channelNodeStream streams the messages sent on messages, until messages is closed
+type channelNodeStream struct {
+	messages chan []byte
+	closed   chan struct{}
+}
+
+func newChannelNodeStream() *channelNodeStream {
+	return &channelNodeStream{messages: make(chan []byte), closed: make(chan struct{})}
+}
+
+func (cs *channelNodeStream) next() ([]byte, error) {
+	select {
+	case data, ok := <-cs.messages:
+		if !ok {
+			return nil, io.EOF
+		}
+		return data, nil
+	case <-cs.closed:
+		return nil, errors.New("stream closed")
+	}
+}
+
+func (cs *channelNodeStream) close() {
+	close(cs.closed)
+}
+
+func TestRelayNodeStream(t *testing.T) {
+	openStream := func(stream nodeStream) func(context.Context) (nodeStream, error) {
+		return func(context.Context) (nodeStream, error) { return stream, nil }
+	}
+
+	// the first message is the reply and the following ones are forwarded until the stream ends
+	stream := newChannelNodeStream()
+	go func() { stream.messages <- []byte("1") }()
+	ch := make(chan interface{})
+	firstMessage, subscription, err := relayNodeStream(context.Background(), ch, time.Second, openStream(stream))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), firstMessage)
+	stream.messages <- []byte("2")
+	require.Equal(t, []byte("2"), <-ch)
+	close(stream.messages)
+	select {
+	case <-stream.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ended stream wasn't closed")
+	}
+	require.NotNil(t, subscription)
+
+	// unsubscribing closes the stream
+	stream = newChannelNodeStream()
+	go func() { stream.messages <- []byte("1") }()
+	_, subscription, err = relayNodeStream(context.Background(), ch, time.Second, openStream(stream))
+	require.NoError(t, err)
+	subscription.Unsubscribe()
+	select {
+	case <-stream.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("unsubscribed stream wasn't closed")
+	}
+
+	// the relay timeout applies to the first message
+	stream = newChannelNodeStream()
+	_, subscription, err = relayNodeStream(context.Background(), ch, 50*time.Millisecond, openStream(stream))
+	require.ErrorIs(t, err, errFirstStreamMessageTimeout)
+	require.Nil(t, subscription)
+
+	// a stream that ends without messages
+	stream = newChannelNodeStream()
+	close(stream.messages)
+	_, _, err = relayNodeStream(context.Background(), ch, time.Second, openStream(stream))
+	require.ErrorIs(t, err, io.EOF)
+	<-stream.closed
+
+	// a stream that failed opening is returned as is
+	openErr := errors.New("open failed")
+	_, _, err = relayNodeStream(context.Background(), ch, time.Second, func(context.Context) (nodeStream, error) { return nil, openErr })
+	require.ErrorIs(t, err, openErr)
+}
+
+func TestIsStreamingApiInterface(t *testing.T) {
+	require.True(t, IsStreamingApiInterface(spectypes.APIInterfaceGrpc))
+	require.True(t, IsStreamingApiInterface(spectypes.APIInterfaceRest))
+	// json-rpc subscriptions are not streams
+	require.False(t, IsStreamingApiInterface(spectypes.APIInterfaceJsonRPC))
+	require.False(t, IsStreamingApiInterface(spectypes.APIInterfaceTendermintRPC))
+}
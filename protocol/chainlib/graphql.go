@@ -0,0 +1,433 @@
+package chainlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type GraphQLChainParser struct {
+	BaseChainParser
+}
+
+// NewGraphQLChainParser creates a new instance of GraphQLChainParser
+func NewGraphQLChainParser() (chainParser *GraphQLChainParser, err error) {
+	return &GraphQLChainParser{}, nil
+}
+
+func (apip *GraphQLChainParser) GetUniqueName() string {
+	return "graphql_chain_parser"
+}
+
+func (apip *GraphQLChainParser) getApiCollection(connectionType, internalPath, addon string) (*spectypes.ApiCollection, error) {
+	if apip == nil {
+		return nil, errors.New("ChainParser not defined")
+	}
+	return apip.BaseChainParser.getApiCollection(connectionType, internalPath, addon)
+}
+
+func (apip *GraphQLChainParser) getSupportedApi(name, connectionType string) (*ApiContainer, error) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return nil, errors.New("ChainParser not defined")
+	}
+	return apip.BaseChainParser.getSupportedApi(name, connectionType)
+}
+
+func (apip *GraphQLChainParser) CraftMessage(parsing *spectypes.ParseDirective, connectionType string, craftData *CraftData, metadata []pairingtypes.Metadata) (ChainMessageForSend, error) {
+	if craftData != nil {
+		chainMessage, err := apip.ParseMsg("", craftData.Data, craftData.ConnectionType, metadata, extensionslib.ExtensionInfo{LatestBlock: 0})
+		if err == nil {
+			chainMessage.AppendHeader(metadata)
+		}
+		return chainMessage, err
+	}
+
+	// without a template the api is queried as a field with no arguments or sub selections
+	msg := &rpcInterfaceMessages.GraphQLMessage{
+		Query:       "{ " + parsing.ApiName + " }",
+		BaseMessage: chainproxy.BaseMessage{Headers: metadata},
+	}
+	apiCont, err := apip.getSupportedApi(parsing.ApiName, connectionType)
+	if err != nil {
+		return nil, err
+	}
+	apiCollection, err := apip.getApiCollection(connectionType, apiCont.collectionKey.InternalPath, apiCont.collectionKey.Addon)
+	if err != nil {
+		return nil, err
+	}
+	return apip.newChainMessage(apiCont.api, spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, msg, apiCollection), nil
+}
+
+// ParseMsg parses a graphql request, every top level field of the executed operation is matched to a spec api
+func (apip *GraphQLChainParser) ParseMsg(url string, data []byte, connectionType string, metadata []pairingtypes.Metadata, extensionInfo extensionslib.ExtensionInfo) (ChainMessage, error) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return nil, errors.New("GraphQLChainParser not defined")
+	}
+
+	msg, err := rpcInterfaceMessages.ParseGraphQLMsg(data)
+	if err != nil {
+		return nil, err
+	}
+	operation, err := msg.ParseOperation()
+	if err != nil {
+		return nil, utils.LavaFormatInfo("failed parsing graphql operation", utils.LogAttr("reason", err))
+	}
+	if operation.Type == rpcInterfaceMessages.GraphQLOperationSubscription {
+		return nil, utils.LavaFormatInfo("graphql subscriptions are not supported", utils.LogAttr("operation", operation.Name))
+	}
+
+	// match the fields to spec apis, several fields in an operation are priced like a batch
+	var api *spectypes.Api
+	var apiCollection *spectypes.ApiCollection
+	apiContainers := make([]*ApiContainer, len(operation.Fields))
+	for idx, field := range operation.Fields {
+		apiCont, err := apip.getSupportedApi(field.Name, connectionType)
+		if err != nil {
+			return nil, utils.LavaFormatInfo("getSupportedApi graphql failed", utils.LogAttr("reason", err), utils.Attribute{Key: "field", Value: field.Name})
+		}
+		apiContainers[idx] = apiCont
+		apiCollectionForField, err := apip.getApiCollection(connectionType, apiCont.collectionKey.InternalPath, apiCont.collectionKey.Addon)
+		if err != nil {
+			return nil, utils.LavaFormatInfo("could not find the api collection for the graphql field", utils.LogAttr("reason", err), utils.Attribute{Key: "field", Value: field.Name})
+		}
+		if idx == 0 {
+			api = apiCont.api
+			apiCollection = apiCollectionForField
+			continue
+		}
+		if apiCollectionForField.CollectionData.AddOn != "" && apiCollectionForField.CollectionData.AddOn != apiCollection.CollectionData.AddOn {
+			if apiCollection.CollectionData.AddOn != "" {
+				return nil, utils.LavaFormatError("unable to parse graphql operation with fields from multiple addons", nil,
+					utils.Attribute{Key: "first addon", Value: apiCollection.CollectionData.AddOn},
+					utils.Attribute{Key: "second addon", Value: apiCollectionForField.CollectionData.AddOn})
+			}
+			apiCollection = apiCollectionForField // overwrite apiCollection to take the addon
+		}
+		api = &spectypes.Api{
+			Enabled:           api.Enabled && apiCont.api.Enabled,
+			Name:              api.Name + SEP + apiCont.api.Name,
+			ComputeUnits:      api.ComputeUnits + apiCont.api.ComputeUnits,
+			ExtraComputeUnits: api.ExtraComputeUnits + apiCont.api.ExtraComputeUnits,
+			Category:          api.GetCategory().Combine(apiCont.api.GetCategory()),
+			BlockParsing: spectypes.BlockParser{
+				ParserArg:    []string{},
+				ParserFunc:   spectypes.PARSER_FUNC_EMPTY,
+				DefaultValue: "",
+				Encoding:     "",
+			},
+		}
+	}
+
+	metadata, overwriteReqBlock, _ := apip.HandleHeaders(metadata, apiCollection, spectypes.Header_pass_send)
+	settingHeaderDirective, _, _ := apip.GetParsingByTag(spectypes.FUNCTION_TAG_SET_LATEST_IN_METADATA)
+	msg.BaseMessage = chainproxy.BaseMessage{Headers: metadata, LatestBlockHeaderSetter: settingHeaderDirective}
+
+	var latestRequestedBlock, earliestRequestedBlock int64
+	if overwriteReqBlock != "" {
+		latestRequestedBlock, err = msg.ParseBlock(overwriteReqBlock)
+		if err != nil {
+			utils.LavaFormatError("failed parsing block from an overwrite header", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "overwriteReqBlock", Value: overwriteReqBlock})
+			latestRequestedBlock = spectypes.NOT_APPLICABLE
+		}
+		earliestRequestedBlock = latestRequestedBlock
+	} else {
+		for idx, field := range operation.Fields {
+			apiCont := apiContainers[idx]
+			// block parsing of a field works on its arguments, with variables already resolved
+			fieldInput := rpcInterfaceMessages.GraphQLFieldInput{Field: field, Message: msg}
+			requestedBlockForField, err := apip.parseRequestedBlock(fieldInput, apiCont.api.BlockParsing)
+			if err != nil {
+				utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
+				requestedBlockForField = spectypes.NOT_APPLICABLE
+			}
+			earliestRequestedBlockForField := requestedBlockForField
+			if apiCont.api.EarliestBlockParsing != nil {
+				requestedBlockForField, earliestRequestedBlockForField = apip.parseRequestedBlockRange(fieldInput, *apiCont.api.EarliestBlockParsing, requestedBlockForField)
+			}
+			if idx == 0 {
+				latestRequestedBlock = requestedBlockForField
+				earliestRequestedBlock = earliestRequestedBlockForField
+				continue
+			}
+			latestRequestedBlock, _ = CompareRequestedBlockInBatch(latestRequestedBlock, requestedBlockForField)
+			_, earliestRequestedBlock = CompareRequestedBlockInBatch(earliestRequestedBlock, earliestRequestedBlockForField)
+		}
+	}
+
+	nodeMsg := apip.newChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msg, apiCollection)
+	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, extensionInfo)
+	return nodeMsg, apip.BaseChainParser.Validate(nodeMsg)
+}
+
+func (*GraphQLChainParser) newChainMessage(serviceApi *spectypes.Api, requestedBlock int64, earliestRequestedBlock int64, msg *rpcInterfaceMessages.GraphQLMessage, apiCollection *spectypes.ApiCollection) *baseChainMessageContainer {
+	nodeMsg := &baseChainMessageContainer{
+		api:                    serviceApi,
+		apiCollection:          apiCollection,
+		latestRequestedBlock:   requestedBlock,
+		earliestRequestedBlock: earliestRequestedBlock,
+		msg:                    msg,
+	}
+	return nodeMsg
+}
+
+// SetSpec sets the spec for the GraphQLChainParser
+func (apip *GraphQLChainParser) SetSpec(spec spectypes.Spec) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return
+	}
+
+	// Add a read-write lock to ensure thread safety
+	apip.rwLock.Lock()
+	defer apip.rwLock.Unlock()
+
+	// extract server and tagged apis from spec
+	serverApis, taggedApis, apiCollections, headers, verifications := getServiceApis(spec, spectypes.APIInterfaceGraphQL)
+	apip.BaseChainParser.Construct(spec, taggedApis, serverApis, apiCollections, headers, verifications)
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+func (apip *GraphQLChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return false, 0
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	// Return enabled and data reliability threshold from spec
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
+}
+
+// ChainBlockStats returns block stats from spec
+// (spec.AllowedBlockLagForQosSync, spec.AverageBlockTime, spec.BlockDistanceForFinalizedData)
+func (apip *GraphQLChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64, averageBlockTime time.Duration, blockDistanceForFinalizedData, blocksInFinalizationProof uint32) {
+	// Guard that the GraphQLChainParser instance exists
+	if apip == nil {
+		return 0, 0, 0, 0
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	// Convert average block time from int64 -> time.Duration
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
+
+	// Return values
+	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
+}
+
+type GraphQLChainListener struct {
+	endpoint       *lavasession.RPCEndpoint
+	relaySender    RelaySender
+	healthReporter HealthReporter
+	logger         *metrics.RPCConsumerLogs
+}
+
+// NewGraphQLChainListener creates a new instance of GraphQLChainListener
+func NewGraphQLChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint,
+	relaySender RelaySender, healthReporter HealthReporter,
+	rpcConsumerLogs *metrics.RPCConsumerLogs,
+) (chainListener *GraphQLChainListener) {
+	// Create a new instance of GraphQLChainListener
+	chainListener = &GraphQLChainListener{
+		listenEndpoint,
+		relaySender,
+		healthReporter,
+		rpcConsumerLogs,
+	}
+
+	return chainListener
+}
+
+// Serve http server for GraphQLChainListener
+func (apil *GraphQLChainListener) Serve(ctx context.Context, cmdFlags common.ConsumerCmdFlags) {
+	// Guard that the GraphQLChainListener instance exists
+	if apil == nil {
+		return
+	}
+
+	// Setup HTTP Server
+	app := createAndSetupBaseAppListener(cmdFlags, apil.endpoint.HealthCheckPath, apil.healthReporter)
+
+	chainID := apil.endpoint.ChainID
+	apiInterface := apil.endpoint.ApiInterface
+	app.Post("/*", func(fiberCtx *fiber.Ctx) error {
+		// Set response header content-type to application/json
+		fiberCtx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		startTime := time.Now()
+		endTx := apil.logger.LogStartTransaction("graphql-http post")
+		defer endTx()
+		dappID := extractDappIDFromFiberContext(fiberCtx)
+		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		guid := utils.GenerateUniqueIdentifier()
+		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		msgSeed := strconv.FormatUint(guid, 10)
+		path := "/" + fiberCtx.Params("*")
+		requestBody := string(fiberCtx.Body())
+		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: requestBody}, utils.Attribute{Key: "dappID", Value: dappID})
+
+		consumerIp := fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP())
+		metadataValues := fiberCtx.GetReqHeaders()
+		headers := convertToMetadataMap(metadataValues)
+		ctx, span := metrics.StartRelaySpan(ctx, "graphql http", chainID, apiInterface, dappID)
+		relayResult, err := apil.relaySender.SendRelay(ctx, path, requestBody, http.MethodPost, dappID, consumerIp, metricsData, headers)
+		metrics.EndSpan(span, err)
+		reply := relayResult.GetReply()
+		go apil.logger.AddMetricForHttp(metricsData, err, fiberCtx.GetReqHeaders())
+		if err != nil {
+			// Get unique GUID response
+			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
+
+			// Log request and response
+			apil.logger.LogRequestAndResponse("graphql http", true, http.MethodPost, path, requestBody, errMasking, msgSeed, time.Since(startTime), err)
+
+			// Set status to internal error
+			if relayResult.GetStatusCode() != 0 {
+				fiberCtx.Status(relayResult.StatusCode)
+			} else {
+				fiberCtx.Status(fiber.StatusInternalServerError)
+			}
+
+			// Construct json response
+			response := convertToJsonError(errMasking)
+			// Return error json response
+			return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), response)
+		}
+		response := string(reply.Data)
+		// Log request and response
+		apil.logger.LogRequestAndResponse("graphql http", false, http.MethodPost, path, requestBody, response, msgSeed, time.Since(startTime), nil)
+		if relayResult.GetStatusCode() != 0 {
+			fiberCtx.Status(relayResult.StatusCode)
+		}
+		// Return json response
+		return addHeadersAndSendString(fiberCtx, reply.GetMetadata(), response)
+	})
+
+	// Go
+	ListenWithRetry(app, apil.endpoint.NetworkAddress)
+}
+
+type GraphQLChainProxy struct {
+	BaseChainProxy
+	httpClient *http.Client
+}
+
+func NewGraphQLChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
+	if len(rpcProviderEndpoint.NodeUrls) == 0 {
+		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
+	}
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	gcp := &GraphQLChainProxy{
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: rpcProviderEndpoint.NodeUrls[0], ErrorHandler: &GraphQLErrorHandler{}, ChainID: rpcProviderEndpoint.ChainID},
+		httpClient: &http.Client{
+			Timeout: 5 * time.Minute, // we are doing a timeout by request
+		},
+	}
+	return gcp, nil
+}
+
+func (gcp *GraphQLChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	if ch != nil {
+		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on graphql", nil)
+	}
+
+	rpcInputMessage := chainMessage.GetRPCMessage()
+	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GraphQLMessage)
+	if !ok {
+		return nil, "", nil, utils.LavaFormatError("invalid message type in graphql, failed to cast RPCInput from chainMessage", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "rpcMessage", Value: rpcInputMessage})
+	}
+	body, err := json.Marshal(nodeMessage)
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("failed marshaling graphql request", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+
+	relayTimeout := common.LocalNodeTimePerCu(chainMessage.GetApi().ComputeUnits)
+	// check if this API is hanging (waiting for block confirmation)
+	if chainMessage.GetApi().Category.HangingApi {
+		relayTimeout += gcp.averageBlockTime
+	}
+	connectCtx, cancel := gcp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(connectCtx, http.MethodPost, gcp.NodeUrl.AuthConfig.AddAuthPath(gcp.NodeUrl.Url), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, metadata := range nodeMessage.GetHeaders() {
+		req.Header.Set(metadata.Name, metadata.Value)
+	}
+	gcp.NodeUrl.SetAuthHeaders(ctx, req.Header.Set)
+	gcp.NodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
+
+	if debug {
+		utils.LavaFormatDebug("provider sending node message",
+			utils.Attribute{Key: "api", Value: chainMessage.GetApi().Name},
+			utils.Attribute{Key: "headers", Value: req.Header},
+			utils.Attribute{Key: "apiInterface", Value: "graphql"},
+		)
+	}
+	res, err := gcp.httpClient.Do(req)
+	if res != nil {
+		// resp can be non nil on error
+		trailer := metadata.Pairs(common.StatusCodeMetadataKey, strconv.Itoa(res.StatusCode))
+		grpc.SetTrailer(ctx, trailer) // we ignore this error here since this code can be triggered not from grpc
+	}
+	if err != nil {
+		// Validate if the error is related to the provider connection to the node or it is a valid error
+		// in case the error is valid (e.g. bad input parameters) the error will return in the form of a valid error reply
+		if parsedError := gcp.HandleNodeError(ctx, err); parsedError != nil {
+			return nil, "", nil, parsedError
+		}
+		return nil, "", nil, err
+	}
+	defer res.Body.Close()
+
+	err = gcp.HandleStatusError(res.StatusCode, nodeMessage.GetDisableErrorHandling())
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatWarning("Received invalid status code", nil, utils.Attribute{Key: "Status Code", Value: res.StatusCode}, utils.Attribute{Key: "chainID", Value: gcp.BaseChainProxy.ChainID}, utils.Attribute{Key: "apiName", Value: chainMessage.GetApi().Name})
+	}
+
+	replyData, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:     replyData,
+		Metadata: convertToMetadataMapOfSlices(res.Header),
+	}
+
+	// graphql errors are returned inside a json response, anything else is a node failure
+	err = gcp.HandleJSONFormatError(reply.Data)
+	if err != nil {
+		return nil, "", nil, utils.LavaFormatError("GraphQL reply is not a JSON object", nil, utils.Attribute{Key: "reply.Data", Value: string(reply.Data)})
+	}
+	return reply, "", nil, nil
+}
@@ -0,0 +1,249 @@
+package chainlib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func graphQLChainParserForTest() *GraphQLChainParser {
+	collectionKey := CollectionKey{ConnectionType: http.MethodPost}
+	return &GraphQLChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: "checkpoint", ConnectionType: http.MethodPost}: {api: &spectypes.Api{
+					Name:         "checkpoint",
+					Enabled:      true,
+					ComputeUnits: 10,
+					Category:     spectypes.SpecCategory{Deterministic: true},
+					BlockParsing: spectypes.BlockParser{
+						ParserArg:    []string{"$.id.sequenceNumber"},
+						ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+						DefaultValue: "latest",
+					},
+				}, collectionKey: collectionKey},
+				{Name: "chainIdentifier", ConnectionType: http.MethodPost}: {api: &spectypes.Api{
+					Name:         "chainIdentifier",
+					Enabled:      true,
+					ComputeUnits: 5,
+					Category:     spectypes.SpecCategory{Deterministic: true},
+					BlockParsing: spectypes.BlockParser{ParserFunc: spectypes.PARSER_FUNC_EMPTY},
+				}, collectionKey: collectionKey},
+				{Name: "executeTransactionBlock", ConnectionType: http.MethodPost}: {api: &spectypes.Api{
+					Name:         "executeTransactionBlock",
+					Enabled:      true,
+					ComputeUnits: 20,
+					Category:     spectypes.SpecCategory{Deterministic: false},
+					BlockParsing: spectypes.BlockParser{ParserFunc: spectypes.PARSER_FUNC_DEFAULT, ParserArg: []string{"latest"}},
+				}, collectionKey: collectionKey},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{collectionKey: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceGraphQL, Type: http.MethodPost}}},
+		},
+	}
+}
+
+func TestGraphQLChainParser_NilGuard(t *testing.T) {
+	var apip *GraphQLChainParser
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("apip methods missing nill guard, panicked with: %v", r)
+		}
+	}()
+
+	apip.SetSpec(spectypes.Spec{})
+	apip.DataReliabilityParams()
+	apip.ChainBlockStats()
+	apip.getSupportedApi("", "")
+	apip.ParseMsg("", []byte{}, "", nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+}
+
+func TestGraphQLParseMessage(t *testing.T) {
+	apip := graphQLChainParserForTest()
+	testCases := []struct {
+		name            string
+		request         string
+		expectedApi     string
+		expectedCU      uint64
+		expectedBlock   int64
+		deterministic   bool
+		expectedFailure bool
+	}{
+		{
+			name:          "single field with an inline argument",
+			request:       `{"query":"{ checkpoint(id: {sequenceNumber: 100}) { digest } }"}`,
+			expectedApi:   "checkpoint",
+			expectedCU:    10,
+			expectedBlock: 100,
+			deterministic: true,
+		},
+		{
+			name:          "argument from a variable",
+			request:       `{"query":"query GetCheckpoint($seq: Int) { checkpoint(id: {sequenceNumber: $seq}) { digest } }","variables":{"seq":42}}`,
+			expectedApi:   "checkpoint",
+			expectedCU:    10,
+			expectedBlock: 42,
+			deterministic: true,
+		},
+		{
+			name:          "field without arguments uses the default",
+			request:       `{"query":"{ checkpoint { digest } }"}`,
+			expectedApi:   "checkpoint",
+			expectedCU:    10,
+			expectedBlock: spectypes.LATEST_BLOCK,
+			deterministic: true,
+		},
+		{
+			name:          "several fields are combined",
+			request:       `{"query":"{ first: checkpoint(id: {sequenceNumber: 7}) { digest } second: checkpoint(id: {sequenceNumber: 9}) { digest } }"}`,
+			expectedApi:   "checkpoint" + SEP + "checkpoint",
+			expectedCU:    20,
+			expectedBlock: 9,
+			deterministic: true,
+		},
+		{
+			name:          "combined with a field without a block",
+			request:       `{"query":"{ chainIdentifier checkpoint(id: {sequenceNumber: 7}) { digest } }"}`,
+			expectedApi:   "chainIdentifier" + SEP + "checkpoint",
+			expectedCU:    15,
+			expectedBlock: spectypes.NOT_APPLICABLE,
+			deterministic: true,
+		},
+		{
+			name:          "mutation",
+			request:       `{"query":"mutation { executeTransactionBlock(txBytes: \"AA==\", signatures: []) { errors } }"}`,
+			expectedApi:   "executeTransactionBlock",
+			expectedCU:    20,
+			expectedBlock: spectypes.LATEST_BLOCK,
+			deterministic: false,
+		},
+		{
+			name:            "unsupported field",
+			request:         `{"query":"{ epoch { epochId } }"}`,
+			expectedFailure: true,
+		},
+		{
+			name:            "subscription",
+			request:         `{"query":"subscription { checkpoint { digest } }"}`,
+			expectedFailure: true,
+		},
+		{
+			name:            "invalid query",
+			request:         `{"query":"{ checkpoint { digest }"}`,
+			expectedFailure: true,
+		},
+		{
+			name:            "missing query",
+			request:         `{"variables":{}}`,
+			expectedFailure: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := apip.ParseMsg("", []byte(testCase.request), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+			if testCase.expectedFailure {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedApi, msg.GetApi().Name)
+			require.Equal(t, testCase.expectedCU, msg.GetApi().ComputeUnits)
+			require.Equal(t, testCase.deterministic, msg.GetApi().Category.Deterministic)
+			latest, _ := msg.RequestedBlock()
+			require.Equal(t, testCase.expectedBlock, latest)
+			_, ok := msg.GetRPCMessage().(*rpcInterfaceMessages.GraphQLMessage)
+			require.True(t, ok)
+		})
+	}
+}
+
+func TestGraphQLParseMessageBlockRange(t *testing.T) {
+	apip := graphQLChainParserForTest()
+	apip.serverApis[ApiKey{Name: "events", ConnectionType: http.MethodPost}] = ApiContainer{api: &spectypes.Api{
+		Name:    "events",
+		Enabled: true,
+		BlockParsing: spectypes.BlockParser{
+			ParserArg:    []string{"$.filter.beforeCheckpoint"},
+			ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			DefaultValue: "latest",
+		},
+		EarliestBlockParsing: &spectypes.BlockParser{
+			ParserArg:    []string{"$.filter.afterCheckpoint"},
+			ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			DefaultValue: "latest",
+		},
+	}, collectionKey: CollectionKey{ConnectionType: http.MethodPost}}
+	testCases := []struct {
+		name             string
+		filter           string
+		expectedLatest   int64
+		expectedEarliest int64
+	}{
+		{name: "range", filter: `{afterCheckpoint: 10, beforeCheckpoint: 20}`, expectedLatest: 20, expectedEarliest: 10},
+		{name: "to latest", filter: `{afterCheckpoint: 10}`, expectedLatest: spectypes.LATEST_BLOCK, expectedEarliest: 10},
+		// an earliest block that fails parsing leaves only the requested block
+		{name: "invalid after", filter: `{afterCheckpoint: \"garbage\", beforeCheckpoint: 20}`, expectedLatest: 20, expectedEarliest: 20},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := `{"query":"{ events(filter: ` + testCase.filter + `) { nodes { sendingModule { name } } } }"}`
+			msg, err := apip.ParseMsg("", []byte(request), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+			require.NoError(t, err)
+			latest, earliest := msg.RequestedBlock()
+			require.Equal(t, testCase.expectedLatest, latest)
+			require.Equal(t, testCase.expectedEarliest, earliest)
+		})
+	}
+}
+
+func TestGraphQLChainProxy(t *testing.T) {
+	ctx := context.Background()
+	request := `{"query":"{ checkpoint(id: {sequenceNumber: 100}) { digest } }"}`
+	reply := `{"data":{"checkpoint":{"digest":"abc"}}}`
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received := rpcInterfaceMessages.GraphQLMessage{}
+		err = json.Unmarshal(body, &received)
+		if err != nil || received.Query == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, reply)
+	})
+	server := httptest.NewServer(serverHandler)
+	defer server.Close()
+
+	apip := graphQLChainParserForTest()
+	chainProxy, err := NewGraphQLChainProxy(ctx, 1, lavasession.RPCProviderEndpoint{NodeUrls: []common.NodeUrl{{Url: server.URL}}, ChainID: "SUIT", ApiInterface: spectypes.APIInterfaceGraphQL}, apip)
+	require.NoError(t, err)
+
+	chainMessage, err := apip.ParseMsg("", []byte(request), http.MethodPost, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	relayReply, _, _, err := chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	require.NoError(t, err)
+	require.JSONEq(t, reply, string(relayReply.Data))
+
+	// the reply is parsed from its data
+	parsable, err := FormatResponseForParsing(relayReply, chainMessage)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"checkpoint":{"digest":"abc"}}`, string(parsable.GetResult()))
+
+	_, _, _, err = chainProxy.SendNodeMsg(ctx, make(chan interface{}), chainMessage)
+	require.Error(t, err)
+}
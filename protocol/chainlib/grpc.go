@@ -15,7 +15,6 @@ import (
 
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
 	dyncodec "github.com/lavanet/lava/protocol/chainlib/grpcproxy/dyncodec"
-	"github.com/lavanet/lava/protocol/parser"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
@@ -97,6 +96,20 @@ func (apip *GrpcChainParser) getSupportedApi(name, connectionType string) (*ApiC
 	return apip.BaseChainParser.getSupportedApi(name, connectionType)
 }
 
+// isStreamingMethod tells whether a method is a spec subscription that is relayed as a grpc server stream
+func (apip *GrpcChainParser) isStreamingMethod(method string) bool {
+	apiCont, err := apip.getSupportedApi(method, "")
+	if err != nil || !apiCont.api.Category.Subscription || apip.registry == nil {
+		return false
+	}
+	methodDescriptor, err := apip.registry.FindMethodDescriptor(method)
+	if err != nil {
+		utils.LavaFormatWarning("failed resolving the descriptor of a grpc subscription, relaying it as a unary call", err, utils.Attribute{Key: "method", Value: method})
+		return false
+	}
+	return methodDescriptor.IsStreamingServer() && !methodDescriptor.IsStreamingClient()
+}
+
 func (apip *GrpcChainParser) setupForConsumer(relayer grpcproxy.ProxyCallBack) {
 	apip.registry = dyncodec.NewRegistry(dyncodec.NewRelayerRemote(relayer))
 	apip.codec = dyncodec.NewCodec(apip.registry)
@@ -171,7 +184,7 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 	blockParser := apiCont.api.BlockParsing
 	var requestedBlock int64
 	if overwriteReqBlock == "" {
-		requestedBlock, err = parser.ParseBlockFromParams(grpcMessage, blockParser)
+		requestedBlock, err = apip.parseRequestedBlock(grpcMessage, blockParser)
 		if err != nil {
 			utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 			requestedBlock = spectypes.NOT_APPLICABLE
@@ -297,7 +310,9 @@ func (apil *GrpcChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		utils.LavaFormatInfo("GRPC Got Relay ", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: method})
 		metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apiInterface)
 		consumerIp := common.GetIpFromGrpcContext(ctx)
+		ctx, span := metrics.StartRelaySpan(ctx, "grpc", apil.endpoint.ChainID, apiInterface, dappID)
 		relayResult, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, consumerIp, metricsData, grpcHeaders)
+		metrics.EndSpan(span, err)
 		relayReply := relayResult.GetReply()
 		go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
 
@@ -307,18 +322,61 @@ func (apil *GrpcChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 			return nil, nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
 		}
 		apil.logger.LogRequestAndResponse("http in/out", false, method, string(reqBody), "", "", msgSeed, time.Since(startTime), nil)
+		return relayReplyToGrpc(relayReply)
+	}
+
+	// server streaming methods are relayed as subscriptions, every provider reply is sent as a message on the stream
+	sendStreamRelayCallback := func(ctx context.Context, method string, reqBody []byte, stream grpc.ServerStream) error {
+		guid := utils.GenerateUniqueIdentifier()
+		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		msgSeed := strconv.FormatUint(guid, 10)
+		metadataValues, _ := metadata.FromIncomingContext(ctx)
+		startTime := time.Now()
+		dappID := extractDappIDFromGrpcHeader(metadataValues)
+
+		grpcHeaders := convertToMetadataMapOfSlices(metadataValues)
+		utils.LavaFormatInfo("GRPC Got Stream Relay ", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: method})
+		metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apiInterface)
+		consumerIp := common.GetIpFromGrpcContext(ctx)
+		ctx, span := metrics.StartRelaySpan(ctx, "grpc", apil.endpoint.ChainID, apiInterface, dappID)
+		relayResult, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, consumerIp, metricsData, grpcHeaders)
+		metrics.EndSpan(span, err)
+		go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
 
-		// try checking for node errors.
-		nodeError := &GrpcNodeErrorResponse{}
-		unMarshalingError := json.Unmarshal(relayReply.Data, nodeError)
-		metadataToReply := relayReply.Metadata
-		if unMarshalingError == nil {
-			return nil, convertRelayMetaDataToMDMetaData(metadataToReply), status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+		if err != nil {
+			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
+			apil.logger.LogRequestAndResponse("grpc stream in/out", true, method, string(reqBody), "", errMasking, msgSeed, time.Since(startTime), err)
+			return utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
+		}
+		apil.logger.LogRequestAndResponse("grpc stream in/out", false, method, string(reqBody), "", "", msgSeed, time.Since(startTime), nil)
+
+		replyServer := relayResult.GetReplyServer()
+		if replyServer == nil {
+			// the provider replied without opening a stream
+			return sendRelayReplyOnGrpcStream(stream, relayResult.GetReply())
+		}
+		for {
+			var reply pairingtypes.RelayReply
+			err = (*replyServer).RecvMsg(&reply)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					// the provider ended the stream
+					return nil
+				}
+				return utils.LavaFormatError("grpc stream relay ended", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: method})
+			}
+			err = sendRelayReplyOnGrpcStream(stream, &reply)
+			if err != nil {
+				return err
+			}
 		}
-		return relayReply.Data, convertRelayMetaDataToMDMetaData(metadataToReply), nil
+	}
+	streamProxy := &grpcproxy.StreamProxy{
+		IsStreaming: apil.chainParser.isStreamingMethod,
+		CallBack:    sendStreamRelayCallback,
 	}
 
-	_, httpServer, err := grpcproxy.NewGRPCProxy(sendRelayCallback, apil.endpoint.HealthCheckPath, cmdFlags)
+	_, httpServer, err := grpcproxy.NewGRPCProxy(sendRelayCallback, streamProxy, apil.endpoint.HealthCheckPath, cmdFlags)
 	if err != nil {
 		utils.LavaFormatFatal("provider failure RegisterServer", err, utils.Attribute{Key: "listenAddr", Value: apil.endpoint.NetworkAddress})
 	}
@@ -347,6 +405,30 @@ func (apil *GrpcChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 	}
 }
 
+// relayReplyToGrpc converts a relay reply to a grpc reply, node errors are returned as the node's status error
+func relayReplyToGrpc(relayReply *pairingtypes.RelayReply) ([]byte, metadata.MD, error) {
+	// try checking for node errors.
+	nodeError := &GrpcNodeErrorResponse{}
+	unMarshalingError := json.Unmarshal(relayReply.Data, nodeError)
+	metadataToReply := relayReply.Metadata
+	if unMarshalingError == nil {
+		return nil, convertRelayMetaDataToMDMetaData(metadataToReply), status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+	}
+	return relayReply.Data, convertRelayMetaDataToMDMetaData(metadataToReply), nil
+}
+
+func sendRelayReplyOnGrpcStream(stream grpc.ServerStream, relayReply *pairingtypes.RelayReply) error {
+	respBytes, md, err := relayReplyToGrpc(relayReply)
+	if len(md) > 0 {
+		// headers can only be set before the first message, later ones are ignored
+		_ = stream.SetHeader(md)
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendMsg(respBytes)
+}
+
 type GrpcChainProxy struct {
 	BaseChainProxy
 	conn             grpcConnectorInterface
@@ -401,14 +483,16 @@ func newGrpcChainProxy(ctx context.Context, nodeUrl string, averageBlockTime tim
 }
 
 func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
-	if ch != nil {
-		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on grpc", nil, utils.Attribute{Key: "GUID", Value: ctx})
-	}
 	conn, err := cp.conn.GetRpc(ctx, true)
 	if err != nil {
 		return nil, "", nil, utils.LavaFormatError("grpc get connection failed ", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
-	defer cp.conn.ReturnRpc(conn)
+	returnConn := true
+	defer func() {
+		if returnConn {
+			cp.conn.ReturnRpc(conn)
+		}
+	}()
 
 	rpcInputMessage := chainMessage.GetRPCMessage()
 	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GrpcMessage)
@@ -455,6 +539,9 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		// add the descriptor to the chainProxy cache
 		cp.descriptorsCache.setDescriptor(methodName, methodDescriptor)
 	}
+	if ch != nil && (!methodDescriptor.IsServerStreaming() || methodDescriptor.IsClientStreaming()) {
+		return nil, "", nil, utils.LavaFormatError("Subscribe is only allowed on grpc server streaming methods", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: nodeMessage.Path})
+	}
 
 	msgFactory := dynamic.NewMessageFactoryWithDefaults()
 
@@ -505,6 +592,11 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 			utils.Attribute{Key: "apiInterface", Value: "grpc"},
 		)
 	}
+	if ch != nil {
+		// a stream keeps the connection until it's done
+		returnConn = false
+		return cp.sendStreamingNodeMsg(ctx, ch, conn, nodeMessage.Path, msg, func() proto.Message { return msgFactory.NewMessage(methodDescriptor.GetOutputType()) }, relayTimeout)
+	}
 	var respHeaders metadata.MD
 	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
 	connectCtx, cancel := cp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
@@ -541,6 +633,79 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	return reply, "", nil, nil
 }
 
+// sendStreamingNodeMsg relays a server streaming method, the first streamed message is returned as the reply
+// and the following ones are sent on ch until the stream ends or the subscription is unsubscribed
+func (cp *GrpcChainProxy) sendStreamingNodeMsg(ctx context.Context, ch chan interface{}, conn *grpc.ClientConn, path string, msg proto.Message, newResponse func() proto.Message, relayTimeout time.Duration) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	var stream *grpcNodeStream
+	respBytes, relayReplyServer, err := relayNodeStream(ctx, ch, relayTimeout, func(streamCtx context.Context) (nodeStream, error) {
+		clientStream, err := conn.NewStream(streamCtx, &grpc.StreamDesc{ServerStreams: true}, "/"+path)
+		if err == nil {
+			err = clientStream.SendMsg(msg)
+			if err == nil {
+				err = clientStream.CloseSend()
+			}
+		}
+		// io.EOF means the stream was rejected, the actual error is returned by RecvMsg
+		if err != nil && !errors.Is(err, io.EOF) {
+			cp.conn.ReturnRpc(conn)
+			return nil, utils.LavaFormatError("failed opening grpc stream", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: path})
+		}
+		stream = &grpcNodeStream{ClientStream: clientStream, newResponse: newResponse, returnConn: func() { cp.conn.ReturnRpc(conn) }}
+		return stream, nil
+	})
+	if err != nil {
+		switch {
+		case stream == nil:
+			return nil, "", nil, err
+		case errors.Is(err, errFirstStreamMessageTimeout):
+			return nil, "", nil, utils.LavaFormatError("timed out waiting for the first grpc stream message", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: path}, utils.Attribute{Key: "timeout", Value: relayTimeout})
+		case errors.Is(err, io.EOF):
+			return nil, "", nil, utils.LavaFormatError("grpc stream ended without messages", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: path})
+		}
+		if parsedError := cp.HandleNodeError(ctx, err); parsedError != nil {
+			return nil, "", nil, parsedError
+		}
+		// a node error is returned to the client without a subscription, same as a unary call
+		respHeaders, _ := stream.Header()
+		respBytes, handlingError := parseGrpcNodeErrorToReply(ctx, err)
+		if handlingError != nil {
+			return nil, "", nil, handlingError
+		}
+		return &pairingtypes.RelayReply{Data: respBytes, Metadata: convertToMetadataMapOfSlices(respHeaders)}, "", nil, nil
+	}
+	respHeaders, _ := stream.Header()
+	reply := &pairingtypes.RelayReply{
+		Data:     respBytes,
+		Metadata: convertToMetadataMapOfSlices(respHeaders),
+	}
+	return reply, path + "-" + string(rpcclient.NewID()), relayReplyServer, nil
+}
+
+// grpcNodeStream reads the messages of a grpc server stream
+type grpcNodeStream struct {
+	grpc.ClientStream
+	newResponse func() proto.Message
+	returnConn  func()
+}
+
+func (gs *grpcNodeStream) next() ([]byte, error) {
+	response := gs.newResponse()
+	err := gs.RecvMsg(response)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(response)
+	if err != nil {
+		return nil, utils.LavaFormatError("proto.Marshal(response) Failed", err)
+	}
+	return data, nil
+}
+
+// close returns the stream's connection, the stream itself is ended by canceling its context
+func (gs *grpcNodeStream) close() {
+	gs.returnConn()
+}
+
 // This method assumes that the error is due to misuse of the request arguments, meaning the user would like to get
 // the response from the server to fix the request arguments. this method will make sure the user will get the response
 // from the node in the same format as expected.
@@ -3,6 +3,7 @@ package chainlib
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -12,11 +13,17 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -291,3 +298,70 @@ func TestSettingBlocksHeadersGrpc(t *testing.T) {
 		})
 	}
 }
+
+func TestGrpcChainProxyServerStreaming(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the health service has both a unary and a server streaming method and is resolved through reflection
+	healthServer := health.NewServer()
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	collectionKey := CollectionKey{ConnectionType: ""}
+	apip := &GrpcChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: "grpc.health.v1.Health/Watch"}: {api: &spectypes.Api{Name: "grpc.health.v1.Health/Watch", Enabled: true, ComputeUnits: 10, Category: spectypes.SpecCategory{Subscription: true}}, collectionKey: collectionKey},
+				{Name: "grpc.health.v1.Health/Check"}: {api: &spectypes.Api{Name: "grpc.health.v1.Health/Check", Enabled: true, ComputeUnits: 10}, collectionKey: collectionKey},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{collectionKey: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceGrpc}}},
+		},
+	}
+	conn, err := chainproxy.NewGRPCConnector(ctx, 1, common.NodeUrl{Url: lis.Addr().String()})
+	require.NoError(t, err)
+	chainProxy, err := newGrpcChainProxy(ctx, lis.Addr().String(), time.Second, apip, conn)
+	require.NoError(t, err)
+
+	// the registry resolves the streaming type of subscriptions
+	require.True(t, apip.isStreamingMethod("grpc.health.v1.Health/Watch"))
+	require.False(t, apip.isStreamingMethod("grpc.health.v1.Health/Check"))
+
+	ch := make(chan interface{})
+	chainMessage, err := apip.ParseMsg("grpc.health.v1.Health/Watch", []byte(`{"service":""}`), "", nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	relayReply, subscriptionID, clientSub, err := chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	require.NoError(t, err)
+	require.NotNil(t, clientSub)
+	require.NotEmpty(t, subscriptionID)
+	response := &healthpb.HealthCheckResponse{}
+	require.NoError(t, protobuf.Unmarshal(relayReply.Data, response))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, response.Status)
+
+	// following messages are sent on the subscription channel
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	select {
+	case data := <-ch:
+		streamed, ok := data.([]byte)
+		require.True(t, ok)
+		require.NoError(t, protobuf.Unmarshal(streamed, response))
+		require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, response.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a streamed message")
+	}
+
+	// unsubscribing ends the stream and closes the error channel
+	clientSub.Unsubscribe()
+	_, open := <-clientSub.Err()
+	require.False(t, open)
+
+	// unary methods can't be subscribed to
+	chainMessage, err = apip.ParseMsg("grpc.health.v1.Health/Check", []byte(`{"service":""}`), "", nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	_, _, _, err = chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	require.Error(t, err)
+}
@@ -172,6 +172,29 @@ func (r *Registry) FindDescriptorByName(name protoreflect.FullName) (protoreflec
 	return r.prefFiles.FindDescriptorByName(name)
 }
 
+// FindMethodDescriptor resolves a grpc method given as "package.Service/Method" or "/package.Service/Method",
+// the returned descriptor tells whether the method is unary or streaming.
+func (r *Registry) FindMethodDescriptor(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	slash := strings.LastIndexByte(fullMethod, '/')
+	if slash <= 0 {
+		return nil, utils.LavaFormatError("invalid grpc method name", nil, utils.Attribute{Key: "method", Value: fullMethod})
+	}
+	sd, err := r.FindDescriptorByName(protoreflect.FullName(fullMethod[:slash]))
+	if err != nil {
+		return nil, err
+	}
+	serviceDescriptor, ok := sd.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, utils.LavaFormatError("Failed converting sd.(protoreflect.ServiceDescriptor)", nil, utils.Attribute{Key: "sd", Value: sd})
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(fullMethod[slash+1:]))
+	if methodDescriptor == nil {
+		return nil, utils.LavaFormatError("method not found in service", protoregistry.NotFound, utils.Attribute{Key: "method", Value: fullMethod})
+	}
+	return methodDescriptor, nil
+}
+
 func (r *Registry) Save() (*descriptorpb.FileDescriptorSet, error) {
 	set := &descriptorpb.FileDescriptorSet{File: make([]*descriptorpb.FileDescriptorProto, 0, r.prefFiles.NumFiles())}
 	var err error
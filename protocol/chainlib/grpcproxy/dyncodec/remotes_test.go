@@ -67,6 +67,16 @@ func TestRemotes(t *testing.T) {
 		mType2, err := registry.FindMessageByURL("type.googleapis.com/grpc.reflection.v1alpha.ServerReflectionRequest")
 		require.NoError(t, err)
 		require.Equal(t, mType.New(), mType2.New())
+
+		// method descriptors expose the streaming type of the method
+		methodDesc, err := registry.FindMethodDescriptor("/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo")
+		require.NoError(t, err)
+		require.True(t, methodDesc.IsStreamingServer())
+		require.True(t, methodDesc.IsStreamingClient())
+		_, err = registry.FindMethodDescriptor("grpc.reflection.v1alpha.ServerReflection/Missing")
+		require.Error(t, err)
+		_, err = registry.FindMethodDescriptor("grpc.reflection.v1alpha.ServerReflection")
+		require.Error(t, err)
 	}
 
 	t.Run("test grpc remote", func(t *testing.T) {
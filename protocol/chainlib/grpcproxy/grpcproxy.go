@@ -18,8 +18,18 @@ import (
 
 type ProxyCallBack = func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error)
 
-func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.ConsumerCmdFlags) (*grpc.Server, *http.Server, error) {
-	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb)), grpc.ForceServerCodec(RawBytesCodec{}))
+// ProxyStreamCallBack relays a server streaming method, the replies are sent on stream and it returns once the stream ended.
+type ProxyStreamCallBack = func(ctx context.Context, method string, reqBody []byte, stream grpc.ServerStream) error
+
+// StreamProxy relays the methods selected by IsStreaming with CallBack instead of the unary ProxyCallBack
+type StreamProxy struct {
+	IsStreaming func(method string) bool
+	CallBack    ProxyStreamCallBack
+}
+
+// NewGRPCProxy creates the proxy server, streamProxy is optional and when nil all methods are relayed as unary calls
+func NewGRPCProxy(cb ProxyCallBack, streamProxy *StreamProxy, healthCheckPath string, cmdFlags common.ConsumerCmdFlags) (*grpc.Server, *http.Server, error) {
+	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb, streamProxy)), grpc.ForceServerCodec(RawBytesCodec{}))
 	wrappedServer := grpcweb.WrapServer(s)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
 		// Set CORS headers
@@ -50,7 +60,7 @@ func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.Cons
 	return s, httpServer, nil
 }
 
-func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
+func makeProxyFunc(callBack ProxyCallBack, streamProxy *StreamProxy) grpc.StreamHandler {
 	return func(srv interface{}, stream grpc.ServerStream) error {
 		// currently the callback function does not account for headers.
 		methodName, ok := grpc.MethodFromServerStream(stream)
@@ -62,7 +72,11 @@ func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
 		if err != nil {
 			return err
 		}
-		respBytes, md, err := callBack(stream.Context(), methodName[1:], reqBytes) // strip first '/' of the method name
+		method := methodName[1:] // strip first '/' of the method name
+		if streamProxy != nil && streamProxy.IsStreaming(method) {
+			return streamProxy.CallBack(stream.Context(), method, reqBytes, stream)
+		}
+		respBytes, md, err := callBack(stream.Context(), method, reqBytes)
 		if err != nil {
 			return err
 		}
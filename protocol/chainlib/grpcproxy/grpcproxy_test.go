@@ -2,11 +2,14 @@ package grpcproxy
 
 import (
 	"context"
+	"io"
+	"strconv"
 	"testing"
 
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy/testproto"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -21,7 +24,7 @@ func TestGRPCProxy(t *testing.T) {
 		responseHeaders := make(metadata.MD)
 		responseHeaders["test-headers"] = append(responseHeaders["test-headers"], "55")
 		return respBytes, responseHeaders, nil
-	}, "", common.ConsumerCmdFlags{HeadersFlag: "*", OriginFlag: "*", MethodsFlag: "GET,POST,OPTIONS", CDNCacheDuration: "86400"})
+	}, nil, "", common.ConsumerCmdFlags{HeadersFlag: "*", OriginFlag: "*", MethodsFlag: "GET,POST,OPTIONS", CDNCacheDuration: "86400"})
 	require.NoError(t, err)
 
 	client := testproto.NewTestClient(testproto.InMemoryClientConn(t, proxyGRPCSrv))
@@ -37,3 +40,56 @@ func TestGRPCProxy(t *testing.T) {
 	do()
 	do()
 }
+
+func TestGRPCProxyStream(t *testing.T) {
+	const streamingMethod = "testproto.Test/Stream"
+	streamProxy := &StreamProxy{
+		IsStreaming: func(method string) bool { return method == streamingMethod },
+		CallBack: func(ctx context.Context, method string, reqBody []byte, stream grpc.ServerStream) error {
+			req := new(testproto.TestRequest)
+			err := req.Unmarshal(reqBody)
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				respBytes, err := (&testproto.TestResponse{Response: req.Request + "-" + strconv.Itoa(i)}).Marshal()
+				require.NoError(t, err)
+				err = stream.SendMsg(respBytes)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	proxyGRPCSrv, _, err := NewGRPCProxy(func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
+		respBytes, err := (&testproto.TestResponse{Response: "unary"}).Marshal()
+		return respBytes, nil, err
+	}, streamProxy, "", common.ConsumerCmdFlags{})
+	require.NoError(t, err)
+
+	conn := testproto.InMemoryClientConn(t, proxyGRPCSrv)
+	ctx := context.Background()
+
+	// unary methods are still relayed by the unary callback
+	resp, err := testproto.NewTestClient(conn).Test(ctx, &testproto.TestRequest{Request: "echo"})
+	require.NoError(t, err)
+	require.Equal(t, "unary", resp.Response)
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/"+streamingMethod, grpc.ForceCodec(RawBytesCodec{}))
+	require.NoError(t, err)
+	reqBytes, err := (&testproto.TestRequest{Request: "echo"}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(reqBytes))
+	require.NoError(t, stream.CloseSend())
+	for i := 0; ; i++ {
+		var respBytes []byte
+		err = stream.RecvMsg(&respBytes)
+		if err == io.EOF {
+			require.Equal(t, 3, i)
+			break
+		}
+		require.NoError(t, err)
+		resp := new(testproto.TestResponse)
+		require.NoError(t, resp.Unmarshal(respBytes))
+		require.Equal(t, "echo-"+strconv.Itoa(i), resp.Response)
+	}
+}
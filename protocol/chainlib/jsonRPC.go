@@ -16,7 +16,6 @@ import (
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
-	"github.com/lavanet/lava/protocol/parser"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
@@ -79,7 +78,7 @@ func (apip *JsonRPCChainParser) CraftMessage(parsing *spectypes.ParseDirective,
 	if err != nil {
 		return nil, err
 	}
-	return apip.newChainMessage(apiCont.api, spectypes.NOT_APPLICABLE, msg, apiCollection), nil
+	return apip.newChainMessage(apiCont.api, spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, msg, apiCollection), nil
 }
 
 // this func parses message data into chain message object
@@ -102,7 +101,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 	var apiCollection *spectypes.ApiCollection
 	var latestRequestedBlock, earliestRequestedBlock int64 = 0, 0
 	for idx, msg := range msgs {
-		var requestedBlockForMessage int64
+		var requestedBlockForMessage, earliestRequestedBlockForMessage int64
 		// Check api is supported and save it in nodeMsg
 		apiCont, err := apip.getSupportedApi(msg.Method, connectionType)
 		if err != nil {
@@ -120,7 +119,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 
 		if overwriteReqBlock == "" {
 			// Fetch requested block, it is used for data reliability
-			requestedBlockForMessage, err = parser.ParseBlockFromParams(msg, apiCont.api.BlockParsing)
+			requestedBlockForMessage, err = apip.parseRequestedBlock(msg, apiCont.api.BlockParsing)
 			if err != nil {
 				utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 				requestedBlockForMessage = spectypes.NOT_APPLICABLE
@@ -132,11 +131,17 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 				requestedBlockForMessage = spectypes.NOT_APPLICABLE
 			}
 		}
+		earliestRequestedBlockForMessage = requestedBlockForMessage
+		if overwriteReqBlock == "" && apiCont.api.EarliestBlockParsing != nil {
+			// the api requests a range of blocks, e.g. eth_getLogs fromBlock and toBlock
+			requestedBlockForMessage, earliestRequestedBlockForMessage = apip.parseRequestedBlockRange(msg, *apiCont.api.EarliestBlockParsing, requestedBlockForMessage)
+		}
 		if idx == 0 {
 			// on the first entry store them
 			api = apiCont.api
 			apiCollection = apiCollectionForMessage
 			latestRequestedBlock = requestedBlockForMessage
+			earliestRequestedBlock = earliestRequestedBlockForMessage
 		} else {
 			// on next entries we need to compare to existing data
 			if api == nil {
@@ -171,12 +176,13 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 					Encoding:     "",
 				},
 			}
-			latestRequestedBlock, earliestRequestedBlock = CompareRequestedBlockInBatch(latestRequestedBlock, requestedBlockForMessage)
+			latestRequestedBlock, _ = CompareRequestedBlockInBatch(latestRequestedBlock, requestedBlockForMessage)
+			_, earliestRequestedBlock = CompareRequestedBlockInBatch(earliestRequestedBlock, earliestRequestedBlockForMessage)
 		}
 	}
 	var nodeMsg *baseChainMessageContainer
 	if len(msgs) == 1 {
-		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, &msgs[0], apiCollection)
+		nodeMsg = apip.newChainMessage(api, latestRequestedBlock, earliestRequestedBlock, &msgs[0], apiCollection)
 	} else {
 		nodeMsg, err = apip.newBatchChainMessage(api, latestRequestedBlock, earliestRequestedBlock, msgs, apiCollection)
 		if err != nil {
@@ -202,12 +208,13 @@ func (*JsonRPCChainParser) newBatchChainMessage(serviceApi *spectypes.Api, reque
 	return nodeMsg, err
 }
 
-func (*JsonRPCChainParser) newChainMessage(serviceApi *spectypes.Api, requestedBlock int64, msg *rpcInterfaceMessages.JsonrpcMessage, apiCollection *spectypes.ApiCollection) *baseChainMessageContainer {
+func (*JsonRPCChainParser) newChainMessage(serviceApi *spectypes.Api, requestedBlock int64, earliestRequestedBlock int64, msg *rpcInterfaceMessages.JsonrpcMessage, apiCollection *spectypes.ApiCollection) *baseChainMessageContainer {
 	nodeMsg := &baseChainMessageContainer{
-		api:                  serviceApi,
-		apiCollection:        apiCollection,
-		latestRequestedBlock: requestedBlock,
-		msg:                  msg,
+		api:                    serviceApi,
+		apiCollection:          apiCollection,
+		latestRequestedBlock:   requestedBlock,
+		earliestRequestedBlock: earliestRequestedBlock,
+		msg:                    msg,
 	}
 	return nodeMsg
 }
@@ -341,7 +348,9 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 			defer cancel() // incase there's a problem make sure to cancel the connection
 			utils.LavaFormatDebug("ws in <<<", utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "msg", Value: msg}, utils.Attribute{Key: "dappID", Value: dappID})
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
+			ctx, span := metrics.StartRelaySpan(ctx, "jsonrpc ws", chainID, apiInterface, dappID)
 			relayResult, err := apil.relaySender.SendRelay(ctx, "", string(msg), http.MethodPost, dappID, websockConn.RemoteAddr().String(), metricsData, nil)
+			metrics.EndSpan(span, err)
 			reply := relayResult.GetReply()
 			replyServer := relayResult.GetReplyServer()
 			go apil.logger.AddMetricForWebSocket(metricsData, err, websockConn)
@@ -413,7 +422,9 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 		consumerIp := fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP())
 		metadataValues := fiberCtx.GetReqHeaders()
 		headers := convertToMetadataMap(metadataValues)
+		ctx, span := metrics.StartRelaySpan(ctx, "jsonrpc http", chainID, apiInterface, dappID)
 		relayResult, err := apil.relaySender.SendRelay(ctx, "", string(fiberCtx.Body()), http.MethodPost, dappID, consumerIp, metricsData, headers)
+		metrics.EndSpan(span, err)
 		reply := relayResult.GetReply()
 		go apil.logger.AddMetricForHttp(metricsData, err, fiberCtx.GetReqHeaders())
 		if err != nil {
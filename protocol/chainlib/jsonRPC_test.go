@@ -341,3 +341,105 @@ func TestJsonRpcBatchCallSameID(t *testing.T) {
 		}
 	}()
 }
+
+type blockHashResolverMock map[string]int64
+
+func (bhr blockHashResolverMock) GetBlockNumByHash(blockHash string) (int64, bool) {
+	blockNum, found := bhr[blockHash]
+	return blockNum, found
+}
+
+func TestJSONParseMessageBlockHash(t *testing.T) {
+	const blockHash = "0x9291edc036ae2a4fcd6e0237f0ef13c452e7f0872e8b6f7569b3a8097a8dc91d"
+	apip := &JsonRPCChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: "eth_getBlockByHash", ConnectionType: connectionType_test}: {api: &spectypes.Api{
+					Name:    "eth_getBlockByHash",
+					Enabled: true,
+					BlockParsing: spectypes.BlockParser{
+						ParserArg:  []string{"0"},
+						ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG,
+					},
+				}, collectionKey: CollectionKey{ConnectionType: connectionType_test}},
+				{Name: "eth_call", ConnectionType: connectionType_test}: {api: &spectypes.Api{
+					Name:    "eth_call",
+					Enabled: true,
+					BlockParsing: spectypes.BlockParser{
+						ParserArg:  []string{"1"},
+						ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG,
+					},
+				}, collectionKey: CollectionKey{ConnectionType: connectionType_test}},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{{ConnectionType: connectionType_test}: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceJsonRPC}}},
+		},
+	}
+	parseRequestedBlock := func(data string) int64 {
+		msg, err := apip.ParseMsg("", []byte(data), connectionType_test, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+		require.NoError(t, err)
+		requestedBlock, _ := msg.RequestedBlock()
+		return requestedBlock
+	}
+	getBlockByHash := `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["` + blockHash + `",false]}`
+	callByHash := `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0"},{"blockHash":"` + blockHash + `"}]}`
+	callByNumber := `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0"},{"blockNumber":"0x10"}]}`
+
+	// without a resolver the hash is not applicable
+	require.Equal(t, spectypes.NOT_APPLICABLE, parseRequestedBlock(getBlockByHash))
+	require.Equal(t, int64(16), parseRequestedBlock(callByNumber))
+
+	// hashes are resolved in the encoding they were stored in, the chain tracker keeps hex hashes in base64
+	apip.SetBlockHashResolver(blockHashResolverMock{"kpHtwDauKk/NbgI38O8TxFLn8Icui291abOoCXqNyR0=": 1000})
+	require.Equal(t, int64(1000), parseRequestedBlock(getBlockByHash))
+	require.Equal(t, int64(1000), parseRequestedBlock(callByHash))
+
+	apip.SetBlockHashResolver(blockHashResolverMock{})
+	require.Equal(t, spectypes.NOT_APPLICABLE, parseRequestedBlock(callByHash))
+}
+
+func TestJSONParseMessageBlockRange(t *testing.T) {
+	apip := &JsonRPCChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: "eth_getLogs", ConnectionType: connectionType_test}: {api: &spectypes.Api{
+					Name:    "eth_getLogs",
+					Enabled: true,
+					BlockParsing: spectypes.BlockParser{
+						ParserArg:    []string{"$[0].toBlock"},
+						ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+						DefaultValue: "latest",
+					},
+					EarliestBlockParsing: &spectypes.BlockParser{
+						ParserArg:    []string{"$[0].fromBlock"},
+						ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+						DefaultValue: "latest",
+					},
+				}, collectionKey: CollectionKey{ConnectionType: connectionType_test}},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{{ConnectionType: connectionType_test}: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceJsonRPC}}},
+		},
+	}
+	testCases := []struct {
+		name             string
+		filter           string
+		expectedLatest   int64
+		expectedEarliest int64
+	}{
+		{name: "range", filter: `{"fromBlock":"0x10","toBlock":"0x20"}`, expectedLatest: 32, expectedEarliest: 16},
+		{name: "to latest", filter: `{"fromBlock":"0x10"}`, expectedLatest: spectypes.LATEST_BLOCK, expectedEarliest: 16},
+		{name: "from genesis", filter: `{"fromBlock":"0x0","toBlock":"0x20"}`, expectedLatest: 32, expectedEarliest: spectypes.EARLIEST_BLOCK},
+		{name: "no range", filter: `{}`, expectedLatest: spectypes.LATEST_BLOCK, expectedEarliest: spectypes.LATEST_BLOCK},
+		// an earliest block that fails parsing leaves only the requested block
+		{name: "invalid from", filter: `{"fromBlock":"garbage","toBlock":"0x20"}`, expectedLatest: 32, expectedEarliest: 32},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data := `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[` + testCase.filter + `]}`
+			msg, err := apip.ParseMsg("", []byte(data), connectionType_test, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+			require.NoError(t, err)
+			latest, earliest := msg.RequestedBlock()
+			require.Equal(t, testCase.expectedLatest, latest)
+			require.Equal(t, testCase.expectedEarliest, earliest)
+		})
+	}
+}
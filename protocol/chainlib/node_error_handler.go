@@ -129,6 +129,12 @@ func (geh *GRPCErrorHandler) HandleNodeError(ctx context.Context, nodeError erro
 	return geh.handleGenericErrors(ctx, nodeError)
 }
 
+type GraphQLErrorHandler struct{ genericErrorHandler }
+
+func (geh *GraphQLErrorHandler) HandleNodeError(ctx context.Context, nodeError error) error {
+	return geh.handleGenericErrors(ctx, nodeError)
+}
+
 type ErrorHandler interface {
 	HandleNodeError(context.Context, error) error
 	HandleStatusError(int, bool) error
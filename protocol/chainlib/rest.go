@@ -1,14 +1,17 @@
 package chainlib
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
@@ -16,7 +19,6 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
 	"github.com/lavanet/lava/protocol/lavasession"
-	"github.com/lavanet/lava/protocol/parser"
 	"github.com/lavanet/lava/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -24,6 +26,8 @@ import (
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	gorillawebsocket "github.com/gorilla/websocket"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/metrics"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -116,7 +120,7 @@ func (apip *RestChainParser) ParseMsg(urlPath string, data []byte, connectionTyp
 	var requestedBlock int64
 	if overwriteReqBlock == "" {
 		// Fetch requested block, it is used for data reliability
-		requestedBlock, err = parser.ParseBlockFromParams(restMessage, blockParser)
+		requestedBlock, err = apip.parseRequestedBlock(restMessage, blockParser)
 		if err != nil {
 			utils.LavaFormatError("ParseBlockFromParams failed parsing block", err, utils.Attribute{Key: "chain", Value: apip.spec.Name}, utils.Attribute{Key: "blockParsing", Value: apiCont.api.BlockParsing})
 			requestedBlock = spectypes.NOT_APPLICABLE
@@ -264,6 +268,60 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 
 	chainID := apil.endpoint.ChainID
 	apiInterface := apil.endpoint.ApiInterface
+
+	// streaming apis are relayed as subscriptions, a websocket connection to the api path subscribes to it
+	webSocketCallback := websocket.New(func(websocketConn *websocket.Conn) {
+		startTime := time.Now()
+		path, _ := websocketConn.Locals(restStreamPathLocal).(string)
+		restHeaders, _ := websocketConn.Locals(restStreamHeadersLocal).([]pairingtypes.Metadata)
+		dappID, _ := websocketConn.Locals("dapp-id").(string)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		guid := utils.GenerateUniqueIdentifier()
+		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		msgSeed := strconv.FormatUint(guid, 10)
+		utils.LavaFormatInfo("ws in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
+		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
+		ctx, span := metrics.StartRelaySpan(ctx, "rest ws", chainID, apiInterface, dappID)
+		relayResult, err := apil.relaySender.SendRelay(ctx, path, "", http.MethodGet, dappID, websocketConn.RemoteAddr().String(), metricsData, restHeaders)
+		metrics.EndSpan(span, err)
+		go apil.logger.AddMetricForWebSocket(metricsData, err, websocketConn)
+		if err != nil {
+			apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, websocket.TextMessage, err, msgSeed, []byte(path), spectypes.APIInterfaceRest, time.Since(startTime))
+			return
+		}
+		// the client doesn't send messages, reading only detects it closing the connection
+		go func() {
+			for {
+				if _, _, err := websocketConn.ReadMessage(); err != nil {
+					cancel()
+					return
+				}
+			}
+		}()
+		err = forwardSubscriptionReplies(ctx, relayResult, func(data []byte) error {
+			return websocketConn.WriteMessage(websocket.TextMessage, data)
+		})
+		if err != nil {
+			apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, websocket.TextMessage, err, msgSeed, []byte(path), spectypes.APIInterfaceRest, time.Since(startTime))
+			return
+		}
+		apil.logger.LogRequestAndResponse("rest ws", false, "ws", path, "", "", msgSeed, time.Since(startTime), nil)
+	})
+	websocketCallbackWithDappID := constructFiberCallbackWithHeaderAndParameterExtraction(webSocketCallback, apil.logger.StoreMetricData)
+	app.Use("/*", func(fiberCtx *fiber.Ctx) error {
+		if websocket.IsWebSocketUpgrade(fiberCtx) {
+			fiberCtx.Locals(restStreamPathLocal, "/"+fiberCtx.Params("*")+"?"+string(fiberCtx.Request().URI().QueryString()))
+			fiberCtx.Locals(restStreamHeadersLocal, convertToMetadataMap(fiberCtx.GetReqHeaders()))
+			return websocketCallbackWithDappID(fiberCtx)
+		}
+		// clients that accept server sent events get the replies of streaming apis as events
+		if strings.Contains(fiberCtx.Get(fiber.HeaderAccept), mimeTextEventStream) {
+			return apil.serveEventStream(fiberCtx)
+		}
+		return fiberCtx.Next()
+	})
+
 	// Catch Post
 	app.Post("/*", func(fiberCtx *fiber.Ctx) error {
 		// Set response header content-type to application/json
@@ -291,7 +349,9 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		analytics := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
 		requestBody := string(fiberCtx.Body())
+		ctx, span := metrics.StartRelaySpan(ctx, "rest http post", chainID, apiInterface, dappID)
 		relayResult, err := apil.relaySender.SendRelay(ctx, path+query, requestBody, http.MethodPost, dappID, fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP()), analytics, restHeaders)
+		metrics.EndSpan(span, err)
 		reply := relayResult.GetReply()
 		go apil.logger.AddMetricForHttp(analytics, err, fiberCtx.GetReqHeaders())
 		if err != nil {
@@ -347,7 +407,9 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		}
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		utils.LavaFormatInfo("in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
+		ctx, span := metrics.StartRelaySpan(ctx, "rest http get", chainID, apiInterface, dappID)
 		relayResult, err := apil.relaySender.SendRelay(ctx, path+query, "", fiberCtx.Method(), dappID, fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP()), analytics, restHeaders)
+		metrics.EndSpan(span, err)
 		reply := relayResult.GetReply()
 		go apil.logger.AddMetricForHttp(analytics, err, fiberCtx.GetReqHeaders())
 		if err != nil {
@@ -384,6 +446,139 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 	ListenWithRetry(app, apil.endpoint.NetworkAddress)
 }
 
+// serveEventStream relays a request whose replies are sent to the client as server sent events, one event per reply of a subscription
+func (apil *RestChainListener) serveEventStream(fiberCtx *fiber.Ctx) error {
+	chainID := apil.endpoint.ChainID
+	apiInterface := apil.endpoint.ApiInterface
+	startTime := time.Now()
+	msgSeed := apil.logger.GetMessageSeed()
+	query := "?" + string(fiberCtx.Request().URI().QueryString())
+	path := "/" + fiberCtx.Params("*")
+
+	restHeaders := convertToMetadataMap(fiberCtx.GetReqHeaders())
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+	guid, found := utils.GetUniqueIdentifier(ctx)
+	if found {
+		msgSeed = strconv.FormatUint(guid, 10)
+	}
+	dappID := extractDappIDFromFiberContext(fiberCtx)
+	analytics := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
+	utils.LavaFormatInfo("sse in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: path}, utils.Attribute{Key: "dappID", Value: dappID}, utils.Attribute{Key: "msgSeed", Value: msgSeed})
+	requestBody := string(fiberCtx.Body())
+	ctx, span := metrics.StartRelaySpan(ctx, "rest sse", chainID, apiInterface, dappID)
+	relayResult, err := apil.relaySender.SendRelay(ctx, path+query, requestBody, fiberCtx.Method(), dappID, fiberCtx.Get(common.IP_FORWARDING_HEADER_NAME, fiberCtx.IP()), analytics, restHeaders)
+	metrics.EndSpan(span, err)
+	go apil.logger.AddMetricForHttp(analytics, err, fiberCtx.GetReqHeaders())
+	if err != nil {
+		cancel()
+		errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
+		apil.logger.LogRequestAndResponse("sse in/out", true, fiberCtx.Method(), path, requestBody, errMasking, msgSeed, time.Since(startTime), err)
+		if relayResult.GetStatusCode() != 0 {
+			fiberCtx.Status(relayResult.StatusCode)
+		} else {
+			fiberCtx.Status(fiber.StatusInternalServerError)
+		}
+		fiberCtx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		return addHeadersAndSendString(fiberCtx, relayResult.GetReply().GetMetadata(), convertToJsonError(errMasking))
+	}
+
+	fiberCtx.Set(fiber.HeaderContentType, mimeTextEventStream)
+	fiberCtx.Set(fiber.HeaderCacheControl, "no-cache")
+	fiberCtx.Set(fiber.HeaderConnection, "keep-alive")
+	method := fiberCtx.Method()
+	serverDone := fiberCtx.Context().Done()
+	fiberCtx.Context().SetBodyStreamWriter(func(writer *bufio.Writer) {
+		defer cancel()
+		err := forwardServerSentEvents(ctx, cancel, serverDone, relayResult, writer, serverSentEventsKeepAliveInterval)
+		apil.logger.LogRequestAndResponse("sse in/out", err != nil, method, path, requestBody, "", msgSeed, time.Since(startTime), err)
+	})
+	return nil
+}
+
+// the interval of the keep-alive comments written to server sent events clients, writing them is how a client that left is noticed
+const serverSentEventsKeepAliveInterval = 15 * time.Second
+
+// forwardServerSentEvents writes the replies of a relay to writer as server sent events. while waiting for replies a keep-alive comment
+// is written every keepAliveInterval, the relay is canceled when writing it fails as the client closed the connection, or when done is closed
+func forwardServerSentEvents(ctx context.Context, cancel context.CancelFunc, done <-chan struct{}, relayResult *common.RelayResult, writer *bufio.Writer, keepAliveInterval time.Duration) error {
+	var writeLock sync.Mutex
+	keepAliveCtx, stopKeepAlive := context.WithCancel(ctx)
+	keepAliveStopped := make(chan struct{})
+	go func() {
+		defer close(keepAliveStopped)
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-keepAliveCtx.Done():
+				return
+			case <-done:
+				cancel()
+				return
+			case <-ticker.C:
+				writeLock.Lock()
+				fmt.Fprint(writer, ": keep-alive\n\n")
+				err := writer.Flush()
+				writeLock.Unlock()
+				if err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	// the writer can't be used once this returns
+	defer func() {
+		stopKeepAlive()
+		<-keepAliveStopped
+	}()
+	return forwardSubscriptionReplies(ctx, relayResult, func(data []byte) error {
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		writeServerSentEvent(writer, data)
+		// fails once the client closed the connection
+		return writer.Flush()
+	})
+}
+
+// forwardSubscriptionReplies writes the reply of a relay, and when the relay is a subscription every following reply,
+// until the subscription ends, ctx is done or writing fails
+func forwardSubscriptionReplies(ctx context.Context, relayResult *common.RelayResult, write func(data []byte) error) error {
+	replyServer := relayResult.GetReplyServer()
+	if replyServer == nil {
+		return write(relayResult.GetReply().GetData())
+	}
+	for {
+		var reply pairingtypes.RelayReply
+		err := (*replyServer).RecvMsg(&reply)
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				// the subscription ended or the client left
+				return nil
+			}
+			return err
+		}
+		err = write(reply.Data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// writeServerSentEvent writes data as a single event, every line of the data is a data field of the event
+func writeServerSentEvent(writer io.Writer, data []byte) {
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(writer, "data: %s\n", line)
+	}
+	fmt.Fprint(writer, "\n")
+}
+
+const (
+	restStreamPathLocal    = "rest-stream-path"
+	restStreamHeadersLocal = "rest-stream-headers"
+)
+
 func addHeadersAndSendString(c *fiber.Ctx, metaData []pairingtypes.Metadata, data string) error {
 	for _, value := range metaData {
 		c.Set(value.Name, value.Value)
@@ -394,7 +589,9 @@ func addHeadersAndSendString(c *fiber.Ctx, metaData []pairingtypes.Metadata, dat
 
 type RestChainProxy struct {
 	BaseChainProxy
-	httpClient *http.Client
+	httpClient          *http.Client
+	streamingHttpClient *http.Client
+	websocketUrl        *common.NodeUrl // optional, streaming apis are relayed over it instead of server sent events
 }
 
 func NewRestChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
@@ -402,18 +599,40 @@ func NewRestChainProxy(ctx context.Context, nConns uint, rpcProviderEndpoint lav
 		return nil, utils.LavaFormatError("rpcProviderEndpoint.NodeUrl list is empty missing node url", nil, utils.Attribute{Key: "chainID", Value: rpcProviderEndpoint.ChainID}, utils.Attribute{Key: "ApiInterface", Value: rpcProviderEndpoint.ApiInterface})
 	}
 	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
-	nodeUrl := rpcProviderEndpoint.NodeUrls[0]
-	nodeUrl.Url = strings.TrimSuffix(rpcProviderEndpoint.NodeUrls[0].Url, "/")
+	nodeUrl, websocketUrl := verifyRestEndpoint(rpcProviderEndpoint.NodeUrls)
 	rcp := &RestChainProxy{
-		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: rpcProviderEndpoint.NodeUrls[0], ErrorHandler: &RestErrorHandler{}, ChainID: rpcProviderEndpoint.ChainID},
+		BaseChainProxy: BaseChainProxy{averageBlockTime: averageBlockTime, NodeUrl: nodeUrl, ErrorHandler: &RestErrorHandler{}, ChainID: rpcProviderEndpoint.ChainID},
+		websocketUrl:   websocketUrl,
 	}
 	return rcp, nil
 }
 
-func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
-	if ch != nil {
-		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on rest", nil)
+// rest requests are sent to the http url, a websocket url is optional and is used only for streaming apis
+func verifyRestEndpoint(endpoints []common.NodeUrl) (httpEndpoint common.NodeUrl, websocketEndpoint *common.NodeUrl) {
+	httpEndpoint = endpoints[0]
+	foundHttpEndpoint := false
+	for idx := range endpoints {
+		endpoint := endpoints[idx]
+		endpoint.Url = strings.TrimSuffix(endpoint.Url, "/") // remove suffix if exists
+		u, err := url.Parse(endpoint.Url)
+		if err == nil && (u.Scheme == "ws" || u.Scheme == "wss") {
+			if websocketEndpoint == nil {
+				websocketEndpoint = &endpoint
+			}
+			continue
+		}
+		if !foundHttpEndpoint {
+			httpEndpoint = endpoint
+			foundHttpEndpoint = true
+		}
 	}
+	if !foundHttpEndpoint {
+		utils.LavaFormatError("Rest Provider was not provided with an http url. please provide a url that starts with http/https", nil, utils.Attribute{Key: "url", Value: httpEndpoint.UrlStr()})
+	}
+	return httpEndpoint, websocketEndpoint
+}
+
+func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
 	if rcp.httpClient == nil {
 		rcp.httpClient = &http.Client{
 			Timeout: 5 * time.Minute, // we are doing a timeout by request
@@ -426,14 +645,6 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if !ok {
 		return nil, "", nil, utils.LavaFormatError("invalid message type in rest, failed to cast RPCInput from chainMessage", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "rpcMessage", Value: rpcInputMessage})
 	}
-	var connectionTypeSlected string = http.MethodGet
-	// if ConnectionType is default value or empty we will choose http.MethodGet otherwise choosing the header type provided
-	if chainMessage.GetApiCollection().CollectionData.Type != "" {
-		connectionTypeSlected = chainMessage.GetApiCollection().CollectionData.Type
-	}
-
-	msgBuffer := bytes.NewBuffer(nodeMessage.Msg)
-	urlPath := rcp.NodeUrl.Url + nodeMessage.Path
 
 	relayTimeout := common.LocalNodeTimePerCu(chainMessage.GetApi().ComputeUnits)
 	// check if this API is hanging (waiting for block confirmation)
@@ -441,27 +652,21 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		relayTimeout += rcp.averageBlockTime
 	}
 
+	if ch != nil {
+		if !chainMessage.GetApi().Category.Subscription {
+			return nil, "", nil, utils.LavaFormatError("Subscribe is only allowed on streaming rest apis", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "apiName", Value: chainMessage.GetApi().Name})
+		}
+		return rcp.sendStreamingNodeMsg(ctx, ch, chainMessage, nodeMessage, relayTimeout)
+	}
+
 	connectCtx, cancel := rcp.NodeUrl.LowerContextTimeout(ctx, relayTimeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(connectCtx, connectionTypeSlected, rcp.NodeUrl.AuthConfig.AddAuthPath(urlPath), msgBuffer)
+	req, err := rcp.newNodeRequest(connectCtx, chainMessage, nodeMessage)
 	if err != nil {
 		return nil, "", nil, err
 	}
 
-	// setting the content-type to be application/json instead of Go's defult http.DefaultClient
-	if connectionTypeSlected == http.MethodPost || connectionTypeSlected == http.MethodPut {
-		req.Header.Set("Content-Type", "application/json")
-	}
-
-	if len(nodeMessage.GetHeaders()) > 0 {
-		for _, metadata := range nodeMessage.GetHeaders() {
-			req.Header.Set(metadata.Name, metadata.Value)
-		}
-	}
-	rcp.NodeUrl.SetAuthHeaders(ctx, req.Header.Set)
-	rcp.NodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
-
 	if debug {
 		utils.LavaFormatDebug("provider sending node message",
 			utils.Attribute{Key: "method", Value: nodeMessage.Path},
@@ -512,3 +717,203 @@ func (rcp *RestChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 
 	return reply, "", nil, nil
 }
+
+// newNodeRequest creates the http request of a rest message, with the message headers and the node url authentication
+func (rcp *RestChainProxy) newNodeRequest(ctx context.Context, chainMessage ChainMessageForSend, nodeMessage *rpcInterfaceMessages.RestMessage) (*http.Request, error) {
+	var connectionTypeSlected string = http.MethodGet
+	// if ConnectionType is default value or empty we will choose http.MethodGet otherwise choosing the header type provided
+	if chainMessage.GetApiCollection().CollectionData.Type != "" {
+		connectionTypeSlected = chainMessage.GetApiCollection().CollectionData.Type
+	}
+
+	msgBuffer := bytes.NewBuffer(nodeMessage.Msg)
+	urlPath := rcp.NodeUrl.Url + nodeMessage.Path
+
+	req, err := http.NewRequestWithContext(ctx, connectionTypeSlected, rcp.NodeUrl.AuthConfig.AddAuthPath(urlPath), msgBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	// setting the content-type to be application/json instead of Go's defult http.DefaultClient
+	if connectionTypeSlected == http.MethodPost || connectionTypeSlected == http.MethodPut {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if len(nodeMessage.GetHeaders()) > 0 {
+		for _, metadata := range nodeMessage.GetHeaders() {
+			req.Header.Set(metadata.Name, metadata.Value)
+		}
+	}
+	rcp.NodeUrl.SetAuthHeaders(ctx, req.Header.Set)
+	rcp.NodeUrl.SetIpForwardingIfNecessary(ctx, req.Header.Set)
+	return req, nil
+}
+
+// sendStreamingNodeMsg relays a streaming rest api, the node streams over the websocket url when one is configured and over server sent events otherwise.
+// the first streamed message is returned as the reply and the following ones are sent on ch until the stream ends or the subscription is unsubscribed
+func (rcp *RestChainProxy) sendStreamingNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, nodeMessage *rpcInterfaceMessages.RestMessage, relayTimeout time.Duration) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	var stream nodeStream
+	var headers http.Header
+	data, relayReplyServer, err := relayNodeStream(ctx, ch, relayTimeout, func(streamCtx context.Context) (nodeStream, error) {
+		var err error
+		if rcp.websocketUrl != nil {
+			stream, headers, err = rcp.dialWebsocketStream(streamCtx, nodeMessage)
+		} else {
+			stream, headers, err = rcp.openEventStream(streamCtx, chainMessage, nodeMessage)
+		}
+		return stream, err
+	})
+	if err != nil {
+		switch {
+		case stream == nil:
+			if parsedError := rcp.HandleNodeError(ctx, err); parsedError != nil {
+				return nil, "", nil, parsedError
+			}
+			return nil, "", nil, err
+		case errors.Is(err, errFirstStreamMessageTimeout):
+			return nil, "", nil, utils.LavaFormatError("timed out waiting for the first rest stream message", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: nodeMessage.Path}, utils.Attribute{Key: "timeout", Value: relayTimeout})
+		case errors.Is(err, io.EOF):
+			return nil, "", nil, utils.LavaFormatError("rest stream ended without messages", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: nodeMessage.Path})
+		}
+		return nil, "", nil, utils.LavaFormatError("failed reading rest stream", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "path", Value: nodeMessage.Path})
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:     data,
+		Metadata: convertToMetadataMapOfSlices(headers),
+	}
+	return reply, nodeMessage.Path + "-" + string(rpcclient.NewID()), relayReplyServer, nil
+}
+
+func (rcp *RestChainProxy) openEventStream(ctx context.Context, chainMessage ChainMessageForSend, nodeMessage *rpcInterfaceMessages.RestMessage) (nodeStream, http.Header, error) {
+	if rcp.streamingHttpClient == nil {
+		// streams don't have a timeout, they last until they are unsubscribed
+		rcp.streamingHttpClient = &http.Client{}
+	}
+	req, err := rcp.newNodeRequest(ctx, chainMessage, nodeMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set(fiber.HeaderAccept, mimeTextEventStream)
+	res, err := rcp.streamingHttpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = rcp.HandleStatusError(res.StatusCode, nodeMessage.GetDisableErrorHandling())
+	if err != nil {
+		res.Body.Close()
+		return nil, nil, utils.LavaFormatWarning("Received invalid status code", nil, utils.Attribute{Key: "Status Code", Value: res.StatusCode}, utils.Attribute{Key: "chainID", Value: rcp.BaseChainProxy.ChainID}, utils.Attribute{Key: "apiName", Value: chainMessage.GetApi().Name})
+	}
+	if !strings.HasPrefix(res.Header.Get(fiber.HeaderContentType), mimeTextEventStream) {
+		// the node replied without streaming, its reply is the only message
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &singleMessageStream{data: body}, res.Header, nil
+	}
+	return newServerSentEventsStream(res.Body), res.Header, nil
+}
+
+func (rcp *RestChainProxy) dialWebsocketStream(ctx context.Context, nodeMessage *rpcInterfaceMessages.RestMessage) (nodeStream, http.Header, error) {
+	header := http.Header{}
+	for _, metadata := range nodeMessage.GetHeaders() {
+		header.Set(metadata.Name, metadata.Value)
+	}
+	rcp.websocketUrl.SetAuthHeaders(ctx, header.Set)
+	rcp.websocketUrl.SetIpForwardingIfNecessary(ctx, header.Set)
+	conn, res, err := gorillawebsocket.DefaultDialer.DialContext(ctx, rcp.websocketUrl.AuthConfig.AddAuthPath(rcp.websocketUrl.Url+nodeMessage.Path), header)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(nodeMessage.Msg) > 0 {
+		// the request body is sent as the first message of the connection
+		err = conn.WriteMessage(gorillawebsocket.TextMessage, nodeMessage.Msg)
+		if err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+	}
+	return &websocketStream{conn: conn}, res.Header, nil
+}
+
+const mimeTextEventStream = "text/event-stream"
+
+type singleMessageStream struct {
+	data []byte
+	read bool
+}
+
+func (sms *singleMessageStream) next() ([]byte, error) {
+	if sms.read {
+		return nil, io.EOF
+	}
+	sms.read = true
+	return sms.data, nil
+}
+
+func (sms *singleMessageStream) close() {}
+
+type websocketStream struct {
+	conn *gorillawebsocket.Conn
+}
+
+func (wss *websocketStream) next() ([]byte, error) {
+	_, data, err := wss.conn.ReadMessage()
+	if gorillawebsocket.IsCloseError(err, gorillawebsocket.CloseNormalClosure, gorillawebsocket.CloseGoingAway) {
+		return nil, io.EOF
+	}
+	return data, err
+}
+
+func (wss *websocketStream) close() {
+	wss.conn.Close()
+}
+
+// serverSentEventsStream reads the data of every event of a server sent events stream,
+// other fields of the event and comments are ignored
+type serverSentEventsStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+func newServerSentEventsStream(body io.ReadCloser) *serverSentEventsStream {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxServerSentEventSize)
+	return &serverSentEventsStream{body: body, scanner: scanner}
+}
+
+const maxServerSentEventSize = 10 * 1024 * 1024
+
+func (sses *serverSentEventsStream) next() ([]byte, error) {
+	var data []byte
+	hasData := false
+	for sses.scanner.Scan() {
+		line := sses.scanner.Bytes()
+		if len(line) == 0 {
+			// an empty line dispatches the event
+			if hasData {
+				return data, nil
+			}
+			continue
+		}
+		field, value, _ := bytes.Cut(line, []byte(":"))
+		if string(field) != "data" {
+			continue
+		}
+		if hasData {
+			data = append(data, '\n')
+		}
+		data = append(data, bytes.TrimPrefix(value, []byte(" "))...)
+		hasData = true
+	}
+	if err := sses.scanner.Err(); err != nil {
+		return nil, err
+	}
+	// an event that wasn't dispatched when the stream ended is discarded
+	return nil, io.EOF
+}
+
+func (sses *serverSentEventsStream) close() {
+	sses.body.Close()
+}
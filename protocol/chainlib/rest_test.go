@@ -1,21 +1,30 @@
 package chainlib
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func TestRestChainParser_Spec(t *testing.T) {
@@ -292,3 +301,199 @@ func TestSettingRequestedBlocksHeadersRest(t *testing.T) {
 		})
 	}
 }
+
+func TestServerSentEventsStream(t *testing.T) {
+	events := ": a comment\n" +
+		"event: block\n" +
+		"id: 1\n" +
+		"data: {\"height\":1}\n" +
+		"\n" +
+		"data:{\"a\":\r\n" +
+		"data: 2}\r\n" +
+		"\r\n" +
+		"retry: 100\n" +
+		"\n" +
+		"data: not dispatched\n"
+	stream := newServerSentEventsStream(io.NopCloser(strings.NewReader(events)))
+	data, err := stream.next()
+	require.NoError(t, err)
+	require.Equal(t, `{"height":1}`, string(data))
+	data, err = stream.next()
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":\n2}", string(data))
+	_, err = stream.next()
+	require.ErrorIs(t, err, io.EOF)
+
+	// events written by the consumer are read back the same
+	buffer := &bytes.Buffer{}
+	writeServerSentEvent(buffer, []byte("{\"a\":\n2}"))
+	writeServerSentEvent(buffer, []byte(`{"height":3}`))
+	stream = newServerSentEventsStream(io.NopCloser(buffer))
+	data, err = stream.next()
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":\n2}", string(data))
+	data, err = stream.next()
+	require.NoError(t, err)
+	require.Equal(t, `{"height":3}`, string(data))
+}
+
+func restStreamingChainParserForTest() *RestChainParser {
+	collectionKey := CollectionKey{ConnectionType: http.MethodGet}
+	return &RestChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: "/v1/stream/blocks", ConnectionType: http.MethodGet}: {api: &spectypes.Api{Name: "/v1/stream/blocks", Enabled: true, ComputeUnits: 10, Category: spectypes.SpecCategory{Subscription: true}}, collectionKey: collectionKey},
+				{Name: "/v1/blocks", ConnectionType: http.MethodGet}:        {api: &spectypes.Api{Name: "/v1/blocks", Enabled: true, ComputeUnits: 10}, collectionKey: collectionKey},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{collectionKey: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceRest, Type: http.MethodGet}}},
+		},
+	}
+}
+
+func TestRestChainProxyStreaming(t *testing.T) {
+	ctx := context.Background()
+	apip := restStreamingChainParserForTest()
+	blocks := []string{`{"height":1}`, `{"height":2}`, `{"height":3}`}
+
+	checkStream := func(t *testing.T, chainProxy ChainProxy) {
+		ch := make(chan interface{})
+		chainMessage, err := apip.ParseMsg("/v1/stream/blocks", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+		require.NoError(t, err)
+		relayReply, subscriptionID, clientSub, err := chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+		require.NoError(t, err)
+		require.NotNil(t, clientSub)
+		require.NotEmpty(t, subscriptionID)
+		require.Equal(t, blocks[0], string(relayReply.Data))
+		for _, block := range blocks[1:] {
+			select {
+			case data := <-ch:
+				streamed, ok := data.([]byte)
+				require.True(t, ok)
+				require.Equal(t, block, string(streamed))
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for a streamed message")
+			}
+		}
+		// the node ended the stream
+		select {
+		case err, open := <-clientSub.Err():
+			require.True(t, open)
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the stream to end")
+		}
+		clientSub.Unsubscribe()
+
+		// apis that aren't streaming can't be subscribed to
+		chainMessage, err = apip.ParseMsg("/v1/blocks", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+		require.NoError(t, err)
+		_, _, _, err = chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+		require.Error(t, err)
+	}
+
+	t.Run("server sent events", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/stream/blocks" || r.Header.Get("Accept") != "text/event-stream" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			for _, block := range blocks {
+				fmt.Fprintf(w, "event: block\ndata: %s\n\n", block)
+				w.(http.Flusher).Flush()
+			}
+		}))
+		defer server.Close()
+		chainProxy, err := NewRestChainProxy(ctx, 1, lavasession.RPCProviderEndpoint{NodeUrls: []common.NodeUrl{{Url: server.URL}}, ChainID: "APT1", ApiInterface: spectypes.APIInterfaceRest}, apip)
+		require.NoError(t, err)
+		checkStream(t, chainProxy)
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		upgrader := websocket.Upgrader{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/stream/blocks" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for _, block := range blocks {
+				if conn.WriteMessage(websocket.TextMessage, []byte(block)) != nil {
+					return
+				}
+			}
+			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		}))
+		defer server.Close()
+		nodeUrls := []common.NodeUrl{{Url: server.URL}, {Url: "ws" + strings.TrimPrefix(server.URL, "http")}}
+		chainProxy, err := NewRestChainProxy(ctx, 1, lavasession.RPCProviderEndpoint{NodeUrls: nodeUrls, ChainID: "APT1", ApiInterface: spectypes.APIInterfaceRest}, apip)
+		require.NoError(t, err)
+		checkStream(t, chainProxy)
+	})
+}
+
+// idleRelaySubscribeClient is a subscription that sends no replies until ctx is done
+type idleRelaySubscribeClient struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (sc *idleRelaySubscribeClient) Recv() (*pairingtypes.RelayReply, error) {
+	var reply pairingtypes.RelayReply
+	return &reply, sc.RecvMsg(&reply)
+}
+
+func (sc *idleRelaySubscribeClient) RecvMsg(m interface{}) error {
+	<-sc.ctx.Done()
+	return sc.ctx.Err()
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestForwardServerSentEventsCancelsOnDisconnect(t *testing.T) {
+	idleSubscription := func(ctx context.Context) *common.RelayResult {
+		var replyServer pairingtypes.Relayer_RelaySubscribeClient = &idleRelaySubscribeClient{ctx: ctx}
+		return &common.RelayResult{ReplyServer: &replyServer}
+	}
+
+	// the client closed the connection, so writing the keep-alive fails
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwardServerSentEvents(ctx, cancel, nil, idleSubscription(ctx), bufio.NewWriter(failingWriter{}), 10*time.Millisecond)
+	}()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+		require.Error(t, ctx.Err())
+	case <-time.After(5 * time.Second):
+		t.Fatal("relay of a disconnected client wasn't canceled")
+	}
+
+	// the server is shutting down
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	buffer := &bytes.Buffer{}
+	go func() {
+		errCh <- forwardServerSentEvents(ctx, cancel, done, idleSubscription(ctx), bufio.NewWriter(buffer), time.Hour)
+	}()
+	close(done)
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+		require.Error(t, ctx.Err())
+	case <-time.After(5 * time.Second):
+		t.Fatal("relay wasn't canceled on shutdown")
+	}
+}
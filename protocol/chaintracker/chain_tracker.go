@@ -106,6 +106,18 @@ func (cs *ChainTracker) GetLatestBlockData(fromBlock, toBlock, specificBlock int
 	return
 }
 
+// GetBlockNumByHash looks for a block hash in the saved blocks, the hash is compared as it was stored after parsing
+func (cs *ChainTracker) GetBlockNumByHash(blockHash string) (blockNum int64, found bool) {
+	cs.blockQueueMu.RLock()
+	defer cs.blockQueueMu.RUnlock()
+	for idx := len(cs.blocksQueue) - 1; idx >= 0; idx-- {
+		if cs.blocksQueue[idx].Hash == blockHash {
+			return cs.blocksQueue[idx].Block, true
+		}
+	}
+	return 0, false
+}
+
 func (cs *ChainTracker) RegisterForBlockTimeUpdates(updatable blockTimeUpdatable) {
 	cs.blockQueueMu.Lock()
 	defer cs.blockQueueMu.Unlock()
@@ -136,7 +136,7 @@ func (ac *AuthConfig) AddAuthPath(url string) string {
 
 func ValidateEndpoint(endpoint, apiInterface string) error {
 	switch apiInterface {
-	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC, spectypes.APIInterfaceRest:
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC, spectypes.APIInterfaceRest, spectypes.APIInterfaceGraphQL:
 		parsedUrl, err := url.Parse(endpoint)
 		if err != nil {
 			return utils.LavaFormatError("could not parse node url", err, utils.Attribute{Key: "url", Value: endpoint}, utils.Attribute{Key: "apiInterface", Value: apiInterface})
@@ -192,6 +192,7 @@ type RelayResult struct {
 	Finalized       bool
 	ConflictHandler ConflictHandlerInterface
 	StatusCode      int
+	FromCache       bool
 }
 
 func (rr *RelayResult) GetReplyServer() *pairingtypes.Relayer_RelaySubscribeClient {
@@ -2,6 +2,7 @@ package lavaprotocol
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 	"github.com/lavanet/lava/utils/slices"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/maps"
 )
 
 type FinalizationConsensus struct {
@@ -37,7 +39,8 @@ type providerDataContainer struct {
 	BlockHeight           int64
 	RelayNum              uint64
 	LatestBlock           int64
-	// TODO:: keep relay request for conflict reporting
+	RelaySession          *pairingtypes.RelaySession // kept for conflict reporting
+	RelayReply            *pairingtypes.RelayReply   // kept for conflict reporting
 }
 
 func NewFinalizationConsensus(specId string) *FinalizationConsensus {
@@ -67,6 +70,8 @@ func (fc *FinalizationConsensus) newProviderHashesConsensus(blockDistanceForFina
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		RelaySession:          req,
+		RelayReply:            reply,
 	}
 	providerDataContainers := map[string]providerDataContainer{}
 	providerDataContainers[providerAcc] = newProviderDataContainer
@@ -86,6 +91,8 @@ func (fc *FinalizationConsensus) insertProviderToConsensus(blockDistanceForFinal
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		RelaySession:          req,
+		RelayReply:            reply,
 	}
 	consensus.agreeingProviders[providerAcc] = newProviderDataContainer
 
@@ -115,8 +122,7 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 		for _, consensus := range fc.currentProviderHashesConsensus {
 			err := fc.discrepancyChecker(finalizedBlocks, consensus)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
+				finalizationConflict = newFinalizationConflict(finalizedBlocks, consensus, req, reply)
 				// we need to insert into a new consensus group before returning
 				// or create new consensus group if no consensus matched
 				continue
@@ -143,8 +149,7 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 		for idx, consensus := range fc.prevEpochProviderHashesConsensus {
 			err := fc.discrepancyChecker(finalizedBlocks, consensus)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
+				finalizationConflict = newFinalizationConflict(finalizedBlocks, consensus, req, reply)
 				return finalizationConflict, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
 			}
 		}
@@ -155,6 +160,26 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 	return finalizationConflict, nil
 }
 
+// newFinalizationConflict creates a finalization conflict between the reply and the reply of a provider in the
+// consensus group that holds a different hash for one of the finalized blocks, so it can be validated on chain.
+// If both replies are of the same provider, the conflict should be reported as a same provider conflict
+func newFinalizationConflict(finalizedBlocks map[int64]string, consensus ProviderHashesConsensus, req *pairingtypes.RelaySession, reply *pairingtypes.RelayReply) *conflicttypes.FinalizationConflict {
+	finalizationConflict := &conflicttypes.FinalizationConflict{RelayReply0: reply, RelaySession0: req}
+	providers := maps.Keys(consensus.agreeingProviders)
+	sort.Strings(providers)
+	for _, provider := range providers {
+		providerData := consensus.agreeingProviders[provider]
+		for blockNum, blockHash := range finalizedBlocks {
+			if otherHash, ok := providerData.FinalizedBlocksHashes[blockNum]; ok && otherHash != blockHash {
+				finalizationConflict.RelayReply1 = providerData.RelayReply
+				finalizationConflict.RelaySession1 = providerData.RelaySession
+				return finalizationConflict
+			}
+		}
+	}
+	return finalizationConflict
+}
+
 func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, consensus ProviderHashesConsensus) (errRet error) {
 	var toIterate map[int64]string   // the smaller map between the two to compare
 	var otherBlocks map[int64]string // the other map
@@ -194,6 +219,22 @@ func (fc *FinalizationConsensus) NewEpoch(epoch uint64) {
 	}
 }
 
+// GetBlockNumByHash looks for a block hash in the finalized hashes reported by the providers of the current and previous epoch
+func (fc *FinalizationConsensus) GetBlockNumByHash(blockHash string) (blockNum int64, found bool) {
+	fc.providerDataContainersMu.RLock()
+	defer fc.providerDataContainersMu.RUnlock()
+	for _, consensuses := range [][]ProviderHashesConsensus{fc.currentProviderHashesConsensus, fc.prevEpochProviderHashesConsensus} {
+		for _, consensus := range consensuses {
+			for blockNum, hash := range consensus.FinalizedBlocksHashes {
+				if hash == blockHash {
+					return blockNum, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
 func (fc *FinalizationConsensus) LatestBlock() uint64 {
 	fc.providerDataContainersMu.RLock()
 	defer fc.providerDataContainersMu.RUnlock()
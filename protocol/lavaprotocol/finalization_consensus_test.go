@@ -20,6 +20,7 @@ type testPlays struct {
 	name                   string
 	finalizationInsertions []finalizationTestInsertion
 	consensusHashesCount   int
+	sameProviderConflict   bool
 }
 type finalizationTestInsertion struct {
 	providerAddr    string
@@ -108,6 +109,7 @@ func TestConsensusHashesInsertion(t *testing.T) {
 			{
 				name:                 "mismatch-with-self",
 				consensusHashesCount: 2,
+				sameProviderConflict: true,
 				finalizationInsertions: append(finalizationInsertionForProviders(chainID, epoch, 100, 0, 1, true, "", blocksInFinalizationProof, blockDistanceForFinalizedData),
 					finalizationInsertionForProviders(chainID, epoch, 100, 0, 1, false, "A", blocksInFinalizationProof, blockDistanceForFinalizedData)...),
 			},
@@ -142,11 +144,18 @@ func TestConsensusHashesInsertion(t *testing.T) {
 				finalizationConsensus.NewEpoch(epoch)
 				// check updating hashes works
 				for _, insertion := range play.finalizationInsertions {
-					_, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
+					finalizationConflict, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
 					if insertion.success {
 						require.NoError(t, err, "failed insertion when was supposed to succeed, provider %s, latest block %d", insertion.providerAddr, insertion.latestBlock)
 					} else {
 						require.Error(t, err)
+						// the conflict holds both conflicting relays as proof
+						require.NotNil(t, finalizationConflict)
+						require.Equal(t, insertion.relaySession, finalizationConflict.RelaySession0)
+						require.Equal(t, insertion.relayReply, finalizationConflict.RelayReply0)
+						require.NotNil(t, finalizationConflict.RelaySession1)
+						require.NotNil(t, finalizationConflict.RelayReply1)
+						require.Equal(t, play.sameProviderConflict, finalizationConflict.RelaySession1.Provider == insertion.providerAddr)
 					}
 				}
 				require.Len(t, finalizationConsensus.currentProviderHashesConsensus, play.consensusHashesCount)
@@ -796,6 +796,7 @@ func (csm *ConsumerSessionManager) OnSessionDone(
 	consumerSession.CalculateQoS(currentLatency, expectedLatency, expectedBH-latestServicedBlock, numOfProviders, int64(providersCount))
 	go csm.providerOptimizer.AppendRelayData(consumerSession.Parent.PublicLavaAddress, currentLatency, isHangingApi, specComputeUnits, uint64(latestServicedBlock))
 	csm.updateMetricsManager(consumerSession)
+	csm.updateLatencyMetrics(consumerSession.Parent.PublicLavaAddress, currentLatency)
 	return nil
 }
 
@@ -823,6 +824,14 @@ func (csm *ConsumerSessionManager) updateMetricsManager(consumerSession *SingleC
 	go csm.consumerMetricsManager.SetQOSMetrics(chainId, apiInterface, consumerSession.Parent.PublicLavaAddress, lastQos, lastQosExcellence, consumerSession.LatestBlock, consumerSession.RelayNum)
 }
 
+func (csm *ConsumerSessionManager) updateLatencyMetrics(providerAddress string, latency time.Duration) {
+	if csm.consumerMetricsManager == nil {
+		return
+	}
+	info := csm.RPCEndpoint()
+	go csm.consumerMetricsManager.SetProviderLatency(info.ChainID, info.ApiInterface, providerAddress, latency)
+}
+
 // consumerSession should still be locked when accessing this method as it fetches information from the session it self
 func (csm *ConsumerSessionManager) resetMetricsManager() {
 	if csm.consumerMetricsManager == nil {
@@ -949,6 +958,12 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseCUOnly(consumerSession *
 	return nil
 }
 
+// charges the compute units of a message streamed on an ongoing subscription to the provider of the session,
+// the session doesn't need to be locked. fails once the provider's max compute units are reached so the subscription can be closed.
+func (csm *ConsumerSessionManager) AddSubscriptionUsedCU(consumerSession *SingleConsumerSession, cu, virtualEpoch uint64) error {
+	return consumerSession.Parent.addUsedComputeUnits(cu, virtualEpoch)
+}
+
 // On a failed DataReliability session we don't decrease the cu unlike a normal session, we just unlock and verify if we need to block this session or provider.
 func (csm *ConsumerSessionManager) OnDataReliabilitySessionFailure(consumerSession *SingleConsumerSession, errorReceived error) error {
 	// consumerSession must be locked when getting here.
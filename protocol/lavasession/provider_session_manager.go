@@ -387,6 +387,16 @@ func (psm *ProviderSessionManager) SubscriptionEnded(consumerAddress string, epo
 	delete(providerSessionWithConsumer.ongoingSubscriptions, subscriptionID) // delete subscription after finished with it
 }
 
+// charge the compute units of a message streamed on an ongoing subscription to the consumer's used cu,
+// fails once the consumer exceeds its max compute units so the stream can be closed.
+func (psm *ProviderSessionManager) AddSubscriptionUsedCU(consumerAddress string, epoch, cu, virtualEpoch uint64) error {
+	providerSessionWithConsumer, activeError := psm.getActiveProjectFromConsumerAddress(consumerAddress, epoch)
+	if activeError != nil {
+		return utils.LavaFormatError("[AddSubscriptionUsedCU] Couldn't find providerSessionWithConsumer", activeError, utils.Attribute{Key: "epoch", Value: epoch}, utils.Attribute{Key: "address", Value: consumerAddress})
+	}
+	return providerSessionWithConsumer.validateAndAddUsedCU(cu, providerSessionWithConsumer.atomicReadMaxComputeUnits(), virtualEpoch)
+}
+
 // Called when the reward server has information on a higher cu proof and usage and this providerSessionsManager needs to sync up on it
 func (psm *ProviderSessionManager) UpdateSessionCU(consumerAddress string, epoch, sessionID, newCU uint64) error {
 	// load the session and update the CU inside
@@ -682,6 +682,37 @@ func TestPSMSubscribeHappyFlowSubscriptionEnded(t *testing.T) {
 	require.Empty(t, psm.sessionsWithAllConsumers[epoch1].sessionMap[projectId].ongoingSubscriptions)
 }
 
+func TestPSMSubscriptionUsedCU(t *testing.T) {
+	// init test
+	psm, sps := prepareSession(t, context.Background())
+
+	// subscribe
+	var channel chan interface{}
+	subscription := &RPCSubscription{
+		Id:                   subscriptionID,
+		Sub:                  nil,
+		SubscribeRepliesChan: channel,
+	}
+	err := psm.ReleaseSessionAndCreateSubscription(sps, subscription, consumerOneAddress, epoch1, relayNumber)
+	require.NoError(t, err)
+	require.Equal(t, relayCu, sps.userSessionsParent.atomicReadUsedComputeUnits())
+
+	// every streamed message is charged until the consumer reaches its max cu
+	for usedCu := relayCu; usedCu < maxCu; usedCu += relayCu {
+		err = psm.AddSubscriptionUsedCU(consumerOneAddress, epoch1, relayCu, 0)
+		require.NoError(t, err)
+	}
+	require.Equal(t, maxCu, sps.userSessionsParent.atomicReadUsedComputeUnits())
+	err = psm.AddSubscriptionUsedCU(consumerOneAddress, epoch1, relayCu, 0)
+	require.True(t, MaximumCULimitReachedByConsumer.Is(err))
+	require.Equal(t, maxCu, sps.userSessionsParent.atomicReadUsedComputeUnits())
+
+	// a virtual epoch allows more messages
+	err = psm.AddSubscriptionUsedCU(consumerOneAddress, epoch1, relayCu, virtualEpoch)
+	require.NoError(t, err)
+	require.Equal(t, maxCu+relayCu, sps.userSessionsParent.atomicReadUsedComputeUnits())
+}
+
 func TestPSMSubscribeHappyFlowSubscriptionEndedOneOutOfTwo(t *testing.T) {
 	// init test
 	psm, sps := prepareSession(t, context.Background())
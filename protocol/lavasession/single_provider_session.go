@@ -243,8 +243,12 @@ func (sps *SingleProviderSession) validateAndSubBadgeUsedCU(currentCU uint64, ba
 }
 
 func (sps *SingleProviderSession) validateAndAddUsedCU(currentCU, maxCu, virtualEpoch uint64) error {
+	return sps.userSessionsParent.validateAndAddUsedCU(currentCU, maxCu, virtualEpoch)
+}
+
+func (pswc *ProviderSessionsWithConsumerProject) validateAndAddUsedCU(currentCU, maxCu, virtualEpoch uint64) error {
 	for {
-		usedCu := sps.userSessionsParent.atomicReadUsedComputeUnits() // check used cu now
+		usedCu := pswc.atomicReadUsedComputeUnits() // check used cu now
 		// add additional CU for virtual epochs
 		if usedCu+currentCU > maxCu*(virtualEpoch+1) {
 			return utils.LavaFormatError("Maximum cu exceeded PrepareSessionForUsage", MaximumCULimitReachedByConsumer,
@@ -257,7 +261,7 @@ func (sps *SingleProviderSession) validateAndAddUsedCU(currentCU, maxCu, virtual
 		// compare usedCu + current cu vs usedCu, if swap succeeds, return otherwise try again
 		// this can happen when multiple sessions are adding their cu at the same time.
 		// comparing and adding is protecting against race conditions as the parent is not locked.
-		if sps.userSessionsParent.atomicCompareAndWriteUsedComputeUnits(usedCu+currentCU, usedCu) {
+		if pswc.atomicCompareAndWriteUsedComputeUnits(usedCu+currentCU, usedCu) {
 			return nil
 		}
 	}
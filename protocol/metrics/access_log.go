@@ -0,0 +1,210 @@
+package metrics
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	AccessLogFileFlagName            = "access-log-file"
+	AccessLogBodySampleRatioFlagName = "access-log-body-sample-ratio"
+	AccessLogMaxBodySizeFlagName     = "access-log-max-body-size"
+	AccessLogRedactFlagName          = "access-log-redact"
+	DefaultAccessLogMaxBodySize      = 1024
+	redactedValue                    = "[REDACTED]"
+	// records waiting to be written, records are dropped when the buffer is full
+	accessLogBufferSize = 10000
+)
+
+// AccessLogRecord is a single line of the access log, written for every client request
+type AccessLogRecord struct {
+	Time         time.Time `json:"time"`
+	GUID         string    `json:"guid"`
+	DappID       string    `json:"dapp_id"`
+	ConsumerIP   string    `json:"consumer_ip"`
+	ChainID      string    `json:"chain_id"`
+	ApiInterface string    `json:"api_interface"`
+	Method       string    `json:"method"`
+	LatencyMs    int64     `json:"latency_ms"`
+	Retries      uint64    `json:"retries"`
+	Providers    []string  `json:"providers,omitempty"` // all the providers the relay was sent to, in order
+	CacheHit     bool      `json:"cache_hit"`
+	Status       int       `json:"status"`
+	ResponseSize int       `json:"response_size"`
+	Error        string    `json:"error,omitempty"`
+	Request      string    `json:"request,omitempty"`
+	Response     string    `json:"response,omitempty"`
+}
+
+type AccessLogConfig struct {
+	FilePath        string
+	MaxSize         string // MB
+	MaxBackups      string
+	MaxAge          string // days
+	BodySampleRatio float64
+	MaxBodySize     int
+	Redact          []string // regular expressions of values to remove from the logged bodies
+}
+
+// AccessLogger writes AccessLogRecords as JSON lines to a rotating file. Request and response
+// bodies are only added to a sample of the records, after redaction. Records are formatted and
+// written by a background routine so logging doesn't block the relay.
+type AccessLogger struct {
+	output          io.WriteCloser
+	bodySampleRatio float64
+	maxBodySize     int
+	redact          []*regexp.Regexp
+	records         chan *AccessLogRecord
+	dropped         atomic.Uint64
+	closeOnce       sync.Once
+	closing         chan struct{}
+	done            chan struct{}
+}
+
+func AddAccessLogFlags(cmd *cobra.Command) {
+	cmd.Flags().String(AccessLogFileFlagName, "", "write a JSON line for every relay to this file (rotated by the rolling-log size, age and backups flags), disabled if empty")
+	cmd.Flags().Float64(AccessLogBodySampleRatioFlagName, 0, "the ratio of access log records that include the request and response bodies, between 0 and 1")
+	cmd.Flags().Int(AccessLogMaxBodySizeFlagName, DefaultAccessLogMaxBodySize, "the maximal size of a request or response body in the access log, longer bodies are truncated")
+	cmd.Flags().StringSlice(AccessLogRedactFlagName, []string{}, "regular expressions of values to redact from the bodies in the access log")
+}
+
+// NewAccessLoggerFromFlags returns an AccessLogger configured by the flags added by AddAccessLogFlags, or nil if it is disabled
+func NewAccessLoggerFromFlags() (*AccessLogger, error) {
+	filePath := viper.GetString(AccessLogFileFlagName)
+	if filePath == "" {
+		return nil, nil
+	}
+	return NewAccessLogger(AccessLogConfig{
+		FilePath:        filePath,
+		MaxSize:         viper.GetString(common.RollingLogMaxSizeFlag),
+		MaxBackups:      viper.GetString(common.RollingLogBackupsFlag),
+		MaxAge:          viper.GetString(common.RollingLogMaxAgeFlag),
+		BodySampleRatio: viper.GetFloat64(AccessLogBodySampleRatioFlagName),
+		MaxBodySize:     viper.GetInt(AccessLogMaxBodySizeFlagName),
+		Redact:          viper.GetStringSlice(AccessLogRedactFlagName),
+	})
+}
+
+func NewAccessLogger(config AccessLogConfig) (*AccessLogger, error) {
+	redact := make([]*regexp.Regexp, 0, len(config.Redact))
+	for _, expression := range config.Redact {
+		compiled, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid access log redaction expression", err, utils.LogAttr("expression", expression))
+		}
+		redact = append(redact, compiled)
+	}
+	utils.LavaFormatInfo("access log enabled", utils.LogAttr("file", config.FilePath), utils.LogAttr("body_sample_ratio", config.BodySampleRatio))
+	al := newAccessLogger(utils.NewRollingLogOutput(config.FilePath, config.MaxSize, config.MaxBackups, config.MaxAge), accessLogBufferSize)
+	al.bodySampleRatio = config.BodySampleRatio
+	al.maxBodySize = config.MaxBodySize
+	al.redact = redact
+	go al.writeRecords()
+	return al, nil
+}
+
+func newAccessLogger(output io.WriteCloser, bufferSize int) *AccessLogger {
+	return &AccessLogger{
+		output:  output,
+		records: make(chan *AccessLogRecord, bufferSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// ShouldLogBodies decides if the bodies of the next record are logged, so they are only copied when needed
+func (al *AccessLogger) ShouldLogBodies() bool {
+	if al == nil || al.bodySampleRatio <= 0 {
+		return false
+	}
+	return al.bodySampleRatio >= 1 || rand.Float64() < al.bodySampleRatio
+}
+
+func (al *AccessLogger) formatBody(body string) string {
+	for _, expression := range al.redact {
+		body = expression.ReplaceAllString(body, redactedValue)
+	}
+	if al.maxBodySize > 0 && len(body) > al.maxBodySize {
+		body = body[:al.maxBodySize] + "...Truncated..."
+	}
+	return body
+}
+
+// Log queues a record to be written, it never blocks: if the queue is full the record is dropped
+func (al *AccessLogger) Log(record *AccessLogRecord) {
+	if al == nil {
+		return
+	}
+	select {
+	case <-al.closing:
+		return
+	default:
+	}
+	select {
+	case al.records <- record:
+	default:
+		al.dropped.Add(1)
+	}
+}
+
+func (al *AccessLogger) writeRecords() {
+	defer close(al.done)
+	for {
+		select {
+		case record := <-al.records:
+			al.writeRecord(record)
+		case <-al.closing:
+			// write the queued records before returning
+			for {
+				select {
+				case record := <-al.records:
+					al.writeRecord(record)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (al *AccessLogger) writeRecord(record *AccessLogRecord) {
+	if dropped := al.dropped.Swap(0); dropped > 0 {
+		utils.LavaFormatWarning("access log records were dropped, the access log can't keep up with the relays", nil, utils.LogAttr("dropped", dropped))
+	}
+	if record.Request != "" {
+		record.Request = al.formatBody(record.Request)
+	}
+	if record.Response != "" {
+		record.Response = al.formatBody(record.Response)
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		utils.LavaFormatWarning("failed marshaling access log record", err)
+		return
+	}
+	line = append(line, '\n')
+	_, err = al.output.Write(line)
+	if err != nil {
+		utils.LavaFormatWarning("failed writing access log record", err)
+	}
+}
+
+// Close writes the queued records and closes the output
+func (al *AccessLogger) Close() error {
+	if al == nil {
+		return nil
+	}
+	al.closeOnce.Do(func() { close(al.closing) })
+	<-al.done
+	return al.output.Close()
+}
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLog(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "access.log")
+	accessLogger, err := NewAccessLogger(AccessLogConfig{
+		FilePath:        filePath,
+		MaxSize:         "1",
+		MaxBackups:      "1",
+		MaxAge:          "1",
+		BodySampleRatio: 1,
+		MaxBodySize:     40,
+		Redact:          []string{`0x[0-9a-fA-F]{8,}`},
+	})
+	require.NoError(t, err)
+	require.True(t, accessLogger.ShouldLogBodies())
+
+	accessLogger.Log(&AccessLogRecord{
+		GUID:      "1",
+		Method:    "eth_getBalance",
+		Providers: []string{"provider1", "provider2"},
+		Status:    200,
+		Request:   `{"params":["0x1234567890abcdef"]}`,
+		Response:  `{"result":"0x0000000000000000000000000000000000000001"}`,
+	})
+	accessLogger.Log(&AccessLogRecord{GUID: "2", Status: 500, Error: "failed"})
+	require.NoError(t, accessLogger.Close())
+
+	file, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+	records := []AccessLogRecord{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := AccessLogRecord{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 2)
+	require.Equal(t, "eth_getBalance", records[0].Method)
+	require.Equal(t, []string{"provider1", "provider2"}, records[0].Providers)
+	require.Equal(t, `{"params":["[REDACTED]"]}`, records[0].Request)
+	require.Equal(t, `{"result":"[REDACTED]"}`, records[0].Response)
+	require.Equal(t, "failed", records[1].Error)
+	require.Empty(t, records[1].Request)
+}
+
+type closeTrackingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closeTrackingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestAccessLogQueue(t *testing.T) {
+	output := &closeTrackingBuffer{}
+	accessLogger := newAccessLogger(output, 2)
+	// the writer isn't running yet, so records beyond the buffer size are dropped without blocking
+	accessLogger.Log(&AccessLogRecord{GUID: "1"})
+	accessLogger.Log(&AccessLogRecord{GUID: "2"})
+	accessLogger.Log(&AccessLogRecord{GUID: "3"})
+	require.Equal(t, uint64(1), accessLogger.dropped.Load())
+
+	go accessLogger.writeRecords()
+	// close writes the queued records
+	require.NoError(t, accessLogger.Close())
+	require.True(t, output.closed)
+	require.Equal(t, 2, bytes.Count(output.Bytes(), []byte("\n")))
+	require.Zero(t, accessLogger.dropped.Load())
+
+	// logging after close is ignored
+	accessLogger.Log(&AccessLogRecord{GUID: "4"})
+	require.Equal(t, 2, bytes.Count(output.Bytes(), []byte("\n")))
+}
+
+func TestAccessLogBodyTruncation(t *testing.T) {
+	accessLogger := &AccessLogger{maxBodySize: 4}
+	require.Equal(t, "abcd...Truncated...", accessLogger.formatBody("abcdefgh"))
+	require.Equal(t, "abc", accessLogger.formatBody("abc"))
+	require.False(t, accessLogger.ShouldLogBodies())
+	var disabled *AccessLogger
+	require.False(t, disabled.ShouldLogBodies())
+	disabled.Log(&AccessLogRecord{})
+}
+
+func TestAccessLogInvalidRedaction(t *testing.T) {
+	_, err := NewAccessLogger(AccessLogConfig{FilePath: filepath.Join(t.TempDir(), "access.log"), Redact: []string{"("}})
+	require.Error(t, err)
+}
@@ -22,6 +22,9 @@ type RelayMetrics struct {
 	ComputeUnits uint64
 	Source       RelaySource
 	Origin       string
+	ApiMethod    string
+	Retries      uint64
+	Providers    []string // all the providers the relay was sent to, in order
 }
 
 type RelayAnalyticsDTO struct {
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/lavanet/lava/utils"
 	"github.com/prometheus/client_golang/prometheus"
@@ -9,12 +10,58 @@ import (
 )
 
 type HealthMetrics struct {
-	failedRuns      *prometheus.CounterVec
-	successfulRuns  *prometheus.CounterVec
-	failureAlerts   *prometheus.GaugeVec
-	healthyChecks   *prometheus.GaugeVec
-	unhealthyChecks *prometheus.GaugeVec
-	latestBlocks    *prometheus.GaugeVec
+	failedRuns                   *prometheus.CounterVec
+	successfulRuns               *prometheus.CounterVec
+	failureAlerts                *prometheus.GaugeVec
+	healthyChecks                *prometheus.GaugeVec
+	unhealthyChecks              *prometheus.GaugeVec
+	latestBlocks                 *prometheus.GaugeVec
+	providerLatency              *prometheus.GaugeVec
+	providerBlockLag             *prometheus.GaugeVec
+	providerFrozen               *prometheus.GaugeVec
+	providerHealthy              *prometheus.GaugeVec
+	consumerBlockLag             *prometheus.GaugeVec
+	consumerHealthy              *prometheus.GaugeVec
+	subscriptionMonthsLeft       *prometheus.GaugeVec
+	subscriptionCuLeft           *prometheus.GaugeVec
+	subscriptionCuLeftPercentage *prometheus.GaugeVec
+	subscriptionTimeLeft         *prometheus.GaugeVec
+}
+
+// HealthEntity identifies a provider or consumer endpoint in the health results
+type HealthEntity struct {
+	Address      string
+	SpecId       string
+	ApiInterface string
+}
+
+type ProviderHealth struct {
+	HealthEntity
+	Healthy  bool
+	Frozen   bool
+	Latency  time.Duration
+	BlockLag int64 // blocks behind the latest block of the spec
+}
+
+type ConsumerHealth struct {
+	HealthEntity
+	Healthy  bool
+	BlockLag int64
+}
+
+type SubscriptionHealth struct {
+	Address          string
+	MonthsLeft       uint64
+	CuLeft           uint64 // this month
+	CuLeftPercentage float64
+	TimeLeft         time.Duration // in this month
+}
+
+// HealthResultsData is the full result of a health run, exported by SetHealthResults
+type HealthResultsData struct {
+	Providers     []ProviderHealth
+	Consumers     []ConsumerHealth
+	Subscriptions []SubscriptionHealth
 }
 
 func NewHealthMetrics(networkAddress string) *HealthMetrics {
@@ -52,6 +99,47 @@ func NewHealthMetrics(networkAddress string) *HealthMetrics {
 		Name: "lava_health_successful_runs",
 		Help: "The total of runs succeeded",
 	}, []string{"identifier"})
+	entityLabels := []string{"identifier", "address", "spec", "apiInterface"}
+	providerLatency := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_provider_latency_milliseconds",
+		Help: "The latency of the provider in the latest health run",
+	}, entityLabels)
+	providerBlockLag := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_provider_block_lag",
+		Help: "The number of blocks the provider is behind the latest block of the spec in the latest health run",
+	}, entityLabels)
+	providerFrozen := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_provider_frozen",
+		Help: "1 if the provider is frozen, 0 otherwise",
+	}, entityLabels)
+	providerHealthy := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_provider_healthy",
+		Help: "1 if the provider responded in the latest health run, 0 otherwise",
+	}, entityLabels)
+	consumerBlockLag := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_consumer_block_lag",
+		Help: "The number of blocks the consumer is behind the latest block of the spec in the latest health run",
+	}, entityLabels)
+	consumerHealthy := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_consumer_healthy",
+		Help: "1 if the consumer responded in the latest health run, 0 otherwise",
+	}, entityLabels)
+	subscriptionMonthsLeft := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_subscription_months_left",
+		Help: "The full months left in the subscription",
+	}, []string{"identifier", "address"})
+	subscriptionCuLeft := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_subscription_cu_left",
+		Help: "The CU left in the current month of the subscription",
+	}, []string{"identifier", "address"})
+	subscriptionCuLeftPercentage := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_subscription_cu_left_percentage",
+		Help: "The part of the month's CU left in the current month of the subscription, between 0 and 1",
+	}, []string{"identifier", "address"})
+	subscriptionTimeLeft := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "lava_health_subscription_month_time_left_seconds",
+		Help: "The time left in the current month of the subscription",
+	}, []string{"identifier", "address"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(failedRuns)
 	prometheus.MustRegister(successfulRuns)
@@ -59,18 +147,38 @@ func NewHealthMetrics(networkAddress string) *HealthMetrics {
 	prometheus.MustRegister(healthyChecks)
 	prometheus.MustRegister(unhealthyChecks)
 	prometheus.MustRegister(latestBlocks)
+	prometheus.MustRegister(providerLatency)
+	prometheus.MustRegister(providerBlockLag)
+	prometheus.MustRegister(providerFrozen)
+	prometheus.MustRegister(providerHealthy)
+	prometheus.MustRegister(consumerBlockLag)
+	prometheus.MustRegister(consumerHealthy)
+	prometheus.MustRegister(subscriptionMonthsLeft)
+	prometheus.MustRegister(subscriptionCuLeft)
+	prometheus.MustRegister(subscriptionCuLeftPercentage)
+	prometheus.MustRegister(subscriptionTimeLeft)
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		utils.LavaFormatInfo("prometheus endpoint listening", utils.Attribute{Key: "Listen Address", Value: networkAddress})
 		http.ListenAndServe(networkAddress, nil)
 	}()
 	return &HealthMetrics{
-		failedRuns:      failedRuns,
-		successfulRuns:  successfulRuns,
-		failureAlerts:   failureAlerts,
-		healthyChecks:   healthyChecks,
-		unhealthyChecks: unhealthyChecks,
-		latestBlocks:    latestBlocks,
+		failedRuns:                   failedRuns,
+		successfulRuns:               successfulRuns,
+		failureAlerts:                failureAlerts,
+		healthyChecks:                healthyChecks,
+		unhealthyChecks:              unhealthyChecks,
+		latestBlocks:                 latestBlocks,
+		providerLatency:              providerLatency,
+		providerBlockLag:             providerBlockLag,
+		providerFrozen:               providerFrozen,
+		providerHealthy:              providerHealthy,
+		consumerBlockLag:             consumerBlockLag,
+		consumerHealthy:              consumerHealthy,
+		subscriptionMonthsLeft:       subscriptionMonthsLeft,
+		subscriptionCuLeft:           subscriptionCuLeft,
+		subscriptionCuLeftPercentage: subscriptionCuLeftPercentage,
+		subscriptionTimeLeft:         subscriptionTimeLeft,
 	}
 }
 
@@ -105,3 +213,46 @@ func (pme *HealthMetrics) SetAlertResults(label string, fails uint64, unhealthy
 	pme.unhealthyChecks.WithLabelValues(label).Set(float64(unhealthy))
 	pme.healthyChecks.WithLabelValues(label).Set(float64(healthy))
 }
+
+func boolToFloat64(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// SetHealthResults exports the results of a health run, replacing the results of the previous run
+// so entities that were removed from the results don't keep their old values
+func (pme *HealthMetrics) SetHealthResults(label string, results *HealthResultsData) {
+	if pme == nil {
+		return
+	}
+	for _, gauge := range []*prometheus.GaugeVec{
+		pme.providerLatency, pme.providerBlockLag, pme.providerFrozen, pme.providerHealthy, pme.consumerBlockLag, pme.consumerHealthy,
+		pme.subscriptionMonthsLeft, pme.subscriptionCuLeft, pme.subscriptionCuLeftPercentage, pme.subscriptionTimeLeft,
+	} {
+		gauge.DeletePartialMatch(prometheus.Labels{"identifier": label})
+	}
+	for _, provider := range results.Providers {
+		labels := []string{label, provider.Address, provider.SpecId, provider.ApiInterface}
+		pme.providerHealthy.WithLabelValues(labels...).Set(boolToFloat64(provider.Healthy))
+		pme.providerFrozen.WithLabelValues(labels...).Set(boolToFloat64(provider.Frozen))
+		if provider.Healthy {
+			pme.providerLatency.WithLabelValues(labels...).Set(float64(provider.Latency.Milliseconds()))
+			pme.providerBlockLag.WithLabelValues(labels...).Set(float64(provider.BlockLag))
+		}
+	}
+	for _, consumer := range results.Consumers {
+		labels := []string{label, consumer.Address, consumer.SpecId, consumer.ApiInterface}
+		pme.consumerHealthy.WithLabelValues(labels...).Set(boolToFloat64(consumer.Healthy))
+		if consumer.Healthy {
+			pme.consumerBlockLag.WithLabelValues(labels...).Set(float64(consumer.BlockLag))
+		}
+	}
+	for _, subscription := range results.Subscriptions {
+		pme.subscriptionMonthsLeft.WithLabelValues(label, subscription.Address).Set(float64(subscription.MonthsLeft))
+		pme.subscriptionCuLeft.WithLabelValues(label, subscription.Address).Set(float64(subscription.CuLeft))
+		pme.subscriptionCuLeftPercentage.WithLabelValues(label, subscription.Address).Set(subscription.CuLeftPercentage)
+		pme.subscriptionTimeLeft.WithLabelValues(label, subscription.Address).Set(subscription.TimeLeft.Seconds())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -11,6 +12,19 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+const (
+	// the maximal number of spec API names used as a label per chain and api interface, to limit the metrics cardinality.
+	// once reached, new API names are reported under OtherApiMethodLabel
+	MaxApiMethodLabels  = 100
+	OtherApiMethodLabel = "other"
+)
+
+var (
+	// latency buckets in milliseconds
+	LatencyHistogramBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}
+	RetriesHistogramBuckets = []float64{0, 1, 2, 3, 4, 5, 6}
+)
+
 type ConsumerMetricsManager struct {
 	totalCURequestedMetric        *prometheus.CounterVec
 	totalRelaysRequestedMetric    *prometheus.CounterVec
@@ -26,6 +40,11 @@ type ConsumerMetricsManager struct {
 	lock                          sync.Mutex
 	protocolVersionMetric         *prometheus.GaugeVec
 	providerRelays                map[string]uint64
+	relayLatencyHistogram         *prometheus.HistogramVec
+	providerLatencyHistogram      *prometheus.HistogramVec
+	relayRetriesHistogram         *prometheus.HistogramVec
+	cacheHitLatencyHistogram      *prometheus.HistogramVec
+	apiMethodLabels               map[string]map[string]struct{} // chainId+apiInterface -> api names used as labels
 }
 
 func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
@@ -91,6 +110,26 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		Name: "lava_provider_protocol_version",
 		Help: "The current running lavap version for the process. major := version / 1000000, minor := (version / 1000) % 1000, patch := version % 1000",
 	}, []string{"version"})
+	relayLatencyHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lava_consumer_relay_latency_milliseconds",
+		Help:    "The end to end latency of successful relays, from receiving the request until replying.",
+		Buckets: LatencyHistogramBuckets,
+	}, []string{"spec", "apiInterface", "method"})
+	providerLatencyHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lava_consumer_provider_latency_milliseconds",
+		Help:    "The latency of successful relays sent to each provider.",
+		Buckets: LatencyHistogramBuckets,
+	}, []string{"spec", "apiInterface", "provider_address"})
+	relayRetriesHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lava_consumer_relay_retries",
+		Help:    "The number of retries needed per relay request.",
+		Buckets: RetriesHistogramBuckets,
+	}, []string{"spec", "apiInterface", "method"})
+	cacheHitLatencyHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lava_consumer_cache_hit_latency_milliseconds",
+		Help:    "The latency of relays that were served from the cache.",
+		Buckets: LatencyHistogramBuckets,
+	}, []string{"spec", "apiInterface", "method"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCURequestedMetric)
 	prometheus.MustRegister(totalRelaysRequestedMetric)
@@ -104,6 +143,10 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(endpointsHealthChecksOkMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	prometheus.MustRegister(relayLatencyHistogram)
+	prometheus.MustRegister(providerLatencyHistogram)
+	prometheus.MustRegister(relayRetriesHistogram)
+	prometheus.MustRegister(cacheHitLatencyHistogram)
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		utils.LavaFormatInfo("prometheus endpoint listening", utils.Attribute{Key: "Listen Address", Value: networkAddress})
@@ -123,6 +166,11 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		virtualEpochMetric:            virtualEpochMetric,
 		endpointsHealthChecksOkMetric: endpointsHealthChecksOkMetric,
 		protocolVersionMetric:         protocolVersionMetric,
+		relayLatencyHistogram:         relayLatencyHistogram,
+		providerLatencyHistogram:      providerLatencyHistogram,
+		relayRetriesHistogram:         relayRetriesHistogram,
+		cacheHitLatencyHistogram:      cacheHitLatencyHistogram,
+		apiMethodLabels:               map[string]map[string]struct{}{},
 	}
 }
 
@@ -144,6 +192,47 @@ func (pme *ConsumerMetricsManager) SetRelayMetrics(relayMetric *RelayMetrics, er
 	if !relayMetric.Success {
 		pme.totalErroredMetric.WithLabelValues(relayMetric.ChainID, relayMetric.APIType).Add(1)
 	}
+	method := pme.getApiMethodLabel(relayMetric.ChainID, relayMetric.APIType, relayMetric.ApiMethod)
+	if relayMetric.Success {
+		pme.relayLatencyHistogram.WithLabelValues(relayMetric.ChainID, relayMetric.APIType, method).Observe(float64(relayMetric.Latency))
+	}
+	pme.relayRetriesHistogram.WithLabelValues(relayMetric.ChainID, relayMetric.APIType, method).Observe(float64(relayMetric.Retries))
+}
+
+func (pme *ConsumerMetricsManager) SetProviderLatency(chainId string, apiInterface string, providerAddress string, latency time.Duration) {
+	if pme == nil {
+		return
+	}
+	pme.providerLatencyHistogram.WithLabelValues(chainId, apiInterface, providerAddress).Observe(float64(latency.Milliseconds()))
+}
+
+func (pme *ConsumerMetricsManager) SetCacheHitLatency(chainId string, apiInterface string, apiMethod string, latency time.Duration) {
+	if pme == nil {
+		return
+	}
+	method := pme.getApiMethodLabel(chainId, apiInterface, apiMethod)
+	pme.cacheHitLatencyHistogram.WithLabelValues(chainId, apiInterface, method).Observe(float64(latency.Milliseconds()))
+}
+
+// returns the label to use for the api method, api names are taken from the spec so they are bounded,
+// but we still guard against a spec with a huge amount of apis blowing up the cardinality
+func (pme *ConsumerMetricsManager) getApiMethodLabel(chainId string, apiInterface string, apiMethod string) string {
+	pme.lock.Lock()
+	defer pme.lock.Unlock()
+	key := chainId + apiInterface
+	methods, ok := pme.apiMethodLabels[key]
+	if !ok {
+		methods = map[string]struct{}{}
+		pme.apiMethodLabels[key] = methods
+	}
+	if _, ok := methods[apiMethod]; ok {
+		return apiMethod
+	}
+	if len(methods) >= MaxApiMethodLabels {
+		return OtherApiMethodLabel
+	}
+	methods[apiMethod] = struct{}{}
+	return apiMethod
 }
 
 func (pme *ConsumerMetricsManager) SetQOSMetrics(chainId string, apiInterface string, providerAddress string, qos *pairingtypes.QualityOfServiceReport, qosExcellence *pairingtypes.QualityOfServiceReport, latestBlock int64, relays uint64) {
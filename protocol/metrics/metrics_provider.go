@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"sync"
+	"time"
 
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,9 +23,10 @@ type ProviderMetrics struct {
 	totalRelaysServicedMetric *prometheus.CounterVec
 	totalErroredMetric        *prometheus.CounterVec
 	consumerQoSMetric         *prometheus.GaugeVec
+	consumersUsage            *ProviderConsumersUsage
 }
 
-func (pm *ProviderMetrics) AddRelay(consumerAddress string, cu uint64, qos *pairingtypes.QualityOfServiceReport) {
+func (pm *ProviderMetrics) AddRelay(consumerAddress string, apiName string, cu uint64, qos *pairingtypes.QualityOfServiceReport) {
 	if pm == nil {
 		return
 	}
@@ -32,6 +34,7 @@ func (pm *ProviderMetrics) AddRelay(consumerAddress string, cu uint64, qos *pair
 	defer pm.lock.Unlock()
 	pm.totalCUServicedMetric.WithLabelValues(pm.specID, pm.apiInterface).Add(float64(cu))
 	pm.totalRelaysServicedMetric.WithLabelValues(pm.specID, pm.apiInterface).Add(1)
+	pm.consumersUsage.AddRelay(pm.specID, pm.apiInterface, consumerAddress, apiName, cu)
 	if qos == nil {
 		return
 	}
@@ -58,13 +61,30 @@ func (pm *ProviderMetrics) AddPayment(cu uint64) {
 	pm.totalCUPaidMetric.WithLabelValues(pm.specID).Add(float64(cu))
 }
 
-func (pm *ProviderMetrics) AddError() {
+func (pm *ProviderMetrics) AddError(consumerAddress string, apiName string) {
 	if pm == nil {
 		return
 	}
 	pm.lock.Lock()
 	defer pm.lock.Unlock()
 	pm.totalErroredMetric.WithLabelValues(pm.specID, pm.apiInterface).Add(1)
+	pm.consumersUsage.AddError(pm.specID, pm.apiInterface, consumerAddress, apiName)
+}
+
+// AddNodeLatency records the latency of a relay that was sent to the node
+func (pm *ProviderMetrics) AddNodeLatency(consumerAddress string, apiName string, latency time.Duration) {
+	if pm == nil {
+		return
+	}
+	pm.consumersUsage.AddNodeLatency(pm.specID, pm.apiInterface, consumerAddress, apiName, latency)
+}
+
+// SetConsumerProject sets the project of a consumer in the consumers usage
+func (pm *ProviderMetrics) SetConsumerProject(consumerAddress string, projectID string) {
+	if pm == nil {
+		return
+	}
+	pm.consumersUsage.SetConsumerProject(pm.specID, consumerAddress, projectID)
 }
 
 func NewProviderMetrics(specID, apiInterface string, totalCUServicedMetric *prometheus.CounterVec,
@@ -72,6 +92,7 @@ func NewProviderMetrics(specID, apiInterface string, totalCUServicedMetric *prom
 	totalRelaysServicedMetric *prometheus.CounterVec,
 	totalErroredMetric *prometheus.CounterVec,
 	consumerQoSMetric *prometheus.GaugeVec,
+	consumersUsage *ProviderConsumersUsage,
 ) *ProviderMetrics {
 	pm := &ProviderMetrics{
 		specID:                    specID,
@@ -82,6 +103,7 @@ func NewProviderMetrics(specID, apiInterface string, totalCUServicedMetric *prom
 		totalRelaysServicedMetric: totalRelaysServicedMetric,
 		totalErroredMetric:        totalErroredMetric,
 		consumerQoSMetric:         consumerQoSMetric,
+		consumersUsage:            consumersUsage,
 	}
 	return pm
 }
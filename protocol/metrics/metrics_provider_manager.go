@@ -34,6 +34,7 @@ type ProviderMetricsManager struct {
 	fetchBlockSuccessMetric     *prometheus.CounterVec
 	protocolVersionMetric       *prometheus.GaugeVec
 	virtualEpochMetric          *prometheus.GaugeVec
+	consumersUsage              *ProviderConsumersUsage
 }
 
 func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
@@ -128,8 +129,11 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 	prometheus.MustRegister(fetchBlockSuccessMetric)
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	consumersUsage := NewProviderConsumersUsage()
+	consumersUsage.register()
 
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle(ConsumersUsageEndpoint, consumersUsage)
 	go func() {
 		utils.LavaFormatInfo("prometheus endpoint listening", utils.Attribute{Key: "Listen Address", Value: networkAddress})
 		http.ListenAndServe(networkAddress, nil)
@@ -150,6 +154,7 @@ func NewProviderMetricsManager(networkAddress string) *ProviderMetricsManager {
 		fetchBlockSuccessMetric:     fetchBlockSuccessMetric,
 		virtualEpochMetric:          virtualEpochMetric,
 		protocolVersionMetric:       protocolVersionMetric,
+		consumersUsage:              consumersUsage,
 	}
 }
 
@@ -172,7 +177,7 @@ func (pme *ProviderMetricsManager) AddProviderMetrics(specID, apiInterface strin
 		return nil
 	}
 	if pme.getProviderMetric(specID, apiInterface) == nil {
-		providerMetric := NewProviderMetrics(specID, apiInterface, pme.totalCUServicedMetric, pme.totalCUPaidMetric, pme.totalRelaysServicedMetric, pme.totalErroredMetric, pme.consumerQoSMetric)
+		providerMetric := NewProviderMetrics(specID, apiInterface, pme.totalCUServicedMetric, pme.totalCUPaidMetric, pme.totalRelaysServicedMetric, pme.totalErroredMetric, pme.consumerQoSMetric, pme.consumersUsage)
 		pme.setProviderMetric(providerMetric)
 	}
 	return pme.getProviderMetric(specID, apiInterface)
@@ -188,15 +193,17 @@ func (pme *ProviderMetricsManager) SetLatestBlock(specID string, block uint64) {
 	pme.lastServicedBlockTimeMetric.WithLabelValues(specID).Set(float64(time.Now().Unix()))
 }
 
-func (pme *ProviderMetricsManager) AddPayment(specID string, cu uint64) {
+func (pme *ProviderMetricsManager) AddPayment(specID string, consumerAddress string, cu uint64) {
 	if pme == nil {
 		return
 	}
+	pme.consumersUsage.AddPayment(specID, consumerAddress, cu)
 	availableAPIInterface := []string{
 		spectypes.APIInterfaceJsonRPC,
 		spectypes.APIInterfaceTendermintRPC,
 		spectypes.APIInterfaceRest,
 		spectypes.APIInterfaceGrpc,
+		spectypes.APIInterfaceGraphQL,
 	}
 	for _, apiInterface := range availableAPIInterface {
 		providerMetrics := pme.getProviderMetric(specID, apiInterface)
@@ -207,6 +214,14 @@ func (pme *ProviderMetricsManager) AddPayment(specID string, cu uint64) {
 	}
 }
 
+// SetConsumerRewardsStateGetter sets the source of the unpaid CU of each consumer, shown on ConsumersUsageEndpoint
+func (pme *ProviderMetricsManager) SetConsumerRewardsStateGetter(rewardsStateGetter ConsumerRewardsStateGetter) {
+	if pme == nil {
+		return
+	}
+	pme.consumersUsage.SetRewardsStateGetter(rewardsStateGetter)
+}
+
 func (pme *ProviderMetricsManager) SetBlock(latestLavaBlock int64) {
 	if pme == nil {
 		return
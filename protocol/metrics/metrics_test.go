@@ -306,3 +306,25 @@ func checkThatMetricDtoInAggregatedMetricMap(mapData map[string]map[string]map[s
 	}
 	return nil
 }
+
+func Test_ApiMethodLabelCardinalityGuard(t *testing.T) {
+	pme := &ConsumerMetricsManager{apiMethodLabels: map[string]map[string]struct{}{}}
+	for i := 0; i < MaxApiMethodLabels; i++ {
+		method := fmt.Sprintf("method_%d", i)
+		if label := pme.getApiMethodLabel("LAV1", "rest", method); label != method {
+			t.Errorf("expected label %s, got %s", method, label)
+		}
+	}
+	// new methods are grouped once the limit is reached
+	if label := pme.getApiMethodLabel("LAV1", "rest", "new_method"); label != OtherApiMethodLabel {
+		t.Errorf("expected label %s, got %s", OtherApiMethodLabel, label)
+	}
+	// known methods keep their label
+	if label := pme.getApiMethodLabel("LAV1", "rest", "method_0"); label != "method_0" {
+		t.Errorf("expected label method_0, got %s", label)
+	}
+	// the limit is per chain and api interface
+	if label := pme.getApiMethodLabel("LAV1", "grpc", "new_method"); label != "new_method" {
+		t.Errorf("expected label new_method, got %s", label)
+	}
+}
@@ -0,0 +1,332 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	ConsumersUsageEndpoint = "/consumers"
+	// MaxTrackedConsumers bounds the number of (spec, consumer) pairs tracked by ProviderConsumersUsage,
+	// so the consumer_address label and the usage map don't grow without bound. When exceeded, the
+	// least recently active consumer is evicted (along with its metrics)
+	MaxTrackedConsumers = 1000
+)
+
+// ConsumerRewardsState is the CU of a consumer that the provider did not get paid for yet
+type ConsumerRewardsState struct {
+	SpecID            string
+	ConsumerAddress   string
+	UnclaimedCU       uint64 // proofs that were not claimed yet
+	AwaitingPaymentCU uint64 // proofs that were claimed and wait for the payment event
+}
+
+type ConsumerRewardsStateGetter interface {
+	ConsumersRewardsState() []ConsumerRewardsState
+}
+
+type ApiUsage struct {
+	Relays           uint64  `json:"relays"`
+	Errors           uint64  `json:"errors"`
+	CUServiced       uint64  `json:"cu_serviced"`
+	NodeLatencyAvgMs float64 `json:"node_latency_avg_ms"`
+	nodeLatencySum   time.Duration
+	nodeLatencyCount uint64
+}
+
+func (au *ApiUsage) addNodeLatency(latency time.Duration) {
+	au.nodeLatencySum += latency
+	au.nodeLatencyCount++
+	au.NodeLatencyAvgMs = float64(au.nodeLatencySum.Milliseconds()) / float64(au.nodeLatencyCount)
+}
+
+type ConsumerUsage struct {
+	SpecID            string `json:"spec_id"`
+	ConsumerAddress   string `json:"consumer_address"`
+	ProjectID         string `json:"project_id"`
+	ApiUsage                 // totals for all the apis
+	CUPaid            uint64 `json:"cu_paid"`
+	CUUnclaimed       uint64 `json:"cu_unclaimed"`
+	CUAwaitingPayment uint64 `json:"cu_awaiting_payment"`
+	// apiInterface -> api name -> usage
+	Apis       map[string]map[string]*ApiUsage `json:"apis"`
+	lastActive time.Time
+}
+
+// ProviderConsumersUsage tracks the usage of each consumer of the provider, it exports it
+// as prometheus metrics and serves it (joined with the reward server state) on ConsumersUsageEndpoint
+type ProviderConsumersUsage struct {
+	lock                     sync.Mutex
+	usage                    map[string]*ConsumerUsage // key is specID + consumer address
+	maxConsumers             int
+	rewardsStateGetter       ConsumerRewardsStateGetter
+	consumerCUServicedMetric *prometheus.CounterVec
+	consumerRelaysMetric     *prometheus.CounterVec
+	consumerErroredMetric    *prometheus.CounterVec
+	consumerCUPaidMetric     *prometheus.CounterVec
+	projectCUServicedMetric  *prometheus.CounterVec
+	projectRelaysMetric      *prometheus.CounterVec
+	apiRelaysMetric          *prometheus.CounterVec
+	nodeLatencyHistogram     *prometheus.HistogramVec
+}
+
+func NewProviderConsumersUsage() *ProviderConsumersUsage {
+	return &ProviderConsumersUsage{
+		usage:        map[string]*ConsumerUsage{},
+		maxConsumers: MaxTrackedConsumers,
+		consumerCUServicedMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_consumer_cu_serviced",
+			Help: "The total number of CUs serviced by the provider per consumer.",
+		}, []string{"spec", "apiInterface", "consumer_address"}),
+		consumerRelaysMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_consumer_relays_serviced",
+			Help: "The total number of relays serviced by the provider per consumer.",
+		}, []string{"spec", "apiInterface", "consumer_address"}),
+		consumerErroredMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_consumer_errored",
+			Help: "The total number of errors encountered by the provider per consumer.",
+		}, []string{"spec", "apiInterface", "consumer_address"}),
+		consumerCUPaidMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_consumer_cu_paid",
+			Help: "The total number of CUs paid to the provider per consumer.",
+		}, []string{"spec", "consumer_address"}),
+		projectCUServicedMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_project_cu_serviced",
+			Help: "The total number of CUs serviced by the provider per consumer project.",
+		}, []string{"spec", "apiInterface", "project_id"}),
+		projectRelaysMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_project_relays_serviced",
+			Help: "The total number of relays serviced by the provider per consumer project.",
+		}, []string{"spec", "apiInterface", "project_id"}),
+		apiRelaysMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_provider_api_relays_serviced",
+			Help: "The total number of relays serviced by the provider per spec api.",
+		}, []string{"spec", "apiInterface", "method"}),
+		nodeLatencyHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "lava_provider_node_latency_milliseconds",
+			Help:    "The latency of the node for relays that were not served from the cache.",
+			Buckets: LatencyHistogramBuckets,
+		}, []string{"spec", "apiInterface", "method"}),
+	}
+}
+
+func (pcu *ProviderConsumersUsage) register() {
+	prometheus.MustRegister(pcu.consumerCUServicedMetric)
+	prometheus.MustRegister(pcu.consumerRelaysMetric)
+	prometheus.MustRegister(pcu.consumerErroredMetric)
+	prometheus.MustRegister(pcu.consumerCUPaidMetric)
+	prometheus.MustRegister(pcu.projectCUServicedMetric)
+	prometheus.MustRegister(pcu.projectRelaysMetric)
+	prometheus.MustRegister(pcu.apiRelaysMetric)
+	prometheus.MustRegister(pcu.nodeLatencyHistogram)
+}
+
+func (pcu *ProviderConsumersUsage) SetRewardsStateGetter(rewardsStateGetter ConsumerRewardsStateGetter) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	pcu.rewardsStateGetter = rewardsStateGetter
+}
+
+// must be called while locked
+func (pcu *ProviderConsumersUsage) getConsumerUsage(specID string, consumerAddress string) *ConsumerUsage {
+	key := specID + consumerAddress
+	consumerUsage, ok := pcu.usage[key]
+	if !ok {
+		if len(pcu.usage) >= pcu.maxConsumers {
+			pcu.evictLeastRecentlyActive()
+		}
+		consumerUsage = &ConsumerUsage{SpecID: specID, ConsumerAddress: consumerAddress, Apis: map[string]map[string]*ApiUsage{}}
+		pcu.usage[key] = consumerUsage
+	}
+	consumerUsage.lastActive = time.Now()
+	return consumerUsage
+}
+
+// evictLeastRecentlyActive removes the least recently active consumer and its metrics
+// must be called while locked
+func (pcu *ProviderConsumersUsage) evictLeastRecentlyActive() {
+	var evictKey string
+	var evicted *ConsumerUsage
+	for key, consumerUsage := range pcu.usage {
+		if evicted == nil || consumerUsage.lastActive.Before(evicted.lastActive) {
+			evictKey = key
+			evicted = consumerUsage
+		}
+	}
+	if evicted == nil {
+		return
+	}
+	delete(pcu.usage, evictKey)
+
+	consumerLabels := prometheus.Labels{"spec": evicted.SpecID, "consumer_address": evicted.ConsumerAddress}
+	pcu.consumerCUServicedMetric.DeletePartialMatch(consumerLabels)
+	pcu.consumerRelaysMetric.DeletePartialMatch(consumerLabels)
+	pcu.consumerErroredMetric.DeletePartialMatch(consumerLabels)
+	pcu.consumerCUPaidMetric.DeletePartialMatch(consumerLabels)
+
+	if evicted.ProjectID == "" {
+		return
+	}
+	for _, consumerUsage := range pcu.usage {
+		if consumerUsage.SpecID == evicted.SpecID && consumerUsage.ProjectID == evicted.ProjectID {
+			// the project still has tracked consumers, keep its metrics
+			return
+		}
+	}
+	projectLabels := prometheus.Labels{"spec": evicted.SpecID, "project_id": evicted.ProjectID}
+	pcu.projectCUServicedMetric.DeletePartialMatch(projectLabels)
+	pcu.projectRelaysMetric.DeletePartialMatch(projectLabels)
+}
+
+// must be called while locked
+func (pcu *ProviderConsumersUsage) getApiUsage(specID string, apiInterface string, consumerAddress string, apiName string) (*ConsumerUsage, *ApiUsage) {
+	consumerUsage := pcu.getConsumerUsage(specID, consumerAddress)
+	apis, ok := consumerUsage.Apis[apiInterface]
+	if !ok {
+		apis = map[string]*ApiUsage{}
+		consumerUsage.Apis[apiInterface] = apis
+	}
+	apiUsage, ok := apis[apiName]
+	if !ok {
+		apiUsage = &ApiUsage{}
+		apis[apiName] = apiUsage
+	}
+	return consumerUsage, apiUsage
+}
+
+func (pcu *ProviderConsumersUsage) AddRelay(specID string, apiInterface string, consumerAddress string, apiName string, cu uint64) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	consumerUsage, apiUsage := pcu.getApiUsage(specID, apiInterface, consumerAddress, apiName)
+	consumerUsage.Relays++
+	consumerUsage.CUServiced += cu
+	apiUsage.Relays++
+	apiUsage.CUServiced += cu
+	pcu.consumerCUServicedMetric.WithLabelValues(specID, apiInterface, consumerAddress).Add(float64(cu))
+	pcu.consumerRelaysMetric.WithLabelValues(specID, apiInterface, consumerAddress).Add(1)
+	pcu.apiRelaysMetric.WithLabelValues(specID, apiInterface, apiName).Add(1)
+	if consumerUsage.ProjectID != "" {
+		pcu.projectCUServicedMetric.WithLabelValues(specID, apiInterface, consumerUsage.ProjectID).Add(float64(cu))
+		pcu.projectRelaysMetric.WithLabelValues(specID, apiInterface, consumerUsage.ProjectID).Add(1)
+	}
+}
+
+// SetConsumerProject sets the project of a consumer (the project is known once the consumer's pairing is verified)
+func (pcu *ProviderConsumersUsage) SetConsumerProject(specID string, consumerAddress string, projectID string) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	pcu.getConsumerUsage(specID, consumerAddress).ProjectID = projectID
+}
+
+func (pcu *ProviderConsumersUsage) AddError(specID string, apiInterface string, consumerAddress string, apiName string) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	consumerUsage, apiUsage := pcu.getApiUsage(specID, apiInterface, consumerAddress, apiName)
+	consumerUsage.Errors++
+	apiUsage.Errors++
+	pcu.consumerErroredMetric.WithLabelValues(specID, apiInterface, consumerAddress).Add(1)
+}
+
+func (pcu *ProviderConsumersUsage) AddNodeLatency(specID string, apiInterface string, consumerAddress string, apiName string, latency time.Duration) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	consumerUsage, apiUsage := pcu.getApiUsage(specID, apiInterface, consumerAddress, apiName)
+	consumerUsage.addNodeLatency(latency)
+	apiUsage.addNodeLatency(latency)
+	pcu.nodeLatencyHistogram.WithLabelValues(specID, apiInterface, apiName).Observe(float64(latency.Milliseconds()))
+}
+
+func (pcu *ProviderConsumersUsage) AddPayment(specID string, consumerAddress string, cu uint64) {
+	if pcu == nil {
+		return
+	}
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	pcu.getConsumerUsage(specID, consumerAddress).CUPaid += cu
+	pcu.consumerCUPaidMetric.WithLabelValues(specID, consumerAddress).Add(float64(cu))
+}
+
+// GetConsumersUsage returns a copy of the usage of all consumers, joined with the rewards state,
+// sorted by the serviced CU (descending) so the consumers driving the load are first
+func (pcu *ProviderConsumersUsage) GetConsumersUsage() []ConsumerUsage {
+	if pcu == nil {
+		return nil
+	}
+	// fetch the rewards state before locking, the reward server has its own lock
+	pcu.lock.Lock()
+	rewardsStateGetter := pcu.rewardsStateGetter
+	pcu.lock.Unlock()
+	var rewardsState []ConsumerRewardsState
+	if rewardsStateGetter != nil {
+		rewardsState = rewardsStateGetter.ConsumersRewardsState()
+	}
+
+	pcu.lock.Lock()
+	defer pcu.lock.Unlock()
+	for _, consumerUsage := range pcu.usage {
+		consumerUsage.CUUnclaimed = 0
+		consumerUsage.CUAwaitingPayment = 0
+	}
+	for _, state := range rewardsState {
+		consumerUsage, ok := pcu.usage[state.SpecID+state.ConsumerAddress]
+		if !ok {
+			if len(pcu.usage) >= pcu.maxConsumers {
+				// don't evict active consumers for consumers that only have a rewards state
+				continue
+			}
+			consumerUsage = pcu.getConsumerUsage(state.SpecID, state.ConsumerAddress)
+		}
+		consumerUsage.CUUnclaimed = state.UnclaimedCU
+		consumerUsage.CUAwaitingPayment = state.AwaitingPaymentCU
+	}
+	consumersUsage := make([]ConsumerUsage, 0, len(pcu.usage))
+	for _, consumerUsage := range pcu.usage {
+		usageCopy := *consumerUsage
+		usageCopy.Apis = make(map[string]map[string]*ApiUsage, len(consumerUsage.Apis))
+		for apiInterface, apis := range consumerUsage.Apis {
+			apisCopy := make(map[string]*ApiUsage, len(apis))
+			for apiName, apiUsage := range apis {
+				apiUsageCopy := *apiUsage
+				apisCopy[apiName] = &apiUsageCopy
+			}
+			usageCopy.Apis[apiInterface] = apisCopy
+		}
+		consumersUsage = append(consumersUsage, usageCopy)
+	}
+	sort.Slice(consumersUsage, func(i, j int) bool {
+		if consumersUsage[i].CUServiced != consumersUsage[j].CUServiced {
+			return consumersUsage[i].CUServiced > consumersUsage[j].CUServiced
+		}
+		return consumersUsage[i].SpecID+consumersUsage[i].ConsumerAddress < consumersUsage[j].SpecID+consumersUsage[j].ConsumerAddress
+	})
+	return consumersUsage
+}
+
+func (pcu *ProviderConsumersUsage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(pcu.GetConsumersUsage())
+	if err != nil {
+		utils.LavaFormatWarning("failed encoding consumers usage", err)
+	}
+}
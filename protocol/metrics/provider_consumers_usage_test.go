@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRewardsStateGetter struct {
+	states []ConsumerRewardsState
+}
+
+func (m *mockRewardsStateGetter) ConsumersRewardsState() []ConsumerRewardsState {
+	return m.states
+}
+
+func TestProviderConsumersUsage(t *testing.T) {
+	pcu := NewProviderConsumersUsage()
+	rewardsState := &mockRewardsStateGetter{states: []ConsumerRewardsState{
+		{SpecID: "LAV1", ConsumerAddress: "consumer1", UnclaimedCU: 30, AwaitingPaymentCU: 10},
+	}}
+	pcu.SetRewardsStateGetter(rewardsState)
+
+	pcu.AddRelay("LAV1", "rest", "consumer1", "block", 10)
+	pcu.AddRelay("LAV1", "rest", "consumer1", "block", 10)
+	pcu.AddRelay("LAV1", "grpc", "consumer1", "balance", 20)
+	pcu.AddError("LAV1", "rest", "consumer1", "block")
+	pcu.AddNodeLatency("LAV1", "rest", "consumer1", "block", 10*time.Millisecond)
+	pcu.AddNodeLatency("LAV1", "rest", "consumer1", "block", 30*time.Millisecond)
+	pcu.AddPayment("LAV1", "consumer1", 5)
+	pcu.AddRelay("LAV1", "rest", "consumer2", "block", 100)
+
+	usage := pcu.GetConsumersUsage()
+	require.Len(t, usage, 2)
+	// sorted by serviced cu
+	require.Equal(t, "consumer2", usage[0].ConsumerAddress)
+	require.Equal(t, uint64(100), usage[0].CUServiced)
+	require.Zero(t, usage[0].CUUnclaimed)
+
+	consumer1 := usage[1]
+	require.Equal(t, "consumer1", consumer1.ConsumerAddress)
+	require.Equal(t, uint64(3), consumer1.Relays)
+	require.Equal(t, uint64(40), consumer1.CUServiced)
+	require.Equal(t, uint64(1), consumer1.Errors)
+	require.Equal(t, uint64(5), consumer1.CUPaid)
+	require.Equal(t, uint64(30), consumer1.CUUnclaimed)
+	require.Equal(t, uint64(10), consumer1.CUAwaitingPayment)
+	require.Equal(t, float64(20), consumer1.NodeLatencyAvgMs)
+	block := consumer1.Apis["rest"]["block"]
+	require.Equal(t, uint64(2), block.Relays)
+	require.Equal(t, uint64(1), block.Errors)
+	require.Equal(t, uint64(1), consumer1.Apis["grpc"]["balance"].Relays)
+
+	// the rewards state is refreshed on each query
+	rewardsState.states = nil
+	usage = pcu.GetConsumersUsage()
+	require.Zero(t, usage[1].CUUnclaimed)
+	require.Zero(t, usage[1].CUAwaitingPayment)
+}
+
+func TestProviderConsumersUsageProject(t *testing.T) {
+	pcu := NewProviderConsumersUsage()
+	pcu.SetConsumerProject("LAV1", "consumer1", "project1")
+	pcu.AddRelay("LAV1", "rest", "consumer1", "block", 10)
+	pcu.AddRelay("LAV1", "rest", "consumer2", "block", 10)
+
+	usage := pcu.GetConsumersUsage()
+	require.Len(t, usage, 2)
+	require.Equal(t, "project1", usage[0].ProjectID)
+	require.Empty(t, usage[1].ProjectID)
+
+	require.Equal(t, float64(10), testutil.ToFloat64(pcu.projectCUServicedMetric.WithLabelValues("LAV1", "rest", "project1")))
+	require.Equal(t, 1, testutil.CollectAndCount(pcu.projectRelaysMetric))
+}
+
+func TestProviderConsumersUsageEviction(t *testing.T) {
+	pcu := NewProviderConsumersUsage()
+	pcu.maxConsumers = 2
+	pcu.SetRewardsStateGetter(&mockRewardsStateGetter{states: []ConsumerRewardsState{
+		{SpecID: "LAV1", ConsumerAddress: "consumer4", UnclaimedCU: 30},
+	}})
+
+	pcu.SetConsumerProject("LAV1", "consumer1", "project1")
+	pcu.AddRelay("LAV1", "rest", "consumer1", "block", 10)
+	time.Sleep(time.Millisecond)
+	pcu.AddRelay("LAV1", "rest", "consumer2", "block", 10)
+	time.Sleep(time.Millisecond)
+	// consumer1 is the least recently active, so it is evicted along with its metrics
+	pcu.AddRelay("LAV1", "rest", "consumer3", "block", 10)
+
+	usage := pcu.GetConsumersUsage()
+	// consumer4 only has a rewards state, so it doesn't evict active consumers
+	require.Len(t, usage, 2)
+	for _, consumerUsage := range usage {
+		require.NotEqual(t, "consumer1", consumerUsage.ConsumerAddress)
+		require.NotEqual(t, "consumer4", consumerUsage.ConsumerAddress)
+	}
+	require.Equal(t, 2, testutil.CollectAndCount(pcu.consumerRelaysMetric))
+	require.Equal(t, 0, testutil.CollectAndCount(pcu.projectRelaysMetric))
+}
@@ -35,6 +35,7 @@ type RPCConsumerLogs struct {
 	excludedUserAgent         []string
 	consumerMetricsManager    *ConsumerMetricsManager
 	consumerRelayServerClient *ConsumerRelayServerClient
+	accessLogger              *AccessLogger
 }
 
 func NewRPCConsumerLogs(consumerMetricsManager *ConsumerMetricsManager, consumerRelayServerClient *ConsumerRelayServerClient) (*RPCConsumerLogs, error) {
@@ -86,6 +87,23 @@ func NewRPCConsumerLogs(consumerMetricsManager *ConsumerMetricsManager, consumer
 	return rpcConsumerLogs, err
 }
 
+func (rpccl *RPCConsumerLogs) SetAccessLogger(accessLogger *AccessLogger) {
+	rpccl.accessLogger = accessLogger
+}
+
+func (rpccl *RPCConsumerLogs) AccessLogEnabled() bool {
+	return rpccl.accessLogger != nil
+}
+
+// ShouldLogAccessBodies returns true if the request and response bodies should be added to the next access log record
+func (rpccl *RPCConsumerLogs) ShouldLogAccessBodies() bool {
+	return rpccl.accessLogger.ShouldLogBodies()
+}
+
+func (rpccl *RPCConsumerLogs) LogAccess(record *AccessLogRecord) {
+	rpccl.accessLogger.Log(record)
+}
+
 func (rpccl *RPCConsumerLogs) GetMessageSeed() string {
 	return "GUID_" + strconv.Itoa(rand.Intn(10000000000))
 }
@@ -153,6 +171,10 @@ func (rpccl *RPCConsumerLogs) LogStartTransaction(name string) func() {
 	}
 }
 
+func (rpccl *RPCConsumerLogs) SetCacheHitLatency(chainId string, apiInterface string, apiMethod string, latency time.Duration) {
+	rpccl.consumerMetricsManager.SetCacheHitLatency(chainId, apiInterface, apiMethod, latency)
+}
+
 func (rpccl *RPCConsumerLogs) AddMetricForHttp(data *RelayMetrics, err error, headers map[string][]string) {
 	rpccl.consumerMetricsManager.SetRelayMetrics(data, err)
 	rpccl.consumerRelayServerClient.SetRelayMetrics(data)
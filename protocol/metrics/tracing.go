@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	TracingEndpointFlagName    = "tracing-otlp-endpoint"
+	TracingSampleRatioFlagName = "tracing-sample-ratio"
+	TracingInsecureFlagName    = "tracing-insecure"
+	DefaultTracingSampleRatio  = 0.1
+	tracerName                 = "github.com/lavanet/lava/protocol"
+)
+
+// AddTracingFlags adds the flags that configure InitTracingFromFlags to cmd
+func AddTracingFlags(cmd *cobra.Command) {
+	cmd.Flags().String(TracingEndpointFlagName, DisabledFlagOption, "the address of an OTLP (gRPC) collector to export traces to (such as localhost:4317)")
+	cmd.Flags().Float64(TracingSampleRatioFlagName, DefaultTracingSampleRatio, "the ratio of relays to trace, between 0 and 1")
+	cmd.Flags().Bool(TracingInsecureFlagName, false, "connect to the OTLP collector without TLS")
+}
+
+// InitTracingFromFlags calls InitTracing with the values of the flags added by AddTracingFlags
+func InitTracingFromFlags(ctx context.Context, serviceName string) (shutdown func(context.Context) error, err error) {
+	return InitTracing(ctx, serviceName, viper.GetString(TracingEndpointFlagName), viper.GetFloat64(TracingSampleRatioFlagName), viper.GetBool(TracingInsecureFlagName))
+}
+
+// InitTracing sets the global OpenTelemetry tracer provider to export spans to an OTLP (gRPC)
+// collector. Spans are sampled by the given ratio, unless their parent was sampled (so a trace
+// that was sampled by the consumer is also traced by the provider). If the endpoint is disabled
+// the global no-op tracer is kept, so the spans cost nothing. The returned function flushes
+// the pending spans and should be called on shutdown.
+func InitTracing(ctx context.Context, serviceName string, endpoint string, sampleRatio float64, insecure bool) (shutdown func(context.Context) error, err error) {
+	if endpoint == "" || endpoint == DisabledFlagOption {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed creating OTLP trace exporter", err, utils.LogAttr("endpoint", endpoint))
+	}
+
+	resource := sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	utils.LavaFormatInfo("tracing enabled",
+		utils.LogAttr("endpoint", endpoint),
+		utils.LogAttr("service", serviceName),
+		utils.LogAttr("sample_ratio", sampleRatio),
+	)
+	return tracerProvider.Shutdown, nil
+}
+
+// StartSpan starts a span as a child of the span in ctx (if any). The relay's GUID (see
+// utils.GetUniqueIdentifier) is added to the span so traces can be matched with the logs.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if guid, found := utils.GetUniqueIdentifier(ctx); found {
+		attributes = append(attributes, attribute.String("lava.guid", strconv.FormatUint(guid, 10)))
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartRelaySpan starts the root span of a relay that was received by a chain listener
+func StartRelaySpan(ctx context.Context, name string, chainID string, apiInterface string, dappID string) (context.Context, trace.Span) {
+	return StartSpan(ctx, name,
+		attribute.String("lava.chain_id", chainID),
+		attribute.String("lava.api_interface", apiInterface),
+		attribute.String("lava.dapp_id", dappID),
+	)
+}
+
+// EndSpan ends a span, marking it as failed if err is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SpanContextFromContext copies the span of ctx to another context. It is used when work
+// that belongs to a relay continues on a new context (e.g. a context that is not canceled
+// when the relay returns)
+func SpanContextFromContext(ctx context.Context, to context.Context) context.Context {
+	return trace.ContextWithSpan(to, trace.SpanFromContext(ctx))
+}
+
+// InjectTraceToOutgoingContext adds the span of ctx to the outgoing gRPC metadata so the
+// trace continues on the other side of the call (see ExtractTraceFromIncomingContext)
+func InjectTraceToOutgoingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	} else {
+		md = md.Copy()
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// ExtractTraceFromIncomingContext returns a context with the remote span that was sent in
+// the incoming gRPC metadata (if any)
+func ExtractTraceFromIncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier adapts gRPC metadata to the OpenTelemetry propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	values := metadata.MD(mc).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (mc metadataCarrier) Set(key string, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for key := range mc {
+		keys = append(keys, key)
+	}
+	return keys
+}
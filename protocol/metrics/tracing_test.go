@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracePropagationOverGrpcMetadata(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample())))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("existing", "value"))
+	ctx, span := StartSpan(ctx, "test")
+	defer span.End()
+
+	outgoing := InjectTraceToOutgoingContext(ctx)
+	md, ok := metadata.FromOutgoingContext(outgoing)
+	require.True(t, ok)
+	require.Equal(t, []string{"value"}, md.Get("existing"))
+
+	// simulate the other side of the call
+	incoming := metadata.NewIncomingContext(context.Background(), md)
+	remote := trace.SpanContextFromContext(ExtractTraceFromIncomingContext(incoming))
+	require.True(t, remote.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+}
+
+func TestInitTracingDisabled(t *testing.T) {
+	shutdown, err := InitTracing(context.Background(), "test", DisabledFlagOption, DefaultTracingSampleRatio, true)
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
@@ -0,0 +1,336 @@
+package monitoring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
+)
+
+const (
+	SeverityCritical = "critical"
+	SeverityWarning  = "warning"
+
+	SlackSinkType     = "slack"
+	PagerDutySinkType = "pagerduty"
+	WebhookSinkType   = "webhook"
+
+	DefaultPagerDutyEventsUrl = "https://events.pagerduty.com/v2/enqueue"
+	alertSinkRequestTimeout   = 10 * time.Second
+)
+
+// the severity of each alert type, sinks receive only the severities they are configured for
+var AlertSeverities = map[string]string{
+	FrozenProviderAttribute:    SeverityCritical,
+	UnhealthyProviderAttribute: SeverityCritical,
+	UnhealthyConsumerAttribute: SeverityCritical,
+	SubscriptionAlertAttribute: SeverityWarning,
+	ProviderBlockGapAttribute:  SeverityWarning,
+	ConsumerBlockGapAttribute:  SeverityWarning,
+	ProviderLatencyAttribute:   SeverityWarning,
+	// trends are early warnings
+	ProviderBlockLagTrendAttribute:     SeverityWarning,
+	ProviderLatencyRegressionAttribute: SeverityWarning,
+	SubscriptionBurnRateAttribute:      SeverityWarning,
+}
+
+func alertSeverity(alertType string) string {
+	if severity, ok := AlertSeverities[alertType]; ok {
+		return severity
+	}
+	return SeverityWarning
+}
+
+// AlertSinkConfig is an entry of alert_sinks in the health config
+type AlertSinkConfig struct {
+	Type       string            `mapstructure:"type"`
+	Url        string            `mapstructure:"url"`
+	Severities []string          `mapstructure:"severities"`  // the severities sent to this sink, all if empty
+	RoutingKey string            `mapstructure:"routing-key"` // pagerduty integration key
+	Template   string            `mapstructure:"template"`    // webhook body, a go text/template executed on WebhookTemplateData
+	Headers    map[string]string `mapstructure:"headers"`     // webhook headers
+}
+
+// SinkAlertEntry is a single alerting (or recovered) entity
+type SinkAlertEntry struct {
+	Entity LavaEntity
+	Data   string
+}
+
+// DedupKey identifies the alert of an entity across health runs, so an alert and its recovery can be matched
+func (sae SinkAlertEntry) DedupKey(alertType string) string {
+	return hex.EncodeToString(sigs.HashMsg([]byte(alertType + " " + sae.Entity.String())))
+}
+
+type SinkAlert struct {
+	Type      string
+	Severity  string
+	Recovered bool
+	Entries   []SinkAlertEntry
+}
+
+// Title is the alert text used by the existing alerts (and logs)
+func (sa SinkAlert) Title() string {
+	if sa.Recovered {
+		return "recovered - " + sa.Type
+	}
+	return sa.Type
+}
+
+// AlertSink receives the alerts of a health run and sends them when the run is done
+type AlertSink interface {
+	AppendAlert(alert SinkAlert)
+	Flush(identifier string) error
+}
+
+type severityFilteredSink struct {
+	AlertSink
+	severities map[string]struct{}
+}
+
+func (sfs *severityFilteredSink) accepts(severity string) bool {
+	if len(sfs.severities) == 0 {
+		return true
+	}
+	_, ok := sfs.severities[severity]
+	return ok
+}
+
+func NewAlertSink(config AlertSinkConfig) (AlertSink, error) {
+	switch config.Type {
+	case SlackSinkType, "":
+		if config.Url == "" {
+			return nil, fmt.Errorf("slack alert sink requires a url")
+		}
+		return NewSlackAlertSink(config.Url), nil
+	case PagerDutySinkType:
+		if config.RoutingKey == "" {
+			return nil, fmt.Errorf("pagerduty alert sink requires a routing-key")
+		}
+		url := config.Url
+		if url == "" {
+			url = DefaultPagerDutyEventsUrl
+		}
+		return &PagerDutyAlertSink{url: url, routingKey: config.RoutingKey}, nil
+	case WebhookSinkType:
+		if config.Url == "" {
+			return nil, fmt.Errorf("webhook alert sink requires a url")
+		}
+		return NewWebhookAlertSink(config.Url, config.Template, config.Headers)
+	default:
+		return nil, fmt.Errorf("unknown alert sink type %q", config.Type)
+	}
+}
+
+func postAlert(url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	client := &http.Client{Timeout: alertSinkRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("alert sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SlackAlertSink posts all the alerts of a run as attachments (and embeds, for discord) of one message
+type SlackAlertSink struct {
+	url         string
+	payload     map[string]interface{}
+	colorToggle bool
+}
+
+func NewSlackAlertSink(url string) *SlackAlertSink {
+	return &SlackAlertSink{url: url, payload: map[string]interface{}{}}
+}
+
+func (sas *SlackAlertSink) AppendAlert(alert SinkAlert) {
+	attachments := []map[string]interface{}{}
+	if attachmentsProp, ok := sas.payload["attachments"]; ok {
+		attachmentsCasted, ok := attachmentsProp.([]map[string]interface{})
+		if ok {
+			attachments = attachmentsCasted
+		}
+	}
+	fields := []map[string]interface{}{}
+	colorToSet := green
+	for _, entry := range alert.Entries {
+		if entry.Data != OKString {
+			colorToSet = red
+			if sas.colorToggle {
+				sas.colorToggle = !sas.colorToggle
+				colorToSet = lessRed
+			}
+		}
+		field := map[string]interface{}{
+			"title":  entry.Entity.String(),
+			"text":   entry.Entity.String(),
+			"value":  entry.Data,
+			"short":  false,
+			"inline": false,
+		}
+		fields = append(fields, field)
+	}
+	attachment := map[string]interface{}{
+		"text":   alert.Title(),
+		"title":  alert.Title(),
+		"color":  colorToSet,
+		"fields": fields,
+	}
+	attachments = append(attachments, attachment)
+	sas.payload["attachments"] = attachments
+	sas.payload["embeds"] = attachments
+}
+
+func (sas *SlackAlertSink) Flush(identifier string) error {
+	payload := sas.payload
+	sas.payload = map[string]interface{}{}
+	if len(payload) == 0 {
+		return nil
+	}
+	if identifier != "" {
+		payload["text"] = identifier
+		payload["content"] = identifier
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return postAlert(sas.url, payloadBytes, nil)
+}
+
+// PagerDutyAlertSink sends an Events API v2 event per entity, triggered by alerts and resolved by their
+// recovery, with a dedup key per alert type and entity so repeated alerts update the same incident
+type PagerDutyAlertSink struct {
+	url        string
+	routingKey string
+	events     []pagerDutyEvent
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+func (pds *PagerDutyAlertSink) AppendAlert(alert SinkAlert) {
+	for _, entry := range alert.Entries {
+		event := pagerDutyEvent{
+			RoutingKey: pds.routingKey,
+			DedupKey:   entry.DedupKey(alert.Type),
+		}
+		if alert.Recovered {
+			event.EventAction = "resolve"
+		} else {
+			event.EventAction = "trigger"
+			event.Payload = &pagerDutyPayload{
+				Summary:       fmt.Sprintf("%s: %s - %s", alert.Type, entry.Entity.String(), entry.Data),
+				Severity:      alert.Severity,
+				Component:     entry.Entity.Address,
+				Group:         entry.Entity.SpecId,
+				CustomDetails: map[string]string{"data": entry.Data, "api_interface": entry.Entity.ApiInterface},
+			}
+		}
+		pds.events = append(pds.events, event)
+	}
+}
+
+func (pds *PagerDutyAlertSink) Flush(identifier string) error {
+	events := pds.events
+	pds.events = nil
+	var lastErr error
+	for _, event := range events {
+		if event.Payload != nil {
+			event.Payload.Source = identifier
+			if event.Payload.Source == "" {
+				event.Payload.Source = "lava-health"
+			}
+		}
+		body, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		err = postAlert(pds.url, body, nil)
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// WebhookTemplateData is the input of the template of a WebhookAlertSink
+type WebhookTemplateData struct {
+	Identifier string
+	Alerts     []SinkAlert
+}
+
+// the default webhook body, a json with all the alerts of the run
+const defaultWebhookTemplate = `{{ json . }}`
+
+// WebhookAlertSink posts the alerts of a run to a url with a body rendered by a configured template
+type WebhookAlertSink struct {
+	url      string
+	template *template.Template
+	headers  map[string]string
+	alerts   []SinkAlert
+}
+
+func NewWebhookAlertSink(url string, bodyTemplate string, headers map[string]string) (*WebhookAlertSink, error) {
+	if bodyTemplate == "" {
+		bodyTemplate = defaultWebhookTemplate
+	}
+	parsed, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(value interface{}) (string, error) {
+			encoded, err := json.Marshal(value)
+			return string(encoded), err
+		},
+	}).Parse(bodyTemplate)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid webhook alert template", err)
+	}
+	return &WebhookAlertSink{url: url, template: parsed, headers: headers}, nil
+}
+
+func (was *WebhookAlertSink) AppendAlert(alert SinkAlert) {
+	was.alerts = append(was.alerts, alert)
+}
+
+func (was *WebhookAlertSink) Flush(identifier string) error {
+	alerts := was.alerts
+	was.alerts = nil
+	if len(alerts) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	err := was.template.Execute(&body, WebhookTemplateData{Identifier: identifier, Alerts: alerts})
+	if err != nil {
+		return err
+	}
+	return postAlert(was.url, body.Bytes(), was.headers)
+}
@@ -0,0 +1,83 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingServer struct {
+	*httptest.Server
+	lock   sync.Mutex
+	bodies [][]byte
+}
+
+func newRecordingServer(t *testing.T) *recordingServer {
+	rs := &recordingServer{}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		rs.lock.Lock()
+		defer rs.lock.Unlock()
+		rs.bodies = append(rs.bodies, body)
+	}))
+	t.Cleanup(rs.Close)
+	return rs
+}
+
+func (rs *recordingServer) Bodies() [][]byte {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	return rs.bodies
+}
+
+func TestAlertSinksSeverities(t *testing.T) {
+	critical := newRecordingServer(t)
+	all := newRecordingServer(t)
+	pagerDuty := newRecordingServer(t)
+	al := NewAlerting(AlertingOptions{
+		Url: all.URL,
+		Sinks: []AlertSinkConfig{
+			{Type: WebhookSinkType, Url: critical.URL, Severities: []string{SeverityCritical}, Template: `{{ range .Alerts }}{{ .Type }};{{ end }}`},
+			{Type: PagerDutySinkType, Url: pagerDuty.URL, RoutingKey: "key"},
+		},
+		DisableAlertSuppression: true,
+		Identifier:              "test",
+	})
+	provider := LavaEntity{Address: "lava@provider", SpecId: "LAV1", ApiInterface: "rest"}
+
+	al.SendAlert(UnhealthyProviderAttribute, []AlertAttribute{{entity: provider, data: "error"}})
+	al.SendAlert(ProviderLatencyAttribute, []AlertAttribute{{entity: provider, data: "latency"}})
+	al.SendAppendedAlerts()
+
+	require.Equal(t, [][]byte{[]byte(UnhealthyProviderAttribute + ";")}, critical.Bodies())
+
+	require.Len(t, all.Bodies(), 1)
+	var slackPayload map[string]interface{}
+	require.NoError(t, json.Unmarshal(all.Bodies()[0], &slackPayload))
+	require.Equal(t, "test", slackPayload["text"])
+	require.Len(t, slackPayload["attachments"], 2)
+
+	// an event per entity, resolved by the recovery with the same dedup key
+	require.Len(t, pagerDuty.Bodies(), 2)
+	var trigger pagerDutyEvent
+	require.NoError(t, json.Unmarshal(pagerDuty.Bodies()[0], &trigger))
+	require.Equal(t, "trigger", trigger.EventAction)
+	require.Equal(t, SeverityCritical, trigger.Payload.Severity)
+	require.Equal(t, "test", trigger.Payload.Source)
+
+	al.activeAlerts[AlertEntry{alertType: UnhealthyProviderAttribute, entity: provider}] = AlertCount{active: 1}
+	al.SendRecoveryAlerts([]AlertEntry{{alertType: UnhealthyProviderAttribute, entity: provider}})
+	al.SendAppendedAlerts()
+	require.Len(t, pagerDuty.Bodies(), 3)
+	var resolve pagerDutyEvent
+	require.NoError(t, json.Unmarshal(pagerDuty.Bodies()[2], &resolve))
+	require.Equal(t, "resolve", resolve.EventAction)
+	require.Equal(t, trigger.DedupKey, resolve.DedupKey)
+	require.Len(t, critical.Bodies(), 2)
+}
@@ -1,10 +1,7 @@
 package monitoring
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -35,7 +32,8 @@ const (
 )
 
 type AlertingOptions struct {
-	Url                           string // where to send the alerts
+	Url                           string // where to send the alerts, as a slack compatible webhook
+	Sinks                         []AlertSinkConfig
 	Logging                       bool   // wether to log alerts to stdout
 	Identifier                    string // a unique identifier added to all alerts
 	SubscriptionCUPercentageAlert float64
@@ -45,6 +43,7 @@ type AlertingOptions struct {
 	SameAlertInterval             time.Duration
 	DisableAlertSuppression       bool
 	SuppressionCounterThreshold   uint64
+	History                       *HealthHistory // enables the trend alerts if set
 }
 
 type AlertAttribute struct {
@@ -63,7 +62,7 @@ type AlertCount struct {
 }
 
 type Alerting struct {
-	url                           string
+	sinks                         []*severityFilteredSink
 	logging                       bool
 	identifier                    string
 	subscriptionCUPercentageAlert float64
@@ -78,8 +77,7 @@ type Alerting struct {
 	currentAlerts                 map[AlertEntry]struct{}
 	suppressionCounterThreshold   uint64
 	suppressedAlerts              uint64 // monitoring
-	payload                       map[string]interface{}
-	colorToggle                   bool
+	history                       *HealthHistory
 }
 
 func NewAlerting(options AlertingOptions) *Alerting {
@@ -88,10 +86,21 @@ func NewAlerting(options AlertingOptions) *Alerting {
 		healthy:       map[LavaEntity]struct{}{},
 		unhealthy:     map[LavaEntity]struct{}{},
 		currentAlerts: map[AlertEntry]struct{}{},
-		payload:       map[string]interface{}{},
 	}
+	sinkConfigs := options.Sinks
 	if options.Url != "" {
-		al.url = options.Url
+		sinkConfigs = append([]AlertSinkConfig{{Type: SlackSinkType, Url: options.Url}}, sinkConfigs...)
+	}
+	for _, sinkConfig := range sinkConfigs {
+		sink, err := NewAlertSink(sinkConfig)
+		if err != nil {
+			utils.LavaFormatFatal("invalid alert sink", err, utils.LogAttr("type", sinkConfig.Type))
+		}
+		severities := map[string]struct{}{}
+		for _, severity := range sinkConfig.Severities {
+			severities[severity] = struct{}{}
+		}
+		al.sinks = append(al.sinks, &severityFilteredSink{AlertSink: sink, severities: severities})
 	}
 	if options.Identifier != "" {
 		al.identifier = options.Identifier
@@ -104,6 +113,7 @@ func NewAlerting(options AlertingOptions) *Alerting {
 	al.allowedTimeGapVsReference = options.AllowedTimeGapVsReference
 	al.maxProviderLatency = options.MaxProviderLatency
 	al.suppressionCounterThreshold = options.SuppressionCounterThreshold
+	al.history = options.History
 	if options.DisableAlertSuppression {
 		al.sameAlertInterval = 0
 		al.suppressionCounterThreshold = 0
@@ -149,17 +159,26 @@ func (al *Alerting) FilterOccurenceSuppresedAlerts(alert string, attributes []Al
 func (al *Alerting) SendAlert(alert string, attributes []AlertAttribute) {
 	// check for occurrence suppression
 	attributes = al.FilterOccurenceSuppresedAlerts(alert, attributes)
+	al.sendAlert(alert, attributes)
+}
+
+// sendAlert sends the alert without occurrence suppression, only the time suppression applies
+func (al *Alerting) sendAlert(alert string, attributes []AlertAttribute) {
 	if len(attributes) == 0 {
 		return
 	}
-	attrs := al.FilterTimeSuppresedAlerts(attributes, alert)
-	if len(attrs) == 0 {
+	attributes = al.FilterTimeSuppresedAlerts(attributes, alert)
+	if len(attributes) == 0 {
 		return
 	}
 
-	if al.url != "" {
-		go al.AppendUrlAlert(alert, attrs)
+	sinkAlert := SinkAlert{Type: alert, Severity: alertSeverity(alert)}
+	attrs := make([]utils.Attribute, 0, len(attributes))
+	for _, attr := range attributes {
+		sinkAlert.Entries = append(sinkAlert.Entries, SinkAlertEntry{Entity: attr.entity, Data: attr.data})
+		attrs = append(attrs, utils.LogAttr(attr.entity.String(), attr.data))
 	}
+	al.appendSinkAlert(sinkAlert)
 	if al.logging {
 		if al.identifier != "" {
 			alert = alert + " - " + al.identifier
@@ -168,8 +187,8 @@ func (al *Alerting) SendAlert(alert string, attributes []AlertAttribute) {
 	}
 }
 
-func (al *Alerting) FilterTimeSuppresedAlerts(attributes []AlertAttribute, alert string) []utils.Attribute {
-	attrs := []utils.Attribute{}
+func (al *Alerting) FilterTimeSuppresedAlerts(attributes []AlertAttribute, alert string) []AlertAttribute {
+	attrs := []AlertAttribute{}
 	for _, attr := range attributes {
 		if al.sameAlertInterval > 0 && al.AlertsCache != nil {
 			// we only hash by keys, values can differ (like blocks or error)
@@ -189,13 +208,13 @@ func (al *Alerting) FilterTimeSuppresedAlerts(attributes []AlertAttribute, alert
 			}
 			al.AlertsCache.SetWithTTL(hashStr, time.Now(), 1, al.sameAlertInterval)
 		}
-		attrs = append(attrs, utils.LogAttr(attr.entity.String(), attr.data))
+		attrs = append(attrs, attr)
 	}
 	return attrs
 }
 
 func (al *Alerting) SendRecoveryAlerts(alertEntries []AlertEntry) {
-	alertTypeAttributes := map[string][]utils.Attribute{}
+	alertTypeAlerts := map[string]*SinkAlert{}
 	for _, alertEntry := range alertEntries {
 		count, ok := al.activeAlerts[alertEntry]
 		if !ok {
@@ -204,94 +223,41 @@ func (al *Alerting) SendRecoveryAlerts(alertEntries []AlertEntry) {
 		if count.active < al.suppressionCounterThreshold {
 			continue
 		}
-		attrs, ok := alertTypeAttributes[alertEntry.alertType]
+		sinkAlert, ok := alertTypeAlerts[alertEntry.alertType]
 		if !ok {
-			attrs = []utils.Attribute{}
+			sinkAlert = &SinkAlert{Type: alertEntry.alertType, Severity: alertSeverity(alertEntry.alertType), Recovered: true}
+			alertTypeAlerts[alertEntry.alertType] = sinkAlert
 		}
-		attrs = append(attrs, utils.Attribute{
-			Key:   alertEntry.entity.String(),
-			Value: OKString,
-		})
-		alertTypeAttributes[alertEntry.alertType] = attrs
-	}
-	if len(alertTypeAttributes) == 0 {
-		return
+		sinkAlert.Entries = append(sinkAlert.Entries, SinkAlertEntry{Entity: alertEntry.entity, Data: OKString})
 	}
-	for alertType, attrs := range alertTypeAttributes {
-		alertType = "recovered - " + alertType
-		if al.url != "" {
-			al.AppendUrlAlert(alertType, attrs)
-		}
+	for _, sinkAlert := range alertTypeAlerts {
+		al.appendSinkAlert(*sinkAlert)
 		if al.logging {
-			utils.LavaFormatInfo(alertType, attrs...)
+			attrs := make([]utils.Attribute, 0, len(sinkAlert.Entries))
+			for _, entry := range sinkAlert.Entries {
+				attrs = append(attrs, utils.Attribute{Key: entry.Entity.String(), Value: OKString})
+			}
+			utils.LavaFormatInfo(sinkAlert.Title(), attrs...)
 		}
 	}
 }
 
-func (al *Alerting) SendAppendedAlerts() error {
-	if len(al.payload) == 0 {
-		return nil
-	}
-	if al.identifier != "" {
-		al.payload["text"] = al.identifier
-		al.payload["content"] = al.identifier
-	}
-	payloadBytes, err := json.Marshal(al.payload)
-	if err != nil {
-		return err
-	}
-	req, err := http.NewRequest("POST", al.url, bytes.NewBuffer(payloadBytes))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
+// SendAppendedAlerts sends the alerts of the health run that were appended to the sinks
+func (al *Alerting) SendAppendedAlerts() {
+	for _, sink := range al.sinks {
+		err := sink.Flush(al.identifier)
+		if err != nil {
+			utils.LavaFormatWarning("failed sending alerts", err, utils.LogAttr("sink", fmt.Sprintf("%T", sink.AlertSink)))
+		}
 	}
-	defer resp.Body.Close()
-	return nil
 }
 
-func (al *Alerting) AppendUrlAlert(alert string, attrs []utils.Attribute) {
-	attachments := []map[string]interface{}{}
-	if attachmentsProp, ok := al.payload["attachments"]; ok {
-		attachmentsCasted, ok := attachmentsProp.([]map[string]interface{})
-		if ok {
-			attachments = attachmentsCasted
+func (al *Alerting) appendSinkAlert(alert SinkAlert) {
+	for _, sink := range al.sinks {
+		if sink.accepts(alert.Severity) {
+			sink.AppendAlert(alert)
 		}
 	}
-	fields := []map[string]interface{}{}
-	colorToSet := green
-	for _, attr := range attrs {
-		if utils.StrValue(attr.Value) != OKString {
-			colorToSet = red
-			if al.colorToggle {
-				al.colorToggle = !al.colorToggle
-				colorToSet = lessRed
-			}
-		}
-		field := map[string]interface{}{
-			"title":  attr.Key,
-			"text":   attr.Key,
-			"value":  attr.Value,
-			"short":  false,
-			"inline": false,
-		}
-		fields = append(fields, field)
-	}
-	attachment := map[string]interface{}{
-		"text":   alert,
-		"title":  alert,
-		"color":  colorToSet,
-		"fields": fields,
-	}
-	attachments = append(attachments, attachment)
-	al.payload["attachments"] = attachments
-	al.payload["embeds"] = attachments
 }
 
 func (al *Alerting) SendFrozenProviders(frozenProviders map[LavaEntity]struct{}) {
@@ -406,10 +372,24 @@ func (al *Alerting) ConsumerAlerts(healthResults *HealthResults) {
 	}
 }
 
+func (al *Alerting) TrendAlerts() {
+	if al.history == nil {
+		return
+	}
+	trendAlerts, err := al.history.TrendAlerts(time.Now())
+	if err != nil {
+		utils.LavaFormatError("failed reading health history", err)
+		return
+	}
+	// a trend already spans several runs, so it is not suppressed by the occurrence counter
+	for alertType, attrs := range trendAlerts {
+		al.sendAlert(alertType, attrs)
+	}
+}
+
 func (al *Alerting) CheckHealthResults(healthResults *HealthResults) {
 	healthResults.Lock.RLock()
 	defer healthResults.Lock.RUnlock()
-	al.payload = map[string]interface{}{}
 	suppressed := al.suppressedAlerts
 	// reset healthy
 	al.currentAlerts = map[AlertEntry]struct{}{}
@@ -433,6 +413,9 @@ func (al *Alerting) CheckHealthResults(healthResults *HealthResults) {
 	// check consumers vs reference
 	al.ConsumerAlerts(healthResults)
 
+	// check trends of the previous runs
+	al.TrendAlerts()
+
 	// delete alerts that are not active, reset recovery for those that are
 	keysToDelete := []AlertEntry{}
 	for alertEntry := range al.activeAlerts {
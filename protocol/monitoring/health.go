@@ -58,6 +58,7 @@ type SubscriptionData struct {
 	FullMonthsLeft               uint64
 	UsagePercentageLeftThisMonth float64
 	DurationLeft                 time.Duration
+	MonthCuLeft                  uint64
 }
 
 func RunHealth(ctx context.Context,
@@ -433,6 +434,7 @@ func checkSubscriptions(ctx context.Context, clientCtx client.Context, subscript
 					FullMonthsLeft:               fullMonthsLeft,
 					UsagePercentageLeftThisMonth: float64(response.Sub.MonthCuLeft) / float64(response.Sub.MonthCuTotal),
 					DurationLeft:                 time.Until(time.Unix(int64(response.Sub.MonthExpiryTime), 0)),
+					MonthCuLeft:                  response.Sub.MonthCuLeft,
 				})
 				break
 			}
@@ -35,6 +35,7 @@ const (
 	intervalFlagName                  = "interval"
 	consumerEndpointPropertyName      = "consumer_endpoints"
 	referenceEndpointPropertyName     = "reference_endpoints"
+	alertSinksPropertyName            = "alert_sinks"
 	allowedBlockTimeLagFlagName       = "allowed_time_lag"
 	queryRetriesFlagName              = "query-retries"
 	alertingWebHookFlagName           = "alert-webhook-url"
@@ -43,6 +44,12 @@ const (
 	alertSuppressionIntervalFlagName  = "alert-suppression-interval"
 	disableAlertSuppressionFlagName   = "disable-alert-suppression"
 	SuppressionCountThresholdFlagName = "suppression-alert-count-threshold"
+	healthHistoryPathFlagName         = "health-history-path"
+	healthHistoryRetentionFlagName    = "health-history-retention"
+	blockLagTrendCyclesFlagName       = "block-lag-trend-cycles"
+	latencyRegressionFactorFlagName   = "latency-regression-factor"
+	latencyBaselineWindowFlagName     = "latency-baseline-window"
+	subscriptionBurnRateFlagName      = "subscription-burn-rate-alert"
 )
 
 func ParseEndpoints(keyName string, viper_endpoints *viper.Viper) (endpoints []*lavasession.RPCEndpoint, err error) {
@@ -77,7 +84,13 @@ reference_endpoints:
       network-address: public-rpc-1
 	- chain-id: ETH1
       api-interface: jsonrpc
-      network-address: public-rpc-2`,
+      network-address: public-rpc-2
+alert_sinks:
+    - type: pagerduty
+      routing-key: <integration key>
+      severities: [critical]
+    - type: slack
+      url: https://hooks.slack.com/...`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -138,8 +151,27 @@ reference_endpoints:
 			healthMetrics := metrics.NewHealthMetrics(prometheusListenAddr)
 			identifier := viper.GetString(identifierFlagName)
 			utils.SetGlobalLoggingLevel(logLevel)
+			var alertSinks []AlertSinkConfig
+			err = viper.UnmarshalKey(alertSinksPropertyName, &alertSinks)
+			if err != nil {
+				utils.LavaFormatFatal("could not unmarshal alert sinks", err, utils.LogAttr("key", alertSinksPropertyName))
+			}
+			var healthHistory *HealthHistory
+			if historyPath := viper.GetString(healthHistoryPathFlagName); historyPath != "" {
+				healthHistory, err = NewHealthHistory(historyPath, viper.GetDuration(healthHistoryRetentionFlagName), TrendOptions{
+					BlockLagCycles:          viper.GetInt(blockLagTrendCyclesFlagName),
+					LatencyRegressionFactor: viper.GetFloat64(latencyRegressionFactorFlagName),
+					LatencyBaselineWindow:   viper.GetDuration(latencyBaselineWindowFlagName),
+					SubscriptionBurnRate:    viper.GetBool(subscriptionBurnRateFlagName),
+				})
+				if err != nil {
+					utils.LavaFormatFatal("failed opening health history", err)
+				}
+				defer healthHistory.Close()
+			}
 			alertingOptions := AlertingOptions{
 				Url:                           viper.GetString(alertingWebHookFlagName),
+				Sinks:                         alertSinks,
 				Logging:                       !viper.GetBool(DisableAlertLogging),
 				Identifier:                    identifier,
 				SubscriptionCUPercentageAlert: viper.GetFloat64(percentageCUFlagName),
@@ -149,6 +181,7 @@ reference_endpoints:
 				SameAlertInterval:             viper.GetDuration(alertSuppressionIntervalFlagName),
 				DisableAlertSuppression:       viper.GetBool(disableAlertSuppressionFlagName),
 				SuppressionCounterThreshold:   viper.GetUint64(SuppressionCountThresholdFlagName),
+				History:                       healthHistory,
 			}
 			alerting := NewAlerting(alertingOptions)
 			RunHealthCheck := func(ctx context.Context,
@@ -167,6 +200,13 @@ reference_endpoints:
 				} else {
 					utils.LavaFormatInfo("[+] completed health run")
 					healthMetrics.SetLatestBlockData(identifier, healthResult.FormatForLatestBlock())
+					healthMetrics.SetHealthResults(identifier, healthResult.FormatForMetrics())
+					if healthHistory != nil {
+						err = healthHistory.Record(NewHealthSnapshot(healthResult, time.Now()))
+						if err != nil {
+							utils.LavaFormatError("failed recording health history", err)
+						}
+					}
 					alerting.CheckHealthResults(healthResult)
 					activeAlerts, unhealthy, healthy := alerting.ActiveAlerts()
 					healthMetrics.SetSuccess(identifier)
@@ -208,6 +248,12 @@ reference_endpoints:
 	cmdTestHealth.Flags().Uint64(subscriptionLeftTimeFlagName, defaultSubscriptionLeftDays, "the amount of days left in a subscription to trigger an alert")
 	cmdTestHealth.Flags().Float64(percentageCUFlagName, defaultCUPercentageThreshold, "the left cu percentage threshold to trigger a subscription alert")
 	cmdTestHealth.Flags().String(identifierFlagName, "", "an identifier to this instance of health added to all alerts, used to differentiate different sources")
+	cmdTestHealth.Flags().String(healthHistoryPathFlagName, "", "a directory to keep the results of the health runs in, enables the trend alerts")
+	cmdTestHealth.Flags().Duration(healthHistoryRetentionFlagName, defaultHealthHistoryRetention, "how long the results of the health runs are kept")
+	cmdTestHealth.Flags().Int(blockLagTrendCyclesFlagName, defaultBlockLagTrendCycles, "alert when a provider's block lag grew in each of this many consecutive runs, 0 to disable")
+	cmdTestHealth.Flags().Float64(latencyRegressionFactorFlagName, defaultLatencyRegressionFactor, "alert when a provider's latency is this many times its baseline, 0 to disable")
+	cmdTestHealth.Flags().Duration(latencyBaselineWindowFlagName, defaultLatencyBaselineWindow, "the window of the runs the latency baseline and the subscription burn rate are computed on")
+	cmdTestHealth.Flags().Bool(subscriptionBurnRateFlagName, true, "alert when a subscription's cu burn rate projects it to run out before the month ends")
 	cmdTestHealth.Flags().String(alertingWebHookFlagName, "", "a url to post an alert to")
 	cmdTestHealth.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdTestHealth.Flags().Duration(intervalFlagName, intervalDefaultDuration, "the interval duration for the health check, (defaults to 0s) if 0 runs once")
@@ -221,6 +267,5 @@ reference_endpoints:
 	// add the ability to quiet it down
 	// add prometheus
 	// add health run times
-	// add latest health results to prom
 	return cmdTestHealth
 }
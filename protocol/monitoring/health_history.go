@@ -0,0 +1,289 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/utils"
+)
+
+// trend alerts, based on the health history
+const (
+	ProviderBlockLagTrendAttribute     = "provider_block_lag_trend_alert"
+	ProviderLatencyRegressionAttribute = "provider_latency_regression_alert"
+	SubscriptionBurnRateAttribute      = "subscription_burn_rate_alert"
+)
+
+const (
+	healthHistoryKeyPrefix         = "run/"
+	defaultHealthHistoryRetention  = 48 * time.Hour
+	defaultBlockLagTrendCycles     = 5
+	defaultLatencyRegressionFactor = 2.0
+	defaultLatencyBaselineWindow   = 24 * time.Hour
+	minLatencyBaselineSamples      = 3
+	sameMonthExpiryTolerance       = 10 * time.Minute
+)
+
+type ProviderSnapshot struct {
+	Entity   LavaEntity
+	BlockLag int64 // blocks behind the latest block of the spec
+	Latency  time.Duration
+}
+
+type SubscriptionSnapshot struct {
+	Address     string
+	MonthCuLeft uint64
+	MonthExpiry time.Time
+}
+
+// HealthSnapshot is the part of a health run's results kept in the history
+type HealthSnapshot struct {
+	Time          time.Time
+	Providers     []ProviderSnapshot // only the providers that responded
+	Subscriptions []SubscriptionSnapshot
+}
+
+func NewHealthSnapshot(healthResults *HealthResults, now time.Time) *HealthSnapshot {
+	healthResults.Lock.RLock()
+	defer healthResults.Lock.RUnlock()
+	snapshot := &HealthSnapshot{Time: now}
+	for entity, data := range healthResults.ProviderData {
+		if _, unhealthy := healthResults.UnhealthyProviders[entity]; unhealthy {
+			continue
+		}
+		blockLag := healthResults.LatestBlocks[entity.SpecId] - data.block
+		if blockLag < 0 {
+			blockLag = 0
+		}
+		snapshot.Providers = append(snapshot.Providers, ProviderSnapshot{Entity: entity, BlockLag: blockLag, Latency: data.latency})
+	}
+	for address, data := range healthResults.SubscriptionsData {
+		snapshot.Subscriptions = append(snapshot.Subscriptions, SubscriptionSnapshot{Address: address, MonthCuLeft: data.MonthCuLeft, MonthExpiry: now.Add(data.DurationLeft)})
+	}
+	return snapshot
+}
+
+type TrendOptions struct {
+	BlockLagCycles          int     // alert when a provider's block lag grew in each of the last cycles, 0 disables
+	LatencyRegressionFactor float64 // alert when a provider's latency is this many times its baseline, 0 disables
+	LatencyBaselineWindow   time.Duration
+	SubscriptionBurnRate    bool // alert when the subscription's cu will run out before the month ends
+}
+
+// HealthHistory persists the results of the health runs so alerts can be based on trends and not only on the latest run
+type HealthHistory struct {
+	db        *badger.DB
+	retention time.Duration
+	options   TrendOptions
+}
+
+func NewHealthHistory(path string, retention time.Duration, options TrendOptions) (*HealthHistory, error) {
+	badgerOptions := badger.DefaultOptions(path)
+	badgerOptions.Logger = nil
+	db, err := badger.Open(badgerOptions)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed opening health history", err, utils.LogAttr("path", path))
+	}
+	if retention == 0 {
+		retention = defaultHealthHistoryRetention
+	}
+	return &HealthHistory{db: db, retention: retention, options: options}, nil
+}
+
+func healthHistoryKey(runTime time.Time) []byte {
+	// zero padded so the keys are sorted by time
+	return []byte(fmt.Sprintf("%s%020d", healthHistoryKeyPrefix, runTime.UnixNano()))
+}
+
+func (hh *HealthHistory) Record(snapshot *HealthSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return hh.db.Update(func(txn *badger.Txn) error {
+		return txn.SetEntry(badger.NewEntry(healthHistoryKey(snapshot.Time), data).WithTTL(hh.retention))
+	})
+}
+
+// Snapshots returns the snapshots recorded since the given time, oldest first
+func (hh *HealthHistory) Snapshots(since time.Time) ([]*HealthSnapshot, error) {
+	snapshots := []*HealthSnapshot{}
+	err := hh.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(healthHistoryKeyPrefix)
+		for it.Seek(healthHistoryKey(since)); it.ValidForPrefix(prefix); it.Next() {
+			err := it.Item().Value(func(val []byte) error {
+				snapshot := &HealthSnapshot{}
+				err := json.Unmarshal(val, snapshot)
+				if err != nil {
+					return err
+				}
+				snapshots = append(snapshots, snapshot)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return snapshots, err
+}
+
+func (hh *HealthHistory) Close() error {
+	return hh.db.Close()
+}
+
+// TrendAlerts returns the alerts of the trend rules on the history, the latest snapshot is the current run
+func (hh *HealthHistory) TrendAlerts(now time.Time) (map[string][]AlertAttribute, error) {
+	window := hh.options.LatencyBaselineWindow
+	if window == 0 {
+		window = defaultLatencyBaselineWindow
+	}
+	snapshots, err := hh.Snapshots(now.Add(-window))
+	if err != nil {
+		return nil, err
+	}
+	return CheckTrends(snapshots, hh.options), nil
+}
+
+// CheckTrends applies the trend rules to snapshots sorted from oldest to latest
+func CheckTrends(snapshots []*HealthSnapshot, options TrendOptions) map[string][]AlertAttribute {
+	alerts := map[string][]AlertAttribute{}
+	if len(snapshots) == 0 {
+		return alerts
+	}
+	if options.BlockLagCycles > 0 {
+		if attrs := blockLagGrowingAlerts(snapshots, options.BlockLagCycles); len(attrs) > 0 {
+			alerts[ProviderBlockLagTrendAttribute] = attrs
+		}
+	}
+	if options.LatencyRegressionFactor > 0 {
+		if attrs := latencyRegressionAlerts(snapshots, options.LatencyRegressionFactor); len(attrs) > 0 {
+			alerts[ProviderLatencyRegressionAttribute] = attrs
+		}
+	}
+	if options.SubscriptionBurnRate {
+		if attrs := subscriptionBurnRateAlerts(snapshots); len(attrs) > 0 {
+			alerts[SubscriptionBurnRateAttribute] = attrs
+		}
+	}
+	return alerts
+}
+
+// providersHistory returns the snapshots of each provider of the latest snapshot, oldest first
+func providersHistory(snapshots []*HealthSnapshot) map[LavaEntity][]ProviderSnapshot {
+	latest := snapshots[len(snapshots)-1]
+	history := map[LavaEntity][]ProviderSnapshot{}
+	for _, provider := range latest.Providers {
+		history[provider.Entity] = nil
+	}
+	for _, snapshot := range snapshots {
+		for _, provider := range snapshot.Providers {
+			if providerHistory, ok := history[provider.Entity]; ok {
+				history[provider.Entity] = append(providerHistory, provider)
+			}
+		}
+	}
+	return history
+}
+
+func blockLagGrowingAlerts(snapshots []*HealthSnapshot, cycles int) []AlertAttribute {
+	if len(snapshots) < cycles+1 {
+		return nil
+	}
+	// the lag must grow between each of the last cycles runs, so a provider missing from one of them doesn't alert
+	recent := snapshots[len(snapshots)-cycles-1:]
+	attrs := []AlertAttribute{}
+	for entity, history := range providersHistory(recent) {
+		if len(history) != len(recent) {
+			continue
+		}
+		growing := true
+		lags := make([]string, 0, len(history))
+		for i, provider := range history {
+			lags = append(lags, utils.StrValue(provider.BlockLag))
+			if i > 0 && provider.BlockLag <= history[i-1].BlockLag {
+				growing = false
+			}
+		}
+		if growing {
+			attrs = append(attrs, AlertAttribute{entity: entity, data: "block lag growing: " + strings.Join(lags, " -> ")})
+		}
+	}
+	sortAlertAttributes(attrs)
+	return attrs
+}
+
+func latencyRegressionAlerts(snapshots []*HealthSnapshot, factor float64) []AlertAttribute {
+	attrs := []AlertAttribute{}
+	for entity, history := range providersHistory(snapshots) {
+		baselineSamples := history[:len(history)-1]
+		if len(baselineSamples) < minLatencyBaselineSamples {
+			continue
+		}
+		var sum time.Duration
+		for _, provider := range baselineSamples {
+			sum += provider.Latency
+		}
+		baseline := sum / time.Duration(len(baselineSamples))
+		current := history[len(history)-1].Latency
+		if baseline > 0 && float64(current) > float64(baseline)*factor {
+			attrs = append(attrs, AlertAttribute{entity: entity, data: fmt.Sprintf("latency regression: %s vs baseline %s", current, baseline)})
+		}
+	}
+	sortAlertAttributes(attrs)
+	return attrs
+}
+
+func subscriptionBurnRateAlerts(snapshots []*HealthSnapshot) []AlertAttribute {
+	latest := snapshots[len(snapshots)-1]
+	attrs := []AlertAttribute{}
+	for _, subscription := range latest.Subscriptions {
+		// the oldest sample of the same month is the most stable estimation of the burn rate
+		var first *SubscriptionSnapshot
+		var firstTime time.Time
+		for _, snapshot := range snapshots[:len(snapshots)-1] {
+			for i := range snapshot.Subscriptions {
+				sample := &snapshot.Subscriptions[i]
+				sameMonth := sample.MonthExpiry.Sub(subscription.MonthExpiry).Abs() < sameMonthExpiryTolerance
+				if sample.Address == subscription.Address && sameMonth && sample.MonthCuLeft >= subscription.MonthCuLeft {
+					first, firstTime = sample, snapshot.Time
+					break
+				}
+			}
+			if first != nil {
+				break
+			}
+		}
+		if first == nil || first.MonthCuLeft == subscription.MonthCuLeft {
+			continue
+		}
+		elapsed := latest.Time.Sub(firstTime)
+		if elapsed <= 0 {
+			continue
+		}
+		burnRate := float64(first.MonthCuLeft-subscription.MonthCuLeft) / float64(elapsed) // cu per nanosecond
+		timeToExhaustion := time.Duration(float64(subscription.MonthCuLeft) / burnRate)
+		timeLeft := subscription.MonthExpiry.Sub(latest.Time)
+		if timeToExhaustion < timeLeft {
+			attrs = append(attrs, AlertAttribute{
+				entity: LavaEntity{Address: subscription.Address},
+				data:   fmt.Sprintf("cu projected to run out in %s, month ends in %s", timeToExhaustion.Round(time.Minute), timeLeft.Round(time.Minute)),
+			})
+		}
+	}
+	sortAlertAttributes(attrs)
+	return attrs
+}
+
+func sortAlertAttributes(attrs []AlertAttribute) {
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].entity.String() < attrs[j].entity.String()
+	})
+}
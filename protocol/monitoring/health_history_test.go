@@ -0,0 +1,98 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTrends(t *testing.T) {
+	now := time.Now()
+	growing := LavaEntity{Address: "lava@growing", SpecId: "LAV1", ApiInterface: "rest"}
+	stable := LavaEntity{Address: "lava@stable", SpecId: "LAV1", ApiInterface: "rest"}
+	monthExpiry := now.Add(10 * 24 * time.Hour)
+
+	snapshots := []*HealthSnapshot{}
+	for i := 0; i < 4; i++ {
+		snapshots = append(snapshots, &HealthSnapshot{
+			Time: now.Add(time.Duration(i-3) * time.Hour),
+			Providers: []ProviderSnapshot{
+				{Entity: growing, BlockLag: int64(i), Latency: 10 * time.Millisecond},
+				{Entity: stable, BlockLag: 1, Latency: 10 * time.Millisecond},
+			},
+			Subscriptions: []SubscriptionSnapshot{
+				// burns 1000 cu an hour, 30000 cu left run out in 30 hours, before the month ends
+				{Address: "lava@burning", MonthCuLeft: uint64(33000 - i*1000), MonthExpiry: monthExpiry},
+				// burns 10 cu an hour, enough for the rest of the month
+				{Address: "lava@slow", MonthCuLeft: uint64(33000 - i*10), MonthExpiry: monthExpiry},
+			},
+		})
+	}
+	// the latest run has a latency regression of the stable provider
+	snapshots[3].Providers[1].Latency = 50 * time.Millisecond
+
+	alerts := CheckTrends(snapshots, TrendOptions{BlockLagCycles: 3, LatencyRegressionFactor: 2, SubscriptionBurnRate: true})
+	require.Len(t, alerts, 3)
+	require.Len(t, alerts[ProviderBlockLagTrendAttribute], 1)
+	require.Equal(t, growing, alerts[ProviderBlockLagTrendAttribute][0].entity)
+	require.Equal(t, "block lag growing: 0 -> 1 -> 2 -> 3", alerts[ProviderBlockLagTrendAttribute][0].data)
+	require.Len(t, alerts[ProviderLatencyRegressionAttribute], 1)
+	require.Equal(t, stable, alerts[ProviderLatencyRegressionAttribute][0].entity)
+	require.Len(t, alerts[SubscriptionBurnRateAttribute], 1)
+	require.Equal(t, "lava@burning", alerts[SubscriptionBurnRateAttribute][0].entity.Address)
+
+	// not enough cycles for the block lag trend, not enough samples for the latency baseline
+	alerts = CheckTrends(snapshots[2:], TrendOptions{BlockLagCycles: 3, LatencyRegressionFactor: 2})
+	require.Empty(t, alerts)
+}
+
+func TestHealthHistoryRecord(t *testing.T) {
+	history, err := NewHealthHistory(t.TempDir(), time.Hour, TrendOptions{BlockLagCycles: 2})
+	require.NoError(t, err)
+	defer history.Close()
+
+	now := time.Now()
+	provider := LavaEntity{Address: "lava@provider", SpecId: "LAV1", ApiInterface: "rest"}
+	for i := 0; i < 3; i++ {
+		healthResults := &HealthResults{
+			LatestBlocks:       map[string]int64{"LAV1": 100},
+			ProviderData:       map[LavaEntity]ReplyData{provider: {block: int64(100 - i), latency: time.Millisecond}},
+			SubscriptionsData:  map[string]SubscriptionData{},
+			UnhealthyProviders: map[LavaEntity]string{},
+		}
+		require.NoError(t, history.Record(NewHealthSnapshot(healthResults, now.Add(time.Duration(i-2)*time.Minute))))
+	}
+
+	snapshots, err := history.Snapshots(now.Add(-90 * time.Second))
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, int64(1), snapshots[0].Providers[0].BlockLag)
+
+	alerts, err := history.TrendAlerts(now)
+	require.NoError(t, err)
+	require.Len(t, alerts[ProviderBlockLagTrendAttribute], 1)
+}
+
+func TestTrendAlertsBypassOccurrenceSuppression(t *testing.T) {
+	history, err := NewHealthHistory(t.TempDir(), time.Hour, TrendOptions{BlockLagCycles: 2})
+	require.NoError(t, err)
+	defer history.Close()
+
+	now := time.Now()
+	provider := LavaEntity{Address: "lava@provider", SpecId: "LAV1", ApiInterface: "rest"}
+	for i := 0; i < 3; i++ {
+		healthResults := &HealthResults{
+			LatestBlocks: map[string]int64{"LAV1": 100},
+			ProviderData: map[LavaEntity]ReplyData{provider: {block: int64(100 - i), latency: time.Millisecond}},
+		}
+		require.NoError(t, history.Record(NewHealthSnapshot(healthResults, now.Add(time.Duration(i-2)*time.Minute))))
+	}
+
+	server := newRecordingServer(t)
+	al := NewAlerting(AlertingOptions{Url: server.URL, SuppressionCounterThreshold: 3, History: history})
+	// the trend is sent on the first run, even though the occurrence threshold is higher
+	al.TrendAlerts()
+	al.SendAppendedAlerts()
+	require.Len(t, server.Bodies(), 1)
+}
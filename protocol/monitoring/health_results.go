@@ -6,6 +6,7 @@ import (
 
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/utils/slices"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -41,6 +42,67 @@ func (healthResults *HealthResults) FormatForLatestBlock() map[string]uint64 {
 	return results
 }
 
+// FormatForMetrics returns the full results for the prometheus exporter
+func (healthResults *HealthResults) FormatForMetrics() *metrics.HealthResultsData {
+	healthResults.Lock.RLock()
+	defer healthResults.Lock.RUnlock()
+	results := &metrics.HealthResultsData{}
+	toHealthEntity := func(entity LavaEntity) metrics.HealthEntity {
+		return metrics.HealthEntity{Address: entity.Address, SpecId: entity.SpecId, ApiInterface: entity.ApiInterface}
+	}
+	blockLag := func(specId string, block int64) int64 {
+		if lag := healthResults.LatestBlocks[specId] - block; lag > 0 {
+			return lag
+		}
+		return 0
+	}
+
+	providers := map[LavaEntity]*metrics.ProviderHealth{}
+	getProvider := func(entity LavaEntity) *metrics.ProviderHealth {
+		provider, ok := providers[entity]
+		if !ok {
+			provider = &metrics.ProviderHealth{HealthEntity: toHealthEntity(entity)}
+			providers[entity] = provider
+		}
+		return provider
+	}
+	for entity, data := range healthResults.ProviderData {
+		provider := getProvider(entity)
+		provider.Healthy = true
+		provider.Latency = data.latency
+		provider.BlockLag = blockLag(entity.SpecId, data.block)
+	}
+	for entity := range healthResults.UnhealthyProviders {
+		getProvider(entity).Healthy = false
+	}
+	for entity := range healthResults.FrozenProviders {
+		getProvider(entity).Frozen = true
+	}
+	for _, provider := range providers {
+		results.Providers = append(results.Providers, *provider)
+	}
+
+	for entity, block := range healthResults.ConsumerBlocks {
+		_, unhealthy := healthResults.UnhealthyConsumers[entity]
+		consumer := metrics.ConsumerHealth{HealthEntity: toHealthEntity(entity), Healthy: !unhealthy}
+		if consumer.Healthy {
+			consumer.BlockLag = blockLag(entity.SpecId, block)
+		}
+		results.Consumers = append(results.Consumers, consumer)
+	}
+
+	for address, data := range healthResults.SubscriptionsData {
+		results.Subscriptions = append(results.Subscriptions, metrics.SubscriptionHealth{
+			Address:          address,
+			MonthsLeft:       data.FullMonthsLeft,
+			CuLeft:           data.MonthCuLeft,
+			CuLeftPercentage: data.UsagePercentageLeftThisMonth,
+			TimeLeft:         data.DurationLeft,
+		})
+	}
+	return results
+}
+
 func (healthResults *HealthResults) GetAllEntities() map[LavaEntity]struct{} {
 	healthResults.Lock.RLock()
 	defer healthResults.Lock.RUnlock()
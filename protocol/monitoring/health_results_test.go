@@ -0,0 +1,36 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthResultsFormatForMetrics(t *testing.T) {
+	healthy := LavaEntity{Address: "lava@healthy", SpecId: "LAV1", ApiInterface: "rest"}
+	unhealthy := LavaEntity{Address: "lava@unhealthy", SpecId: "LAV1", ApiInterface: "rest"}
+	consumer := LavaEntity{Address: "127.0.0.1:3333", SpecId: "LAV1", ApiInterface: "rest"}
+	healthResults := &HealthResults{
+		LatestBlocks:       map[string]int64{"LAV1": 100},
+		ProviderData:       map[LavaEntity]ReplyData{healthy: {block: 95, latency: 20 * time.Millisecond}},
+		ConsumerBlocks:     map[LavaEntity]int64{consumer: 100},
+		SubscriptionsData:  map[string]SubscriptionData{"lava@sub": {FullMonthsLeft: 2, UsagePercentageLeftThisMonth: 0.5, MonthCuLeft: 1000, DurationLeft: time.Hour}},
+		FrozenProviders:    map[LavaEntity]struct{}{unhealthy: {}},
+		UnhealthyProviders: map[LavaEntity]string{unhealthy: "timeout"},
+		UnhealthyConsumers: map[LavaEntity]string{},
+	}
+
+	results := healthResults.FormatForMetrics()
+	require.ElementsMatch(t, []metrics.ProviderHealth{
+		{HealthEntity: metrics.HealthEntity{Address: "lava@healthy", SpecId: "LAV1", ApiInterface: "rest"}, Healthy: true, Latency: 20 * time.Millisecond, BlockLag: 5},
+		{HealthEntity: metrics.HealthEntity{Address: "lava@unhealthy", SpecId: "LAV1", ApiInterface: "rest"}, Frozen: true},
+	}, results.Providers)
+	require.Equal(t, []metrics.ConsumerHealth{
+		{HealthEntity: metrics.HealthEntity{Address: "127.0.0.1:3333", SpecId: "LAV1", ApiInterface: "rest"}, Healthy: true},
+	}, results.Consumers)
+	require.Equal(t, []metrics.SubscriptionHealth{
+		{Address: "lava@sub", MonthsLeft: 2, CuLeft: 1000, CuLeftPercentage: 0.5, TimeLeft: time.Hour},
+	}, results.Subscriptions)
+}
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// jsonPathStep is a single key or index access of a json path
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseJsonPathExpression splits a JSONPath-like expression into steps, the supported syntax is a subset of JSONPath:
+// an optional "$" root, ".key" and "['key']" for object fields and "[index]" for arrays (negative indexes count from the end)
+// for example: $[0].filter.fromBlock or $.block['last_commit'].height
+func parseJsonPathExpression(expression string) ([]jsonPathStep, error) {
+	expression = strings.TrimSpace(expression)
+	path := expression
+	steps := []jsonPathStep{}
+	if strings.HasPrefix(path, "$") {
+		path = path[1:]
+		if len(path) > 0 && path[0] != '.' && path[0] != '[' {
+			return nil, fmt.Errorf("unexpected character %q after the root in json path %s", path[0], expression)
+		}
+	} else if len(path) > 0 && path[0] != '.' && path[0] != '[' {
+		// a path can start with a key without the root, e.g. filter.fromBlock
+		path = "." + path
+	}
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end == -1 {
+				end = len(path)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty key in json path %s", expression)
+			}
+			steps = append(steps, jsonPathStep{key: path[:end]})
+			path = path[end:]
+		case '[':
+			end := strings.Index(path, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed bracket in json path %s", expression)
+			}
+			inner := strings.TrimSpace(path[1:end])
+			path = path[end+1:]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				steps = append(steps, jsonPathStep{key: inner[1 : len(inner)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %s in json path %s", inner, expression)
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+		default:
+			return nil, fmt.Errorf("unexpected character %q in json path %s", path[0], expression)
+		}
+	}
+	return steps, nil
+}
+
+// evaluateJsonPath walks the unmarshalled json data along the steps, a missing key or index returns ValueNotSetError
+func evaluateJsonPath(data interface{}, steps []jsonPathStep) (interface{}, error) {
+	current := data
+	for _, step := range steps {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			if step.isIndex {
+				// objects with numeric keys are addressed by index as well, like dictionary parsing does
+				step.key = strconv.Itoa(step.index)
+			}
+			value, ok := typed[step.key]
+			if !ok {
+				return nil, ValueNotSetError
+			}
+			current = value
+		case []interface{}:
+			if !step.isIndex {
+				return nil, fmt.Errorf("can't get field %s of an array", step.key)
+			}
+			index := step.index
+			if index < 0 {
+				index += len(typed)
+			}
+			if index < 0 || index >= len(typed) {
+				return nil, ValueNotSetError
+			}
+			current = typed[index]
+		case nil:
+			return nil, ValueNotSetError
+		default:
+			return nil, fmt.Errorf("can't traverse into %v of type %T", current, current)
+		}
+	}
+	if current == nil {
+		return nil, ValueNotSetError
+	}
+	return current, nil
+}
+
+// parseJsonPath returns the value at a JSONPath-like expression, evaluated on the params or on the result
+// expected input is [json path], for example: PARAMS: [{"filter":{"fromBlock":<#BlockNum>}}] args: "$[0].filter.fromBlock"
+// a value missing from the params of an input with a body (a rest POST request) is looked for in the body
+func parseJsonPath(rpcInput RPCInput, input []string, dataSource int) ([]interface{}, error) {
+	if len(input) != 1 {
+		return nil, utils.LavaFormatProduction("invalid input format, input length", nil, utils.Attribute{Key: "input_len", Value: strconv.Itoa(len(input))})
+	}
+	steps, err := parseJsonPathExpression(input[0])
+	if err != nil {
+		return nil, utils.LavaFormatProduction("invalid input format, input isn't a valid json path", err, utils.Attribute{Key: "input", Value: input[0]})
+	}
+
+	var data interface{}
+	switch dataSource {
+	case PARSE_PARAMS:
+		data = rpcInput.GetParams()
+	case PARSE_RESULT:
+		result := rpcInput.GetResult()
+		if len(result) == 0 {
+			return nil, fmt.Errorf("parseJsonPath failure Get.Result is empty")
+		}
+		err = json.Unmarshal(result, &data)
+		if err != nil {
+			return nil, utils.LavaFormatProduction("invalid input format, result is not json", err, utils.Attribute{Key: "result", Value: CapStringLen(string(result))})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported block parser data source")
+	}
+
+	value, err := evaluateJsonPath(data, steps)
+	if err == ValueNotSetError && dataSource == PARSE_PARAMS {
+		if body := getJsonBody(rpcInput); body != nil {
+			value, err = evaluateJsonPath(body, steps)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return appendInterfaceToInterfaceArray(blockInterfaceToString(value)), nil
+}
+
+// getJsonBody returns the unmarshalled body of the input, nil if it has no json body
+func getJsonBody(rpcInput RPCInput) interface{} {
+	inputWithBody, ok := rpcInput.(RPCInputWithBody)
+	if !ok {
+		return nil
+	}
+	body := inputWithBody.GetBody()
+	if len(body) == 0 {
+		return nil
+	}
+	var data interface{}
+	err := json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -9,7 +9,10 @@ import (
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/slices"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -28,6 +31,11 @@ type RPCInput interface {
 	GetHeaders() []pairingtypes.Metadata
 }
 
+// RPCInputWithBody is implemented by inputs that have a json body besides their params, like rest POST requests
+type RPCInputWithBody interface {
+	GetBody() []byte
+}
+
 func ParseDefaultBlockParameter(block string) (int64, error) {
 	switch block {
 	case "latest":
@@ -66,6 +74,70 @@ func ParseBlockFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser)
 	return rpcInput.ParseBlock(resString)
 }
 
+// ParseBlockOrHashFromParams is ParseBlockFromParams for apis that can address the block by its hash,
+// a block hash or an EIP-1898 object ({"blockHash": ...}) is returned as blockHash with a NOT_APPLICABLE block so the caller can resolve it
+func ParseBlockOrHashFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser) (block int64, blockHash string, err error) {
+	result, err := parse(rpcInput, blockParser, PARSE_PARAMS)
+	if err != nil || result == nil {
+		return spectypes.NOT_APPLICABLE, "", err
+	}
+	resString, ok := result[0].(string)
+	if !ok {
+		return spectypes.NOT_APPLICABLE, "", fmt.Errorf("ParseBlockOrHashFromParams - result[0].(string) - type assertion failed, type:" + fmt.Sprintf("%s", result[0]))
+	}
+	block, err = rpcInput.ParseBlock(resString)
+	if err == nil {
+		return block, "", nil
+	}
+	if strings.HasPrefix(resString, "{") {
+		// EIP-1898 block parameter, addressing the block by number or by hash
+		var blockObject map[string]interface{}
+		if json.Unmarshal([]byte(resString), &blockObject) == nil {
+			if blockNumber, ok := blockObject["blockNumber"]; ok {
+				block, err = ParseDefaultBlockParameter(blockInterfaceToString(blockNumber))
+				return block, "", err
+			}
+			if hash, ok := blockObject["blockHash"]; ok {
+				resString = blockInterfaceToString(hash)
+			}
+		}
+	}
+	if IsBlockHash(resString) {
+		return spectypes.NOT_APPLICABLE, resString, nil
+	}
+	return spectypes.NOT_APPLICABLE, "", err
+}
+
+// IsBlockHash returns true for a 32 bytes hash, hex (with or without 0x) or base64 encoded
+func IsBlockHash(blockHash string) bool {
+	return len(blockHashBytes(blockHash)) == 32
+}
+
+func blockHashBytes(blockHash string) []byte {
+	hexString := strings.TrimPrefix(strings.TrimPrefix(blockHash, "0x"), "0X")
+	if len(hexString) == 64 {
+		if decoded, err := hex.DecodeString(hexString); err == nil {
+			return decoded
+		}
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(blockHash); err == nil {
+		return decoded
+	}
+	return nil
+}
+
+// BlockHashEncodings returns the encodings a block hash can be stored in, hex in both cases with and without 0x and base64,
+// base64 is how hex hashes are stored after parsing a reply with the hex encoding
+func BlockHashEncodings(blockHash string) []string {
+	hashBytes := blockHashBytes(blockHash)
+	if hashBytes == nil {
+		return []string{blockHash}
+	}
+	hexString := hex.EncodeToString(hashBytes)
+	encodings := []string{blockHash, hexString, strings.ToUpper(hexString), "0x" + hexString, base64.StdEncoding.EncodeToString(hashBytes)}
+	return slices.Union(encodings)
+}
+
 // This returns the parsed response without decoding
 func ParseFromReply(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
 	result, err := parse(rpcInput, blockParser, PARSE_RESULT)
@@ -123,6 +195,8 @@ func parse(rpcInput RPCInput, blockParser spectypes.BlockParser, dataSource int)
 		retval, err = parseDictionaryOrOrdered(rpcInput, blockParser.ParserArg, dataSource)
 	case spectypes.PARSER_FUNC_DEFAULT:
 		retval = parseDefault(rpcInput, blockParser.ParserArg, dataSource)
+	case spectypes.PARSER_FUNC_PARSE_JSON_PATH:
+		retval, err = parseJsonPath(rpcInput, blockParser.ParserArg, dataSource)
 	default:
 		return nil, fmt.Errorf("unsupported block parser parserFunc")
 	}
@@ -161,11 +235,45 @@ func parseResponseByEncoding(rawResult []byte, encoding string) (string, error)
 			return "", utils.LavaFormatError("tried decoding a hex response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: hexString})
 		}
 		return base64.StdEncoding.EncodeToString(hexBytes), nil
+	case spectypes.EncodingBech32:
+		dataBytes, err := decodeBech32(string(rawResult))
+		if err != nil {
+			return "", utils.LavaFormatError("tried decoding a bech32 response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: string(rawResult)})
+		}
+		return base64.StdEncoding.EncodeToString(dataBytes), nil
+	case spectypes.EncodingBase58:
+		dataBytes, err := decodeBase58(string(rawResult))
+		if err != nil {
+			return "", utils.LavaFormatError("tried decoding a base58 response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: string(rawResult)})
+		}
+		return base64.StdEncoding.EncodeToString(dataBytes), nil
 	default:
 		return string(rawResult), nil
 	}
 }
 
+// decodeBech32 returns the data part of a bech32 string, the human readable prefix is dropped
+func decodeBech32(encoded string) ([]byte, error) {
+	_, data, err := bech32.DecodeAndConvert(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// decodeBase58 decodes a bitcoin alphabet base58 string (used by solana for hashes)
+func decodeBase58(encoded string) ([]byte, error) {
+	if encoded == "" {
+		return nil, fmt.Errorf("empty base58 string")
+	}
+	data := base58.Decode(encoded)
+	// the decoder returns an empty result for invalid characters
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid base58 string %s", encoded)
+	}
+	return data, nil
+}
+
 // Move to RPCInput
 func getDataToParse(rpcInput RPCInput, dataSource int) (interface{}, error) {
 	switch dataSource {
@@ -203,6 +311,13 @@ func blockInterfaceToString(block interface{}) string {
 		return strconv.FormatInt(castedBlock, 10)
 	case uint64:
 		return strconv.FormatUint(castedBlock, 10)
+	case map[string]interface{}:
+		// objects are kept as json so they can still be parsed, e.g. an EIP-1898 block parameter
+		encoded, err := json.Marshal(castedBlock)
+		if err != nil {
+			return fmt.Sprintf("%s", block)
+		}
+		return string(encoded)
 	default:
 		return fmt.Sprintf("%s", block)
 	}
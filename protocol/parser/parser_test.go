@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -14,6 +15,7 @@ import (
 type RPCInputTest struct {
 	Params         interface{}
 	Result         json.RawMessage
+	Body           []byte
 	Headers        []pairingtypes.Metadata
 	ParseBlockFunc func(block string) (int64, error)
 	GetHeadersFunc func() []pairingtypes.Metadata
@@ -27,6 +29,10 @@ func (rpcInputTest *RPCInputTest) GetResult() json.RawMessage {
 	return rpcInputTest.Result
 }
 
+func (rpcInputTest *RPCInputTest) GetBody() []byte {
+	return rpcInputTest.Body
+}
+
 func (rpcInputTest *RPCInputTest) ParseBlock(block string) (int64, error) {
 	if rpcInputTest.ParseBlockFunc == nil {
 		return ParseDefaultBlockParameter(block)
@@ -144,6 +150,18 @@ func TestParseResponseByEncoding(t *testing.T) {
 	// returned form evmos evm-jsonrpc vs rest
 	testData = []data{{bytes: []byte("0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"), encoding: spectypes.EncodingHex}, {bytes: []byte("lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag="), encoding: spectypes.EncodingBase64}}
 	testInputs(testData)
+	// the same hash base58 encoded, as returned by solana
+	testData = []data{{bytes: []byte("As9asU7fJqHDX7affXL5aKCpPRZ4sRi6ukWRZbj8CsUG"), encoding: spectypes.EncodingBase58}, {bytes: []byte("kpHtwDauJU+abgI38O8TxFLn8Ici6NvWiy80zIEyyR0="), encoding: spectypes.EncodingBase64}}
+	testInputs(testData)
+	// and bech32 encoded
+	testData = []data{{bytes: []byte("lava@1j2g7mspk4cj5lxnwqgmlpmcnc3fw0uy8yt5dh45t9u6veqfjeyws4t7ju3"), encoding: spectypes.EncodingBech32}, {bytes: []byte("9291EDC036AE254F9A6E0237F0EF13C452E7F08722E8DBD68B2F34CC8132C91D"), encoding: spectypes.EncodingHex}}
+	testInputs(testData)
+
+	// invalid characters
+	_, err := parseResponseByEncoding([]byte("0OIl"), spectypes.EncodingBase58)
+	require.Error(t, err)
+	_, err = parseResponseByEncoding([]byte("lava@1j2g7mspk4cj5lxnwqgmlpmcnc3fw0uy8yt5dh45t9u6veqfjeyws4t7ju4"), spectypes.EncodingBech32)
+	require.Error(t, err)
 }
 
 func TestParseBlockHappyFlow(t *testing.T) {
@@ -334,6 +352,68 @@ func TestParseBlockFromParamsHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 103,
 		},
+		{
+			name: "ParseJsonPath__Nested__Case",
+			message: RPCInputTest{
+				Params: []interface{}{
+					map[string]interface{}{"filter": map[string]interface{}{"fromBlock": "0x10", "toBlock": "0x20"}},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$[0].filter.fromBlock"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			},
+			expectedBlock: 16,
+		},
+		{
+			name: "ParseJsonPath__Dictionary__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{
+					"block": map[string]interface{}{"heights": []interface{}{float64(5), float64(7)}},
+				},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"block['heights'][-1]"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			},
+			expectedBlock: 7,
+		},
+		{
+			name: "ParseJsonPath__DefaultValue__Case",
+			message: RPCInputTest{
+				Params: []interface{}{map[string]interface{}{"filter": map[string]interface{}{}}},
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:    []string{"$[0].filter.fromBlock"},
+				ParserFunc:   spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+				DefaultValue: "latest",
+			},
+			expectedBlock: spectypes.LATEST_BLOCK,
+		},
+		{
+			name: "ParseJsonPath__Body__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{"chain": "osmosis"},
+				Body:   []byte(`{"query":{"height":"42"}}`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$.query.height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			},
+			expectedBlock: 42,
+		},
+		{
+			name: "ParseJsonPath__ParamsBeforeBody__Case",
+			message: RPCInputTest{
+				Params: map[string]interface{}{"height": "7"},
+				Body:   []byte(`{"height":"42"}`),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$.height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			},
+			expectedBlock: 7,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -394,6 +474,19 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 			},
 			expectedBlock: 25,
 		},
+		{
+			name: "ParseJsonPath",
+			message: RPCInputTest{
+				Result: []byte(
+					`{"block": {"header": {"height": "42"}}}`,
+				),
+			},
+			blockParser: spectypes.BlockParser{
+				ParserArg:  []string{"$.block.header.height"},
+				ParserFunc: spectypes.PARSER_FUNC_PARSE_JSON_PATH,
+			},
+			expectedBlock: 42,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -404,3 +497,67 @@ func TestParseBlockFromReplyHappyFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJsonPathErrors(t *testing.T) {
+	message := &RPCInputTest{
+		Params: []interface{}{map[string]interface{}{"filter": map[string]interface{}{"fromBlock": "0x10"}}},
+	}
+	for _, expression := range []string{"$[0", "$[a]", "$[0]..fromBlock", "$filter", "$[0]filter"} {
+		_, err := parseJsonPath(message, []string{expression}, PARSE_PARAMS)
+		require.Error(t, err, expression)
+	}
+
+	_, err := parseJsonPath(message, []string{"$[1].filter.fromBlock"}, PARSE_PARAMS)
+	require.True(t, ValueNotSetError.Is(err))
+	_, err = parseJsonPath(message, []string{"$[0].filter.toBlock"}, PARSE_PARAMS)
+	require.True(t, ValueNotSetError.Is(err))
+	_, err = parseJsonPath(message, []string{"$.filter"}, PARSE_PARAMS)
+	require.Error(t, err)
+}
+
+func TestParseBlockOrHashFromParams(t *testing.T) {
+	const blockHash = "0x9291EDC036AE2A4FCD6E0237F0EF13C452E7F0872E8B6F7569B3A8097A8DC91D"
+	blockParser := spectypes.BlockParser{
+		ParserArg:  []string{"1"},
+		ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG,
+	}
+	testCases := []struct {
+		name          string
+		params        interface{}
+		expectedBlock int64
+		expectedHash  string
+	}{
+		{name: "number", params: []interface{}{"0x0", "0x10"}, expectedBlock: 16},
+		{name: "tag", params: []interface{}{"0x0", "latest"}, expectedBlock: spectypes.LATEST_BLOCK},
+		{name: "hash", params: []interface{}{"0x0", blockHash}, expectedBlock: spectypes.NOT_APPLICABLE, expectedHash: blockHash},
+		{name: "EIP-1898 hash", params: []interface{}{"0x0", map[string]interface{}{"blockHash": blockHash, "requireCanonical": true}}, expectedBlock: spectypes.NOT_APPLICABLE, expectedHash: blockHash},
+		{name: "EIP-1898 number", params: []interface{}{"0x0", map[string]interface{}{"blockNumber": "0x20"}}, expectedBlock: 32},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			block, hash, err := ParseBlockOrHashFromParams(&RPCInputTest{Params: testCase.params}, blockParser)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedBlock, block)
+			require.Equal(t, testCase.expectedHash, hash)
+		})
+	}
+
+	_, _, err := ParseBlockOrHashFromParams(&RPCInputTest{Params: []interface{}{"0x0", "0x123z"}}, blockParser)
+	require.Error(t, err)
+}
+
+func TestBlockHashEncodings(t *testing.T) {
+	const hexHash = "9291edc036ae2a4fcd6e0237f0ef13c452e7f0872e8b6f7569b3a8097a8dc91d"
+	const base64Hash = "kpHtwDauKk/NbgI38O8TxFLn8Icui291abOoCXqNyR0="
+	require.True(t, IsBlockHash("0x"+hexHash))
+	require.True(t, IsBlockHash(base64Hash))
+	require.False(t, IsBlockHash("0x10"))
+	require.False(t, IsBlockHash("latest"))
+
+	for _, blockHash := range []string{"0x" + hexHash, strings.ToUpper(hexHash), base64Hash} {
+		encodings := BlockHashEncodings(blockHash)
+		require.Contains(t, encodings, blockHash)
+		require.Subset(t, encodings, []string{hexHash, strings.ToUpper(hexHash), "0x" + hexHash, base64Hash})
+	}
+	require.Equal(t, []string{"latest"}, BlockHashEncodings("latest"))
+}
@@ -6,7 +6,9 @@ import (
 
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type Cache struct {
@@ -48,6 +50,24 @@ func (cache *Cache) GetEntry(ctx context.Context, relayCacheGet *pairingtypes.Re
 	return cache.client.GetRelay(ctx, relayCacheGet)
 }
 
+// IsCacheMiss returns whether a GetEntry error is a cache miss (the cache server didn't have a
+// matching entry, or the cache is not used) rather than a failure to query the cache, a nil error is a hit
+func IsCacheMiss(err error) bool {
+	if err == nil {
+		return false
+	}
+	if NotInitialisedError.Is(err) {
+		return true
+	}
+	if NotConnectedError.Is(err) {
+		return false
+	}
+	// errors returned by the cache server handlers (entry not found, hash mismatch) reach the
+	// client with the Unknown code, while connection errors have their own codes
+	grpcStatus, ok := status.FromError(err)
+	return ok && grpcStatus.Code() == codes.Unknown
+}
+
 func (cache *Cache) CacheActive() bool {
 	return cache != nil
 }
@@ -0,0 +1,32 @@
+package performance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsCacheMiss(t *testing.T) {
+	templates := []struct {
+		name string
+		err  error
+		miss bool
+	}{
+		{"cache hit", nil, false},
+		{"cache not initialised", NotInitialisedError, true},
+		{"cache not connected", NotConnectedError.Wrapf("No client connected to address: %s", "localhost"), false},
+		{"entry not found", status.Error(codes.Unknown, "cache entry for specific block and request wasn't found"), true},
+		{"cache unavailable", status.Error(codes.Unavailable, "connection refused"), false},
+		{"deadline exceeded", status.Error(codes.DeadlineExceeded, "context deadline exceeded"), false},
+		{"non grpc error", fmt.Errorf("failed"), false},
+	}
+
+	for _, tt := range templates {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.miss, IsCacheMiss(tt.err))
+		})
+	}
+}
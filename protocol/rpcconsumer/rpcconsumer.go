@@ -114,6 +114,7 @@ type rpcConsumerStartOptions struct {
 	analyticsServerAddressess AnalyticsServerAddressess
 	cmdFlags                  common.ConsumerCmdFlags
 	stateShare                bool
+	accessLogger              *metrics.AccessLogger
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
@@ -129,6 +130,7 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 	if err != nil {
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err)
 	}
+	rpcConsumerMetrics.SetAccessLogger(options.accessLogger)
 	consumerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ConsumerVersion)
 
 	// spawn up ConsumerStateTracker
@@ -275,6 +277,8 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 				errCh <- err
 				return err
 			}
+			// requests addressing a block by hash are resolved with the finalized hashes the providers report
+			chainParser.SetBlockHashResolver(finalizationConsensus)
 
 			// Register For Updates
 			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, consumerMetricsManager)
@@ -512,8 +516,20 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				RelaysHealthIntervalFlag: viper.GetDuration(common.RelayHealthIntervalFlag),
 			}
 
+			shutdownTracing, err := metrics.InitTracingFromFlags(ctx, "rpcconsumer")
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing(context.Background())
+
+			accessLogger, err := metrics.NewAccessLoggerFromFlags()
+			if err != nil {
+				return err
+			}
+			defer accessLogger.Close()
+
 			rpcConsumerSharedState := viper.GetBool(common.SharedStateFlag)
-			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState})
+			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState, accessLogger})
 			return err
 		},
 	}
@@ -532,6 +548,8 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s)", strings.Join(strategyNames, "|")))
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdRPCConsumer.Flags().String(metrics.RelayServerFlagName, metrics.DisabledFlagOption, "the http address of the relay usage server api endpoint (example http://127.0.0.1:8080)")
+	metrics.AddTracingFlags(cmdRPCConsumer)
+	metrics.AddAccessLogFlags(cmdRPCConsumer)
 	cmdRPCConsumer.Flags().BoolVar(&DebugRelaysFlag, DebugRelaysFlagName, false, "adding debug information to relays")
 	// CORS related flags
 	cmdRPCConsumer.Flags().String(common.CorsCredentialsFlag, "true", "Set up CORS allowed credentials,default \"true\"")
@@ -3,6 +3,7 @@ package rpcconsumer
 import (
 	"context"
 	"errors"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	plantypes "github.com/lavanet/lava/x/plans/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -246,6 +248,24 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	consumerIp string,
 	analytics *metrics.RelayMetrics,
 	metadata []pairingtypes.Metadata,
+) (relayResult *common.RelayResult, errRet error) {
+	startTime := time.Now()
+	relayResult, errRet = rpccs.sendRelay(ctx, url, req, connectionType, dappID, consumerIp, analytics, metadata)
+	if rpccs.rpcConsumerLogs.AccessLogEnabled() {
+		rpccs.logAccess(ctx, startTime, url, req, dappID, consumerIp, analytics, relayResult, errRet)
+	}
+	return relayResult, errRet
+}
+
+func (rpccs *RPCConsumerServer) sendRelay(
+	ctx context.Context,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadata []pairingtypes.Metadata,
 ) (relayResult *common.RelayResult, errRet error) {
 	// gets the relay request data from the ChainListener
 	// parses the request into an APIMessage, and validating it corresponds to the spec currently in use
@@ -258,13 +278,18 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
 	relaySentTime := time.Now()
+	_, parseSpan := metrics.StartSpan(ctx, "ParseMsg")
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, rpccs.getExtensionsFromDirectiveHeaders(rpccs.getLatestBlock(), directiveHeaders))
+	metrics.EndSpan(parseSpan, err)
 	if err != nil {
 		return nil, err
 	}
-	// temporarily disable subscriptions
+	if analytics != nil {
+		analytics.ApiMethod = chainMessage.GetApi().Name
+	}
+	// only the streams of grpc and rest are supported as subscriptions at the moment
 	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
+	if isSubscription && !chainlib.IsStreamingApiInterface(rpccs.listenEndpoint.ApiInterface) {
 		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are not supported at the moment", nil)
 	}
 
@@ -286,8 +311,16 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	unwantedProviders := rpccs.GetInitialUnwantedProviders(directiveHeaders)
 	for ; retries < MaxRelayRetries; retries++ {
 		// TODO: make this async between different providers
-		relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, &unwantedProviders, timeouts)
+		attemptCtx, attemptSpan := metrics.StartSpan(ctx, "sendRelayToProvider", attribute.Int64("lava.attempt", int64(retries)), attribute.String("lava.api", chainMessage.GetApi().Name))
+		relayResult, err := rpccs.sendRelayToProvider(attemptCtx, chainMessage, relayRequestData, dappID, consumerIp, &unwantedProviders, timeouts)
+		if relayResult != nil {
+			attemptSpan.SetAttributes(attribute.String("lava.provider", relayResult.ProviderInfo.ProviderAddress))
+		}
+		metrics.EndSpan(attemptSpan, err)
 		if relayResult.ProviderInfo.ProviderAddress != "" {
+			if analytics != nil {
+				analytics.Providers = append(analytics.Providers, relayResult.ProviderInfo.ProviderAddress)
+			}
 			if err != nil {
 				// add this provider to the erroring providers
 				if errorRelayResult.ProviderInfo.ProviderAddress != "" {
@@ -332,18 +365,19 @@ func (rpccs *RPCConsumerServer) SendRelay(
 				relayResult.Finalized = false // shut down data reliability
 			}
 		}
-		if len(relayResults) >= rpccs.requiredResponses {
+		if len(relayResults) >= rpccs.requiredResponses || isSubscription {
+			// a subscription is streamed from a single provider
 			break
 		}
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
-	if enabled {
+	if enabled && !isSubscription {
 		for _, relayResult := range relayResults {
 			// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
 			// as data reliability happens in a go routine it will continue while the response returns.
 			guid, found := utils.GetUniqueIdentifier(ctx)
-			dataReliabilityContext := context.Background()
+			dataReliabilityContext := metrics.SpanContextFromContext(ctx, context.Background())
 			if found {
 				dataReliabilityContext = utils.WithUniqueIdentifier(dataReliabilityContext, guid)
 			}
@@ -351,6 +385,9 @@ func (rpccs *RPCConsumerServer) SendRelay(
 		}
 	}
 
+	if analytics != nil {
+		analytics.Retries = retries
+	}
 	if len(relayResults) == 0 {
 		rpccs.appendHeadersToRelayResult(ctx, errorRelayResult, retries)
 		// suggest the user to add the timeout flag
@@ -383,6 +420,52 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	return returnedResult, nil
 }
 
+// writes a record of a client request to the access log
+func (rpccs *RPCConsumerServer) logAccess(ctx context.Context, startTime time.Time, url string, req string, dappID string, consumerIp string, analytics *metrics.RelayMetrics, relayResult *common.RelayResult, err error) {
+	record := &metrics.AccessLogRecord{
+		Time:         startTime,
+		DappID:       dappID,
+		ConsumerIP:   consumerIp,
+		ChainID:      rpccs.listenEndpoint.ChainID,
+		ApiInterface: rpccs.listenEndpoint.ApiInterface,
+		LatencyMs:    time.Since(startTime).Milliseconds(),
+		Status:       relayResult.GetStatusCode(),
+		ResponseSize: len(relayResult.GetReply().GetData()),
+	}
+	if guid, found := utils.GetUniqueIdentifier(ctx); found {
+		record.GUID = strconv.FormatUint(guid, 10)
+	}
+	if analytics != nil {
+		record.Method = analytics.ApiMethod
+		record.Retries = analytics.Retries
+		record.Providers = analytics.Providers
+	}
+	if relayResult != nil {
+		if len(record.Providers) == 0 && relayResult.ProviderInfo.ProviderAddress != "" {
+			record.Providers = strings.Split(relayResult.ProviderInfo.ProviderAddress, ",")
+		}
+		record.CacheHit = relayResult.FromCache
+	}
+	if record.Status == 0 {
+		// the listeners reply with an internal error if the node did not return a status code
+		record.Status = http.StatusOK
+		if err != nil {
+			record.Status = http.StatusInternalServerError
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if rpccs.rpcConsumerLogs.ShouldLogAccessBodies() {
+		record.Request = req
+		if record.Request == "" {
+			record.Request = url
+		}
+		record.Response = string(relayResult.GetReply().GetData())
+	}
+	rpccs.rpcConsumerLogs.LogAccess(record)
+}
+
 func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	ctx context.Context,
 	chainMessage chainlib.ChainMessage,
@@ -405,9 +488,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
 
 	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		// temporarily disable subscriptions
-		// TODO: fix subscription and disable this case.
+	if isSubscription && !chainlib.IsStreamingApiInterface(rpccs.listenEndpoint.ApiInterface) {
 		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are disabled currently", nil)
 	}
 
@@ -425,10 +506,21 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 
 	// try using cache before sending relay
 	var cacheError error
-	if reqBlock != spectypes.NOT_APPLICABLE {
+	if isSubscription {
+		utils.LavaFormatDebug("skipping cache for a subscription", utils.Attribute{Key: "api name", Value: chainMessage.GetApi().Name})
+	} else if reqBlock != spectypes.NOT_APPLICABLE {
 		var cacheReply *pairingtypes.CacheRelayReply
-		cacheReply, cacheError = rpccs.cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{Request: relayRequestData, BlockHash: nil, ChainID: chainID, Finalized: false, SharedStateId: sharedStateId}) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+		cacheCtx, cacheSpan := metrics.StartSpan(ctx, "consumer cache GetEntry")
+		cacheLookupTime := time.Now()
+		cacheReply, cacheError = rpccs.cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{Request: relayRequestData, BlockHash: nil, ChainID: chainID, Finalized: false, SharedStateId: sharedStateId}) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
 		reply := cacheReply.GetReply()
+		cacheSpan.SetAttributes(attribute.Bool("lava.cache_hit", cacheError == nil && reply != nil))
+		cacheSpanError := cacheError
+		if performance.IsCacheMiss(cacheError) {
+			// a miss is recorded by the cache_hit attribute, the span is failed only when the cache can't be queried
+			cacheSpanError = nil
+		}
+		metrics.EndSpan(cacheSpan, cacheSpanError)
 		// read seen block from cache even if we had a miss we still want to get the seen block so we can use it to get the right provider.
 		cacheSeenBlock := cacheReply.GetSeenBlock()
 		// check if the cache seen block is greater than my local seen block, this means the user requested this
@@ -442,6 +534,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 
 		// handle cache reply
 		if cacheError == nil && reply != nil {
+			rpccs.rpcConsumerLogs.SetCacheHitLatency(chainID, rpccs.listenEndpoint.ApiInterface, chainMessage.GetApi().Name, time.Since(cacheLookupTime))
 			// Info was fetched from cache, so we don't need to change the state
 			// so we can return here, no need to update anything and calculate as this info was fetched from the cache
 			relayResult = &common.RelayResult{
@@ -450,6 +543,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 					RelayData: relayRequestData,
 				},
 				Finalized: false, // set false to skip data reliability
+				FromCache: true,
 			}
 			return relayResult, nil
 		}
@@ -470,7 +564,9 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	addon := chainlib.GetAddon(chainMessage)
 	extensions := chainMessage.GetExtensions()
 
-	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+	sessionsCtx, sessionsSpan := metrics.StartSpan(ctx, "GetSessions")
+	sessions, err := rpccs.consumerSessionManager.GetSessions(sessionsCtx, chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+	metrics.EndSpan(sessionsSpan, err)
 	if err != nil {
 		if lavasession.PairingListEmptyError.Is(err) && (addon != "" || len(extensions) > 0) {
 			// if we have no providers for a specific addon or extension, return an indicative error
@@ -494,7 +590,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 		go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
 			var localRelayResult *common.RelayResult
 			var errResponse error
-			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
+			goroutineCtx, goroutineCtxCancel := context.WithCancel(metrics.SpanContextFromContext(ctx, context.Background()))
 			guid, found := utils.GetUniqueIdentifier(ctx)
 			if found {
 				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
@@ -532,10 +628,9 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 			endpointClient := *singleConsumerSession.Endpoint.Client
 
 			if isSubscription {
-				localRelayResult, errResponse = rpccs.relaySubscriptionInner(goroutineCtx, endpointClient, singleConsumerSession, localRelayResult)
-				if errResponse != nil {
-					return
-				}
+				// the subscription outlives this goroutine, so it uses the context of the relay
+				localRelayResult, errResponse = rpccs.relaySubscriptionInner(ctx, endpointClient, singleConsumerSession, localRelayResult, chainMessage)
+				return
 			}
 
 			// unique per dappId and ip
@@ -672,6 +767,8 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 		metadataAdd := metadata.New(map[string]string{common.IP_FORWARDING_HEADER_NAME: consumerToken})
 		connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
 		defer connectCtxCancel()
+		connectCtx, span := metrics.StartSpan(connectCtx, "provider Relay call", attribute.String("lava.provider", providerPublicAddress))
+		connectCtx = metrics.InjectTraceToOutgoingContext(connectCtx)
 		var trailer metadata.MD
 		reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
 		statuses := trailer.Get(common.StatusCodeMetadataKey)
@@ -683,6 +780,7 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 			relayResult.StatusCode = codeNum
 		}
 		relayLatency = time.Since(relaySentTime)
+		metrics.EndSpan(span, err)
 		if DebugRelaysFlag {
 			utils.LavaFormatDebug("sending relay to provider",
 				utils.LogAttr("GUID", ctx),
@@ -729,17 +827,21 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	enabled, _ := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
 		// TODO: DETECTION instead of existingSessionLatestBlock, we need proof of last reply to send the previous reply and the current reply
-		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.consumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
+		finalizedBlocks, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.consumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
 		if err != nil {
-			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
-				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
-			}
 			return relayResult, 0, err, false
 		}
 
-		finalizationConflict, err = rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, finalizedBlocks, relayRequest.RelaySession, reply)
+		finalizationConflict, err := rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, finalizedBlocks, relayRequest.RelaySession, reply)
 		if err != nil {
-			go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
+			// a conflict can be validated on chain only with the conflicting reply of another relay
+			if finalizationConflict != nil && finalizationConflict.RelayReply1 != nil {
+				if finalizationConflict.RelaySession0.Provider == finalizationConflict.RelaySession1.Provider {
+					go rpccs.consumerTxSender.TxConflictDetection(ctx, nil, nil, finalizationConflict, singleConsumerSession.Parent)
+				} else {
+					go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
+				}
+			}
 			return relayResult, 0, err, false
 		}
 	}
@@ -747,11 +849,13 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	return relayResult, relayLatency, nil, false
 }
 
-func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult) (relayResultRet *common.RelayResult, err error) {
+func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, chainMessage chainlib.ChainMessage) (relayResultRet *common.RelayResult, err error) {
 	// relaySentTime := time.Now()
-	replyServer, err := endpointClient.RelaySubscribe(ctx, relayResult.Request)
+	subscriptionCtx, cancelSubscription := context.WithCancel(ctx)
+	replyServer, err := endpointClient.RelaySubscribe(subscriptionCtx, relayResult.Request)
 	// relayLatency := time.Since(relaySentTime) // TODO: use subscription QoS
 	if err != nil {
+		cancelSubscription()
 		errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err)
 		if errReport != nil {
 			return relayResult, utils.LavaFormatError("subscribe relay failed onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "original error", Value: err.Error()})
@@ -761,11 +865,49 @@ func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endp
 	// TODO: need to check that if provider fails and returns error, this is reflected here and we run onSessionDone
 	// my thoughts are that this fails if the grpc fails not if the provider fails, and if the provider returns an error this is reflected by the Recv function on the chainListener calling us here
 	// and this is too late
-	relayResult.ReplyServer = &replyServer
+	var chargedReplyServer pairingtypes.Relayer_RelaySubscribeClient = &chargedSubscriptionClient{
+		Relayer_RelaySubscribeClient: replyServer,
+		cancel:                       cancelSubscription,
+		chargeMessage: func() error {
+			return rpccs.consumerSessionManager.AddSubscriptionUsedCU(singleConsumerSession, chainlib.GetComputeUnits(chainMessage), rpccs.consumerTxSender.GetLatestVirtualEpoch())
+		},
+	}
+	relayResult.ReplyServer = &chargedReplyServer
 	err = rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
 	return relayResult, err
 }
 
+// chargedSubscriptionClient charges the compute units of every message streamed after the subscription reply,
+// which is paid for by the relay, and closes the subscription once it ends or can't be paid for
+type chargedSubscriptionClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	receivedReply bool
+	chargeMessage func() error
+	cancel        context.CancelFunc
+}
+
+func (csc *chargedSubscriptionClient) RecvMsg(m interface{}) error {
+	err := csc.Relayer_RelaySubscribeClient.RecvMsg(m)
+	if err == nil && csc.receivedReply {
+		err = csc.chargeMessage()
+	}
+	if err != nil {
+		csc.cancel()
+		return err
+	}
+	csc.receivedReply = true
+	return nil
+}
+
+func (csc *chargedSubscriptionClient) Recv() (*pairingtypes.RelayReply, error) {
+	reply := &pairingtypes.RelayReply{}
+	err := csc.RecvMsg(reply)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
 func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context.Context, dappID string, consumerIp string, relayResult *common.RelayResult, chainMessage chainlib.ChainMessage, dataReliabilityThreshold uint32, unwantedProviders map[string]struct{}) error {
 	// validate relayResult is not nil
 	if relayResult == nil || relayResult.Reply == nil || relayResult.Request == nil {
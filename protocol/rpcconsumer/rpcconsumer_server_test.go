@@ -0,0 +1,239 @@
+package rpcconsumer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/gorilla/websocket"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	subscriptionChainID = "STREAM"
+	subscriptionCU      = uint64(10)
+)
+
+// mockStreamingProvider replies to every subscription with a stream of the same messages
+type mockStreamingProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	replies []string
+}
+
+func (msp *mockStreamingProvider) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	for _, reply := range msp.replies {
+		err := srv.Send(&pairingtypes.RelayReply{Data: []byte(reply)})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startMockStreamingProvider(t *testing.T, replies ...string) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(lavasession.GetTlsConfig(lavasession.NetworkAddressData{}))))
+	pairingtypes.RegisterRelayerServer(server, &mockStreamingProvider{replies: replies})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+type mockConsumerTxSender struct {
+	ConsumerTxSender
+}
+
+func (mockConsumerTxSender) GetLatestVirtualEpoch() uint64 {
+	return 0
+}
+
+func streamingSpecForTest(apiInterface, connectionType, apiName string) spectypes.Spec {
+	return spectypes.Spec{
+		Index:                         subscriptionChainID,
+		Enabled:                       true,
+		AverageBlockTime:              1000,
+		AllowedBlockLagForQosSync:     2,
+		BlockDistanceForFinalizedData: 0,
+		BlocksInFinalizationProof:     1,
+		ApiCollections: []*spectypes.ApiCollection{{
+			Enabled:        true,
+			CollectionData: spectypes.CollectionData{ApiInterface: apiInterface, Type: connectionType},
+			Apis: []*spectypes.Api{{
+				Name:         apiName,
+				Enabled:      true,
+				ComputeUnits: subscriptionCU,
+				Category:     spectypes.SpecCategory{Subscription: true},
+				BlockParsing: spectypes.BlockParser{ParserFunc: spectypes.PARSER_FUNC_EMPTY},
+			}},
+		}},
+	}
+}
+
+// createStreamingConsumerServer creates a consumer server paired with a single provider
+func createStreamingConsumerServer(t *testing.T, spec spectypes.Spec, apiInterface string, providerAddress string, maxComputeUnits uint64) (*RPCConsumerServer, *lavasession.ConsumerSessionsWithProvider) {
+	allowInsecure := lavasession.AllowInsecureConnectionToProviders
+	lavasession.AllowInsecureConnectionToProviders = true
+	t.Cleanup(func() { lavasession.AllowInsecureConnectionToProviders = allowInsecure })
+	rand.InitRandomSeed()
+
+	chainParser, err := chainlib.NewChainParser(apiInterface)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+
+	listenEndpoint := &lavasession.RPCEndpoint{NetworkAddress: "localhost:0", ChainID: subscriptionChainID, ApiInterface: apiInterface}
+	optimizer := provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, common.AverageWorldLatency/2, 1)
+	consumerSessionManager := lavasession.NewConsumerSessionManager(listenEndpoint, optimizer, nil)
+	providerSessions := &lavasession.ConsumerSessionsWithProvider{
+		PublicLavaAddress: "provider",
+		Endpoints:         []*lavasession.Endpoint{{NetworkAddress: providerAddress, Enabled: true}},
+		Sessions:          map[int64]*lavasession.SingleConsumerSession{},
+		MaxComputeUnits:   maxComputeUnits,
+		PairingEpoch:      20,
+	}
+	err = consumerSessionManager.UpdateAllProviders(20, map[uint64]*lavasession.ConsumerSessionsWithProvider{0: providerSessions})
+	require.NoError(t, err)
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	rpcConsumerLogs, err := metrics.NewRPCConsumerLogs(nil, nil)
+	require.NoError(t, err)
+	rpccs := &RPCConsumerServer{
+		chainParser:            chainParser,
+		consumerSessionManager: consumerSessionManager,
+		listenEndpoint:         listenEndpoint,
+		rpcConsumerLogs:        rpcConsumerLogs,
+		privKey:                privKey,
+		consumerTxSender:       mockConsumerTxSender{},
+		requiredResponses:      1,
+		finalizationConsensus:  lavaprotocol.NewFinalizationConsensus(subscriptionChainID),
+		lavaChainID:            "lava",
+		consumerConsistency:    NewConsumerConsistency(subscriptionChainID),
+	}
+	return rpccs, providerSessions
+}
+
+func readSubscriptionReplies(t *testing.T, relayResult *common.RelayResult) (replies []string, err error) {
+	replyServer := relayResult.GetReplyServer()
+	require.NotNil(t, replyServer)
+	for {
+		var reply pairingtypes.RelayReply
+		err = (*replyServer).RecvMsg(&reply)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return replies, err
+		}
+		replies = append(replies, string(reply.Data))
+	}
+}
+
+func TestSendRelayGrpcStream(t *testing.T) {
+	const method = "grpc.health.v1.Health/Watch"
+	spec := streamingSpecForTest(spectypes.APIInterfaceGrpc, "", method)
+	streamed := []string{"first", "second", "third"}
+
+	t.Run("stream", func(t *testing.T) {
+		rpccs, providerSessions := createStreamingConsumerServer(t, spec, spectypes.APIInterfaceGrpc, startMockStreamingProvider(t, streamed...), 100)
+		relayResult, err := rpccs.SendRelay(context.Background(), method, "", "", "dapp", "127.0.0.1", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "provider", relayResult.ProviderInfo.ProviderAddress)
+		replies, err := readSubscriptionReplies(t, relayResult)
+		require.NoError(t, err)
+		require.Equal(t, streamed, replies)
+		// the subscription relay pays for the first message, every following message is charged on its own
+		require.Equal(t, subscriptionCU*uint64(len(streamed)), providerSessions.UsedComputeUnits)
+	})
+
+	t.Run("stream exceeding the compute units", func(t *testing.T) {
+		rpccs, providerSessions := createStreamingConsumerServer(t, spec, spectypes.APIInterfaceGrpc, startMockStreamingProvider(t, streamed...), 2*subscriptionCU)
+		relayResult, err := rpccs.SendRelay(context.Background(), method, "", "", "dapp", "127.0.0.1", nil, nil)
+		require.NoError(t, err)
+		replies, err := readSubscriptionReplies(t, relayResult)
+		require.ErrorIs(t, err, lavasession.MaxComputeUnitsExceededError)
+		require.Equal(t, streamed[:2], replies)
+		require.Equal(t, 2*subscriptionCU, providerSessions.UsedComputeUnits)
+	})
+}
+
+func TestRestListenerStream(t *testing.T) {
+	const path = "/v1/stream/blocks"
+	spec := streamingSpecForTest(spectypes.APIInterfaceRest, http.MethodGet, path)
+	streamed := []string{`{"height":1}`, `{"height":2}`, `{"height":3}`}
+	rpccs, _ := createStreamingConsumerServer(t, spec, spectypes.APIInterfaceRest, startMockStreamingProvider(t, streamed...), 1000)
+
+	// serve the rest listener of the consumer
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	listenAddress := lis.Addr().String()
+	require.NoError(t, lis.Close())
+	rpccs.listenEndpoint.NetworkAddress = listenAddress
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainListener, err := chainlib.NewChainListener(ctx, rpccs.listenEndpoint, rpccs, rpccs, rpccs.rpcConsumerLogs, rpccs.chainParser)
+	require.NoError(t, err)
+	go chainListener.Serve(ctx, common.ConsumerCmdFlags{})
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", listenAddress)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("websocket", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial("ws://"+listenAddress+path, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		replies := []string{}
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				// the listener closes the connection once the stream ends
+				break
+			}
+			replies = append(replies, string(message))
+		}
+		require.Equal(t, streamed, replies)
+	})
+
+	t.Run("server sent events", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://"+listenAddress+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		replies := []string{}
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if data, isData := strings.CutPrefix(scanner.Text(), "data: "); isData {
+				replies = append(replies, data)
+			}
+		}
+		require.NoError(t, scanner.Err())
+		require.Equal(t, streamed, replies)
+	})
+}
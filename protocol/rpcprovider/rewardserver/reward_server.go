@@ -394,6 +394,38 @@ func (rws *RewardServer) gatherRewardsForClaim(ctx context.Context, currentEpoch
 	return rewardsForClaim, errRet
 }
 
+// ConsumersRewardsState returns the CU each consumer owes the provider, split to rewards that were not claimed yet
+// and claimed rewards that are waiting for their payment event
+func (rws *RewardServer) ConsumersRewardsState() []metrics.ConsumerRewardsState {
+	rws.lock.RLock()
+	defer rws.lock.RUnlock()
+	states := map[string]*metrics.ConsumerRewardsState{}
+	getState := func(specID string, consumerAddress string) *metrics.ConsumerRewardsState {
+		key := getKeyForConsumerRewards(specID, consumerAddress)
+		state, ok := states[key]
+		if !ok {
+			state = &metrics.ConsumerRewardsState{SpecID: specID, ConsumerAddress: consumerAddress}
+			states[key] = state
+		}
+		return state
+	}
+	for _, epochRewards := range rws.rewards {
+		for _, consumerRewards := range epochRewards.consumerRewards {
+			for _, proof := range consumerRewards.proofs {
+				getState(proof.SpecId, consumerRewards.consumer).UnclaimedCU += proof.CuSum
+			}
+		}
+	}
+	for _, expectedPayment := range rws.expectedPayments {
+		getState(expectedPayment.ChainID, expectedPayment.Client.String()).AwaitingPaymentCU += expectedPayment.CU
+	}
+	ret := make([]metrics.ConsumerRewardsState, 0, len(states))
+	for _, state := range states {
+		ret = append(ret, *state)
+	}
+	return ret
+}
+
 func (rws *RewardServer) SubscribeStarted(consumer string, epoch uint64, subscribeID string) {
 	// TODO: hold off reward claims for subscription while this is still active
 }
@@ -439,7 +471,7 @@ func (rws *RewardServer) PaymentHandler(payment *PaymentRequest) {
 	}
 	if serverID == rws.serverID {
 		rws.updateCUPaid(payment.CU)
-		go rws.providerMetrics.AddPayment(payment.ChainID, payment.CU)
+		go rws.providerMetrics.AddPayment(payment.ChainID, payment.Client.String(), payment.CU)
 		removedPayment := rws.RemoveExpectedPayment(payment.CU, payment.Client, payment.BlockHeightDeadline, payment.UniqueIdentifier, payment.ChainID)
 		if !removedPayment {
 			utils.LavaFormatWarning("tried removing payment that wasn't expected", nil, utils.Attribute{Key: "payment", Value: payment})
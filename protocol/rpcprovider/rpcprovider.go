@@ -157,6 +157,7 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	// single reward server
 	rewardDB := rewardserver.NewRewardDBWithTTL(options.rewardTTL)
 	rpcp.rewardServer = rewardserver.NewRewardServer(providerStateTracker, rpcp.providerMetricsManager, rewardDB, options.rewardStoragePath, options.rewardsSnapshotThreshold, options.rewardsSnapshotTimeoutSec, rpcp.chainTrackers)
+	rpcp.providerMetricsManager.SetConsumerRewardsStateGetter(rpcp.rewardServer)
 	rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rpcp.rewardServer)
 	rpcp.providerStateTracker.RegisterPaymentUpdatableForPayments(ctx, rpcp.rewardServer)
 	keyName, err := sigs.GetKeyName(options.clientCtx)
@@ -446,6 +447,7 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	// prevents these objects form being overrun later
 	chainParser.Activate()
 	chainTracker.RegisterForBlockTimeUpdates(chainParser)
+	chainParser.SetBlockHashResolver(chainTracker)
 	rpcp.providerMetricsManager.SetEnabledChain(rpcProviderEndpoint.ChainID, rpcProviderEndpoint.ApiInterface)
 	return nil
 }
@@ -629,6 +631,12 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 			shardID := viper.GetUint(ShardIDFlagName)
 			rewardsSnapshotThreshold := viper.GetUint(rewardserver.RewardsSnapshotThresholdFlagName)
 			rewardsSnapshotTimeoutSec := viper.GetUint(rewardserver.RewardsSnapshotTimeoutSecFlagName)
+			shutdownTracing, err := metrics.InitTracingFromFlags(ctx, "rpcprovider")
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing(context.Background())
+
 			rpcProvider := RPCProvider{}
 			err = rpcProvider.Start(
 				&rpcProviderStartOptions{
@@ -660,6 +668,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
 	cmdRPCProvider.Flags().String(flags.FlagLogLevel, "debug", "log level")
 	cmdRPCProvider.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
+	metrics.AddTracingFlags(cmdRPCProvider)
 	cmdRPCProvider.Flags().String(rewardserver.RewardServerStorageFlagName, rewardserver.DefaultRewardServerStorage, "the path to store reward server data")
 	cmdRPCProvider.Flags().Duration(rewardserver.RewardTTLFlagName, rewardserver.DefaultRewardTTL, "reward time to live")
 	cmdRPCProvider.Flags().Uint(ShardIDFlagName, DefaultShardID, "shard id")
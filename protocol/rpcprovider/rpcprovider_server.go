@@ -30,6 +30,7 @@ import (
 	"github.com/lavanet/lava/utils/slices"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"go.opentelemetry.io/otel/attribute"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -107,11 +108,17 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 }
 
 // function used to handle relay requests from a consumer, it is called by a provider_listener by calling RegisterReceiver
-func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (relayReply *pairingtypes.RelayReply, errRet error) {
 	if request.RelayData == nil || request.RelaySession == nil {
 		return nil, utils.LavaFormatWarning("invalid relay request, internal fields are nil", nil)
 	}
 	ctx = utils.AppendUniqueIdentifier(ctx, lavaprotocol.GetSalt(request.RelayData))
+	// continue the consumer's trace (if it sent one)
+	ctx, span := metrics.StartSpan(metrics.ExtractTraceFromIncomingContext(ctx), "provider Relay",
+		attribute.String("lava.chain_id", rpcps.rpcProviderEndpoint.ChainID),
+		attribute.String("lava.api_interface", rpcps.rpcProviderEndpoint.ApiInterface),
+	)
+	defer func() { metrics.EndSpan(span, errRet) }()
 	startTime := time.Now()
 	// This is for the SDK, since the timeout is not automatically added to the request like in Go
 	timeout, timeoutFound, err := rpcps.tryGetTimeoutFromRequest(ctx)
@@ -161,13 +168,13 @@ func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes
 			utils.Attribute{Key: "GUID", Value: ctx},
 			utils.Attribute{Key: "timed_out", Value: common.ContextOutOfTime(ctx)},
 		)
-		go rpcps.metrics.AddError()
+		go rpcps.metrics.AddError(consumerAddress.String(), chainMessage.GetApi().Name)
 	} else {
 		// On successful relay
 		pairingEpoch := relaySession.PairingEpoch
 		sendRewards := relaySession.IsPayingRelay() // when consumer mismatch causes this relay not to provide cu
 		replyBlock := reply.LatestBlock
-		go rpcps.metrics.AddRelay(consumerAddress.String(), relaySession.LatestRelayCu, request.RelaySession.QosReport)
+		go rpcps.metrics.AddRelay(consumerAddress.String(), chainMessage.GetApi().Name, relaySession.LatestRelayCu, request.RelaySession.QosReport)
 		relayError := rpcps.providerSessionManager.OnSessionDone(relaySession, request.RelaySession.RelayNum)
 		if relayError != nil {
 			utils.LavaFormatError("OnSession Done failure: ", relayError)
@@ -364,6 +371,9 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 		return false, err
 	}
 	rpcps.rewardServer.SubscribeStarted(consumerAddress.String(), requestBlockHeight, subscriptionID)
+	// the messages of grpc and rest streams are charged to the consumer and counted in the relay metrics with the api's compute units
+	countStreamedMessages := chainlib.IsStreamingApiInterface(chainMessage.GetApiCollection().CollectionData.ApiInterface)
+	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(requestBlockHeight)
 	processSubscribeMessages := func() (subscribed bool, errRet error) {
 		err = srv.Send(reply) // this reply contains the RPC ID
 		if err != nil {
@@ -374,17 +384,32 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 
 		for {
 			select {
-			case <-clientSub.Err():
+			case err := <-clientSub.Err():
+				if err == nil {
+					// the subscription ended without an error, e.g. a finished stream
+					utils.LavaFormatDebug("client sub ended", utils.Attribute{Key: "GUID", Value: ctx})
+					return subscribed, nil
+				}
 				utils.LavaFormatError("client sub", err, utils.Attribute{Key: "GUID", Value: ctx})
 				// delete this connection from the subs map
 
 				return subscribed, err
 			case subscribeReply := <-subscribeRepliesChan:
-				data, err := json.Marshal(subscribeReply)
-				if err != nil {
-					return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+				// streamed replies (e.g. grpc server streams) are already encoded
+				data, isEncoded := subscribeReply.([]byte)
+				if !isEncoded {
+					data, err = json.Marshal(subscribeReply)
+					if err != nil {
+						return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
 				}
 
+				if countStreamedMessages {
+					err = rpcps.providerSessionManager.AddSubscriptionUsedCU(consumerAddress.String(), requestBlockHeight, chainMessage.GetApi().ComputeUnits, virtualEpoch)
+					if err != nil {
+						return subscribed, utils.LavaFormatWarning("closing stream, consumer exceeded its compute units", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
+				}
 				err = srv.Send(
 					&pairingtypes.RelayReply{
 						Data: data,
@@ -401,6 +426,9 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 				} else {
 					subscribed = true
 				}
+				if countStreamedMessages {
+					go rpcps.metrics.AddRelay(consumerAddress.String(), chainMessage.GetApi().Name, chainMessage.GetApi().ComputeUnits, nil)
+				}
 
 				utils.LavaFormatDebug("Sending data", utils.Attribute{Key: "data", Value: string(data)}, utils.Attribute{Key: "GUID", Value: ctx})
 			}
@@ -544,6 +572,7 @@ func (rpcps *RPCProviderServer) getSingleProviderSession(ctx context.Context, re
 					utils.Attribute{Key: "relayNum", Value: request.RelayNum},
 				)
 			}
+			go rpcps.metrics.SetConsumerProject(consumerAddressString, projectId)
 			// After validating the consumer we can register it with provider session manager.
 			singleProviderSession, err = rpcps.providerSessionManager.RegisterProviderSessionWithConsumer(ctx, consumerAddressString, uint64(request.Epoch), request.SessionId, request.RelayNum, maxCuForConsumer, pairedProviders, projectId, request.Badge)
 			if err != nil {
@@ -678,8 +707,16 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	ignoredMetadata := []pairingtypes.Metadata{}
 	if requestedBlockHash != nil || finalized {
 		var cacheReply *pairingtypes.CacheRelayReply
-		cacheReply, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{Request: request.RelayData, BlockHash: requestedBlockHash, ChainID: rpcps.rpcProviderEndpoint.ChainID, Finalized: finalized, Provider: rpcps.providerAddress.String()})
+		cacheCtx, cacheSpan := metrics.StartSpan(ctx, "provider cache GetEntry")
+		cacheReply, err = cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{Request: request.RelayData, BlockHash: requestedBlockHash, ChainID: rpcps.rpcProviderEndpoint.ChainID, Finalized: finalized, Provider: rpcps.providerAddress.String()})
 		reply = cacheReply.GetReply()
+		cacheSpan.SetAttributes(attribute.Bool("lava.cache_hit", err == nil && reply != nil))
+		cacheSpanError := err
+		if performance.IsCacheMiss(err) {
+			// a miss is recorded by the cache_hit attribute, the span is failed only when the cache can't be queried
+			cacheSpanError = nil
+		}
+		metrics.EndSpan(cacheSpan, cacheSpanError)
 		ignoredMetadata = cacheReply.GetOptionalMetadata()
 		if err != nil && performance.NotConnectedError.Is(err) {
 			utils.LavaFormatDebug("cache not connected", utils.LogAttr("err", err), utils.Attribute{Key: "GUID", Value: ctx})
@@ -701,6 +738,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		if err != nil {
 			return nil, utils.LavaFormatError("Sending chainMsg failed", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "specID", Value: rpcps.rpcProviderEndpoint.ChainID})
 		}
+		go rpcps.metrics.AddNodeLatency(consumerAddr.String(), chainMsg.GetApi().Name, time.Since(sendTime))
 		if debugLatency {
 			utils.LavaFormatDebug("node reply received", utils.Attribute{Key: "timeTaken", Value: time.Since(sendTime)}, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "specID", Value: rpcps.rpcProviderEndpoint.ChainID})
 		}
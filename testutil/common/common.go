@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -150,3 +151,39 @@ func CreateMsgDetectionTest(ctx context.Context, consumer, provider0, provider1
 	msg.ResponseConflict.ConflictRelayData1 = conflictconstruct.ConstructConflictRelayData(reply2, msg.ResponseConflict.ConflictRelayData1.Request)
 	return msg, reply, reply2, err
 }
+
+// CreateRelayFinalizationTest creates a relay session (signed by the consumer) and a relay reply holding
+// the given finalized blocks hashes (with its finalization data signed by the provider)
+func CreateRelayFinalizationTest(ctx context.Context, consumer, provider sigs.Account, spec spectypes.Spec, sessionID uint64, latestBlock int64, finalizedBlocks map[int64]string) (*types.RelaySession, *types.RelayReply, error) {
+	session := &types.RelaySession{
+		Provider:  provider.Addr.String(),
+		SessionId: sessionID,
+		SpecId:    spec.Index,
+		Epoch:     sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		RelayNum:  1,
+		QosReport: &types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()},
+	}
+	sig, err := sigs.Sign(consumer.SK, *session)
+	if err != nil {
+		return nil, nil, err
+	}
+	session.Sig = sig
+
+	finalizedBlocksHashes, err := json.Marshal(finalizedBlocks)
+	if err != nil {
+		return nil, nil, err
+	}
+	reply := &types.RelayReply{
+		Data:                  []byte("DUMMYREPLY"),
+		LatestBlock:           latestBlock,
+		FinalizedBlocksHashes: finalizedBlocksHashes,
+		Metadata:              []types.Metadata{},
+	}
+	relayFinalization := types.NewRelayFinalization(types.NewRelayExchange(types.RelayRequest{RelaySession: session}, *reply), consumer.Addr)
+	sigBlocks, err := sigs.Sign(provider.SK, relayFinalization)
+	if err != nil {
+		return nil, nil, err
+	}
+	reply.SigBlocks = sigBlocks
+	return session, reply, nil
+}
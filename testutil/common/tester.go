@@ -17,6 +17,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	dualstakingtypes "github.com/lavanet/lava/x/dualstaking/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	fixationstoretypes "github.com/lavanet/lava/x/fixationstore/types"
@@ -455,6 +456,22 @@ func (ts *Tester) TxDualstakingClaimRewards(
 	return ts.Servers.DualstakingServer.ClaimRewards(ts.GoCtx, msg)
 }
 
+// TxDualstakingSetAutoCompound: implement 'tx dualstaking set-auto-compound'
+func (ts *Tester) TxDualstakingSetAutoCompound(
+	creator string,
+	provider string,
+	chainID string,
+	enable bool,
+) (*dualstakingtypes.MsgSetAutoCompoundResponse, error) {
+	msg := &dualstakingtypes.MsgSetAutoCompound{
+		Creator:  creator,
+		Provider: provider,
+		ChainID:  chainID,
+		Enable:   enable,
+	}
+	return ts.Servers.DualstakingServer.SetAutoCompound(ts.GoCtx, msg)
+}
+
 // TxSubscriptionBuy: implement 'tx subscription buy'
 func (ts *Tester) TxSubscriptionBuy(creator, consumer, plan string, months int, autoRenewal, advancePurchase bool) (*subscriptiontypes.MsgBuyResponse, error) {
 	msg := &subscriptiontypes.MsgBuy{
@@ -772,6 +789,25 @@ func (ts *Tester) QueryPairingSubscriptionMonthlyPayout(consumer string) (*pairi
 	return ts.Keepers.Pairing.SubscriptionMonthlyPayout(ts.GoCtx, msg)
 }
 
+// QueryPairingProviderQos implements 'q pairing provider-qos'
+func (ts *Tester) QueryPairingProviderQos(provider, chainID, cluster string) (*pairingtypes.QueryProviderQosResponse, error) {
+	msg := &pairingtypes.QueryProviderQosRequest{
+		Provider: provider,
+		ChainID:  chainID,
+		Cluster:  cluster,
+	}
+	return ts.Keepers.Pairing.ProviderQos(ts.GoCtx, msg)
+}
+
+// QueryPairingDisciplineHistory implements 'q pairing discipline-history'
+func (ts *Tester) QueryPairingDisciplineHistory(provider, chainID string) (*pairingtypes.QueryDisciplineHistoryResponse, error) {
+	msg := &pairingtypes.QueryDisciplineHistoryRequest{
+		Provider: provider,
+		ChainID:  chainID,
+	}
+	return ts.Keepers.Pairing.DisciplineHistory(ts.GoCtx, msg)
+}
+
 // QueryPairingVerifyPairing implements 'q dualstaking delegator-providers'
 func (ts *Tester) QueryDualstakingDelegatorProviders(delegator string, withPending bool) (*dualstakingtypes.QueryDelegatorProvidersResponse, error) {
 	msg := &dualstakingtypes.QueryDelegatorProvidersRequest{
@@ -800,6 +836,15 @@ func (ts *Tester) QueryDualstakingDelegatorRewards(delegator string, provider st
 	return ts.Keepers.Dualstaking.DelegatorRewards(ts.GoCtx, msg)
 }
 
+// QueryConflictSlashHistory implements 'q conflict slash-history'
+func (ts *Tester) QueryConflictSlashHistory(provider string, chainID string) (*conflicttypes.QuerySlashHistoryResponse, error) {
+	msg := &conflicttypes.QuerySlashHistoryRequest{
+		Provider: provider,
+		ChainID:  chainID,
+	}
+	return ts.Keepers.Conflict.SlashHistory(ts.GoCtx, msg)
+}
+
 // QueryFixationAllIndices implements 'q fixationstore all-indices'
 func (ts *Tester) QueryFixationAllIndices(storeKey string, prefix string) (*fixationstoretypes.QueryAllIndicesResponse, error) {
 	msg := &fixationstoretypes.QueryAllIndicesRequest{
@@ -47,6 +47,7 @@ func ConflictKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		epochstorage,
 		nil,
 		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
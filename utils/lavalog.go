@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"runtime/debug"
 	"sort"
@@ -96,26 +97,7 @@ func SetGlobalLoggingLevel(logLevel string) {
 }
 
 func RollingLoggerSetup(rollingLogLevel string, filePath string, maxSize string, maxBackups string, maxAge string, stdFormat string) func() {
-	maxSizeNumber, err := strconv.Atoi(maxSize)
-	if err != nil {
-		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxSize", maxSize))
-	}
-	maxBackupsNumber, err := strconv.Atoi(maxBackups)
-	if err != nil {
-		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxBackups", maxBackups))
-	}
-	maxAgeNumber, err := strconv.Atoi(maxAge)
-	if err != nil {
-		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxAge", maxAge))
-	}
-
-	rollingLogOutput := &lumberjack.Logger{
-		Filename:   filePath,
-		MaxSize:    maxSizeNumber,
-		MaxBackups: maxBackupsNumber,
-		MaxAge:     maxAgeNumber,
-		Compress:   true,
-	}
+	rollingLogOutput := NewRollingLogOutput(filePath, maxSize, maxBackups, maxAge)
 	var logLevel zerolog.Level
 	switch rollingLogLevel {
 	case "off":
@@ -143,6 +125,29 @@ func RollingLoggerSetup(rollingLogLevel string, filePath string, maxSize string,
 	return func() { rollingLogOutput.Close() }
 }
 
+// NewRollingLogOutput returns a writer to filePath that rotates the file by the given size (MB), backups and age (days)
+func NewRollingLogOutput(filePath string, maxSize string, maxBackups string, maxAge string) io.WriteCloser {
+	maxSizeNumber, err := strconv.Atoi(maxSize)
+	if err != nil {
+		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxSize", maxSize))
+	}
+	maxBackupsNumber, err := strconv.Atoi(maxBackups)
+	if err != nil {
+		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxBackups", maxBackups))
+	}
+	maxAgeNumber, err := strconv.Atoi(maxAge)
+	if err != nil {
+		LavaFormatFatal("strconv.Atoi(maxSize)", err, LogAttr("maxAge", maxAge))
+	}
+	return &lumberjack.Logger{
+		Filename:   filePath,
+		MaxSize:    maxSizeNumber,
+		MaxBackups: maxBackupsNumber,
+		MaxAge:     maxAgeNumber,
+		Compress:   true,
+	}
+}
+
 func StrValueForLog(val interface{}, key string, idx int, attributes []Attribute) string {
 	st_val := ""
 	switch value := val.(type) {
@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowConflictVote())
 	cmd.AddCommand(CmdProviderConflicts())
 	cmd.AddCommand(CmdConsumerConflicts())
+	cmd.AddCommand(CmdSlashHistory())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
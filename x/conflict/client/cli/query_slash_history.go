@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/conflict/types"
+	"github.com/spf13/cobra"
+)
+
+const chainIDFlagName = "chain-id"
+
+func CmdSlashHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-history <provider>",
+		Short: "Queries a provider's slash history (slashes due to proven conflicts)",
+		Long: `Queries a provider's slash history (slashes due to proven conflicts).
+Use the --chain-id flag to show only the slashes on a specific chain.`,
+		Example: `lavad q conflict slash-history <provider>
+lavad q conflict slash-history <provider> --chain-id ETH1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(chainIDFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySlashHistoryRequest{
+				Provider: args[0],
+				ChainID:  chainID,
+			}
+
+			res, err := queryClient.SlashHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(chainIDFlagName, "", "filter the slash history by chain ID")
+
+	return cmd
+}
@@ -13,6 +13,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.ConflictVoteList {
 		k.SetConflictVote(ctx, elem)
 	}
+	// Set all the slashRecord
+	for _, elem := range genState.SlashRecordList {
+		k.SetSlashRecord(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 
@@ -25,6 +29,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.ConflictVoteList = k.GetAllConflictVote(ctx)
+	genesis.SlashRecordList = k.GetAllSlashRecord(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
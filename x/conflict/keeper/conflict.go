@@ -2,16 +2,24 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
+// ValidateFinalizationConflict validates a finalization conflict between two providers: both relay
+// replies hold finalization data signed by a provider staked on the chain (over a relay session signed by
+// the client) and the replies' finalized blocks hashes conflict. The conflict alone doesn't prove which of
+// the providers is dishonest, so no provider is slashed for it (see ValidateSameProviderConflict)
 func (k Keeper) ValidateFinalizationConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
-	return nil
+	_, _, _, err := k.validateFinalizationConflictData(ctx, conflictData, clientAddr)
+	return err
 }
 
 func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.ResponseConflict, clientAddr sdk.AccAddress) error {
@@ -165,6 +173,108 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 	return nil
 }
 
-func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
-	return nil
+// ValidateSameProviderConflict validates a finalization conflict in which both relay replies were signed by
+// the same provider. Such a conflict proves the provider signed two different hashes for a finalized block,
+// so the provider, the chain ID and the conflicting block are returned for the provider to be slashed
+func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (providerAddress sdk.AccAddress, chainID string, conflictBlock int64, err error) {
+	chainID, providerAddresses, conflictBlock, err := k.validateFinalizationConflictData(ctx, conflictData, clientAddr)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if !providerAddresses[0].Equals(providerAddresses[1]) {
+		return nil, "", 0, fmt.Errorf("the relay replies were signed by different providers %s, %s", providerAddresses[0], providerAddresses[1])
+	}
+	return providerAddresses[0], chainID, conflictBlock, nil
+}
+
+// validateFinalizationConflictData validates the relay sessions and replies of a finalization conflict and
+// returns the chain ID, the addresses of the providers that signed the replies' finalization data and a
+// block that both replies hold as finalized, with different hashes
+func (k Keeper) validateFinalizationConflictData(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (chainID string, providerAddresses [2]sdk.AccAddress, conflictBlock int64, err error) {
+	replies := [2]*pairingtypes.RelayReply{conflictData.RelayReply0, conflictData.RelayReply1}
+	sessions := [2]*pairingtypes.RelaySession{conflictData.RelaySession0, conflictData.RelaySession1}
+	for i := range replies {
+		if replies[i] == nil || sessions[i] == nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: missing relay reply or relay session", i)
+		}
+	}
+
+	// 1. validate mismatching data
+	chainID = sessions[0].SpecId
+	if chainID != sessions[1].SpecId {
+		return "", providerAddresses, 0, fmt.Errorf("mismatching relay session chain IDs %s, %s", chainID, sessions[1].SpecId)
+	}
+
+	finalizedBlocks := [2]map[int64]string{}
+	for i := range replies {
+		// 2. validate params
+		epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(sessions[i].Epoch))
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: could not find epoch for block %d", i, sessions[i].Epoch)
+		}
+		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(sessions[i].Epoch))
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("could not get EpochBlocks param")
+		}
+		span := k.VoteStartSpan(ctx) * epochBlocks
+		if uint64(ctx.BlockHeight())-epochStart >= span {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: conflict was received outside of the allowed span, current: %d, span %d - %d", i, ctx.BlockHeight(), epochStart, epochStart+span)
+		}
+
+		// 3. validate the client signed the relay session
+		pubKey, err := sigs.RecoverPubKey(*sessions[i])
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: invalid consumer signature in relay session, error: %s", i, err.Error())
+		}
+		derivedClientAddr, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: invalid consumer address from signature in relay session, error: %s", i, err.Error())
+		}
+		if !derivedClientAddr.Equals(clientAddr) {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: mismatching consumer address signature and msg.Creator in relay session %s , %s", i, derivedClientAddr, clientAddr)
+		}
+		_, err = k.pairingKeeper.GetProjectData(ctx, clientAddr, chainID, epochStart)
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("did not find a project for %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
+		}
+
+		// 4. validate the provider signed the finalization data and its stake entry for that epoch
+		relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(pairingtypes.RelayRequest{RelaySession: sessions[i]}, *replies[i]), clientAddr)
+		pubKey, err = sigs.RecoverPubKey(relayFinalization)
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: RecoverPubKey provider finalization data: %w", i, err)
+		}
+		providerAddresses[i], err = sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: AccAddressFromHex provider finalization data: %w", i, err)
+		}
+		if providerAddresses[i].String() != sessions[i].Provider {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: mismatching provider address signature and relay session provider %s , %s", i, providerAddresses[i], sessions[i].Provider)
+		}
+		_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddresses[i], epochStart)
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("did not find a stake entry for provider %s on epoch %d, chainID %s error: %s", providerAddresses[i], epochStart, chainID, err.Error())
+		}
+
+		err = json.Unmarshal(replies[i].FinalizedBlocksHashes, &finalizedBlocks[i])
+		if err != nil {
+			return "", providerAddresses, 0, fmt.Errorf("conflict data %d: failed unmarshalling finalized blocks hashes: %w", i, err)
+		}
+	}
+
+	// 5. validate mismatching hashes of a block that is finalized on both replies (iterate the blocks
+	// in a deterministic order)
+	blocks := maps.Keys(finalizedBlocks[0])
+	slices.Sort(blocks)
+	for _, block := range blocks {
+		otherHash, ok := finalizedBlocks[1][block]
+		if !ok || otherHash == finalizedBlocks[0][block] {
+			continue
+		}
+		if !k.specKeeper.IsFinalizedBlock(ctx, chainID, block, replies[0].LatestBlock) || !k.specKeeper.IsFinalizedBlock(ctx, chainID, block, replies[1].LatestBlock) {
+			continue
+		}
+		return chainID, providerAddresses, block, nil
+	}
+	return "", providerAddresses, 0, fmt.Errorf("no conflict between the providers finalized blocks hashes")
 }
@@ -26,6 +26,7 @@ type (
 		epochstorageKeeper types.EpochstorageKeeper
 		specKeeper         types.SpecKeeper
 		stakingKeeper      types.StakingKeeper
+		dualstakingKeeper  types.DualstakingKeeper
 	}
 )
 
@@ -41,6 +42,7 @@ func NewKeeper(
 	epochstorageKeeper types.EpochstorageKeeper,
 	specKeeper types.SpecKeeper,
 	stakingKeeper types.StakingKeeper,
+	dualstakingKeeper types.DualstakingKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -58,6 +60,7 @@ func NewKeeper(
 		epochstorageKeeper: epochstorageKeeper,
 		specKeeper:         specKeeper,
 		stakingKeeper:      stakingKeeper,
+		dualstakingKeeper:  dualstakingKeeper,
 	}
 }
 
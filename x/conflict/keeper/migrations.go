@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v5 "github.com/lavanet/lava/x/conflict/migrations/v5"
+	"github.com/lavanet/lava/x/conflict/types"
 )
 
 type Migrator struct {
@@ -16,3 +17,12 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) MigrateToV5(ctx sdk.Context) error {
 	return v5.DeleteOpenConflicts(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// MigrateVersion2To3 implements store migration from v2 to v3:
+// - Set the new slashing params to their default values
+func (m Migrator) MigrateVersion2To3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeySlashFraction, types.DefaultSlashFraction)
+	m.keeper.paramstore.Set(ctx, types.KeyDelegatorsSlashFraction, types.DefaultDelegatorsSlashFraction)
+	m.keeper.paramstore.Set(ctx, types.KeyBurnSlashedFunds, types.DefaultBurnSlashedFunds)
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -17,6 +18,12 @@ func DetectionIndex(msg *types.MsgDetection, epochStart uint64) string {
 	return msg.Creator + msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider + msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider + strconv.FormatUint(epochStart, 10)
 }
 
+// SameProviderConflictIndex is the index of a same provider conflict, which is used as the vote ID of
+// the provider's slash record (so a provider is slashed once for each conflicting block)
+func SameProviderConflictIndex(provider, chainID string, conflictBlock int64) string {
+	return provider + chainID + strconv.FormatInt(conflictBlock, 10)
+}
+
 func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*types.MsgDetectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := k.Keeper.Logger(ctx)
@@ -34,12 +41,31 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 			)
 		}
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil {
-		err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
+		providerAddr, chainID, conflictBlock, err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
 		if err != nil {
 			return nil, utils.LavaFormatWarning("Simulation: invalid same provider conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
+
+		// the provider signed two different hashes for the same finalized block, slash it (once per block)
+		provider := providerAddr.String()
+		index := SameProviderConflictIndex(provider, chainID, conflictBlock)
+		for _, record := range k.Keeper.GetProviderSlashRecords(ctx, provider, chainID) {
+			if record.VoteID == index {
+				return nil, utils.LavaFormatWarning("Simulation: same provider conflict was already reported for this provider and block", fmt.Errorf("provider already slashed"),
+					utils.Attribute{Key: "client", Value: msg.Creator},
+					utils.Attribute{Key: "provider", Value: provider},
+					utils.Attribute{Key: "block", Value: conflictBlock},
+				)
+			}
+		}
+		// there are no voters in a same provider conflict, so the slashed funds are burned
+		slashed, err := k.Keeper.SlashProvider(ctx, provider, chainID, types.SlashReasonFinalizationConflict, index, true)
+		if err != nil {
+			return nil, err
+		}
+		k.Keeper.DistributeSlashedFunds(ctx, slashed, nil)
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
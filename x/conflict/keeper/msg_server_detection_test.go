@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -9,6 +10,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
+	"github.com/lavanet/lava/x/conflict/keeper"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	conflictconstruct "github.com/lavanet/lava/x/conflict/types/construct"
 	"github.com/lavanet/lava/x/pairing/types"
@@ -184,3 +186,82 @@ func TestFrozenProviderDetection(t *testing.T) {
 	// the frozen provider should not be part of the voters list
 	require.False(t, slices.Contains(votersList, frozenProvider))
 }
+
+// TestFinalizationConflictDetection checks that finalization conflicts are validated and that a provider
+// that signed two different hashes for the same finalized block (same provider conflict) is slashed
+func TestFinalizationConflictDetection(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(2)
+
+	latestBlock := int64(ts.BlockHeight())
+	blocks := map[int64]string{latestBlock - 1: "hash0", latestBlock: "hash1"}
+	conflictingBlocks := map[int64]string{latestBlock - 1: "hash0", latestBlock: "DIFF"}
+
+	relayFinalization := func(provider sigs.Account, sessionID uint64, finalizedBlocks map[int64]string) (*types.RelaySession, *types.RelayReply) {
+		session, reply, err := common.CreateRelayFinalizationTest(ts.GoCtx, ts.consumer, provider, ts.spec, sessionID, latestBlock, finalizedBlocks)
+		require.NoError(t, err)
+		return session, reply
+	}
+
+	tests := []struct {
+		name         string
+		provider1    sigs.Account
+		blocks1      map[int64]string
+		sameProvider bool
+		tamper       bool
+		valid        bool
+	}{
+		{"SameProvider", ts.providers[0], conflictingBlocks, true, false, true},
+		{"SameProviderNoConflict", ts.providers[0], blocks, true, false, false},
+		{"SameProviderBadSignature", ts.providers[0], conflictingBlocks, true, true, false},
+		{"SameProviderDifferentProviders", ts.providers[1], conflictingBlocks, true, false, false},
+		{"DifferentProviders", ts.providers[1], conflictingBlocks, false, false, true},
+		{"DifferentProvidersNoConflict", ts.providers[1], blocks, false, false, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session0, reply0 := relayFinalization(ts.providers[0], uint64(2*i), blocks)
+			session1, reply1 := relayFinalization(tt.provider1, uint64(2*i+1), tt.blocks1)
+			if tt.tamper {
+				// change the finalized blocks hashes after the provider signed them
+				reply1.FinalizedBlocksHashes = []byte(strings.Replace(string(reply1.FinalizedBlocksHashes), "DIFF", "DIFF2", 1))
+			}
+			conflict := &conflicttypes.FinalizationConflict{RelayReply0: reply0, RelayReply1: reply1, RelaySession0: session0, RelaySession1: session1}
+
+			msg := conflicttypes.MsgDetection{Creator: ts.consumer.Addr.String()}
+			if tt.sameProvider {
+				msg.SameProviderConflict = conflict
+			} else {
+				msg.FinalizationConflict = conflict
+			}
+			before, err := ts.QueryConflictSlashHistory(ts.providers[0].Addr.String(), ts.spec.Index)
+			require.NoError(t, err)
+
+			_, err = ts.txConflictDetection(&msg)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := ts.QueryConflictSlashHistory(ts.providers[0].Addr.String(), ts.spec.Index)
+			require.NoError(t, err)
+			if !tt.sameProvider {
+				// a conflict between two providers doesn't prove which of them is dishonest
+				require.Equal(t, before.Records, res.Records)
+				return
+			}
+			require.Len(t, res.Records, len(before.Records)+1)
+			record := res.Records[len(res.Records)-1]
+			require.Equal(t, conflicttypes.SlashReasonFinalizationConflict, record.Reason)
+			require.Equal(t, keeper.SameProviderConflictIndex(ts.providers[0].Addr.String(), ts.spec.Index, latestBlock), record.VoteID)
+			require.True(t, record.ProviderSlashed.IsPositive())
+			require.True(t, record.Burned)
+
+			// the provider is slashed only once for the conflicting block
+			_, err = ts.txConflictDetection(&msg)
+			require.Error(t, err)
+		})
+	}
+}
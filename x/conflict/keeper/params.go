@@ -12,6 +12,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.VoteStartSpan(ctx),
 		k.VotePeriod(ctx),
 		k.Rewards(ctx),
+		k.SlashFraction(ctx),
+		k.DelegatorsSlashFraction(ctx),
+		k.BurnSlashedFunds(ctx),
 	)
 }
 
@@ -40,3 +43,18 @@ func (k Keeper) Rewards(ctx sdk.Context) (res types.Rewards) {
 	k.paramstore.Get(ctx, types.KeyRewards, &res)
 	return
 }
+
+func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeySlashFraction, &res)
+	return
+}
+
+func (k Keeper) DelegatorsSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyDelegatorsSlashFraction, &res)
+	return
+}
+
+func (k Keeper) BurnSlashedFunds(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyBurnSlashedFunds, &res)
+	return
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/conflict/types"
+	"golang.org/x/exp/slices"
+)
+
+// SlashProvider slashes a provider that was proven dishonest in a conflict. The provider's
+// self delegation is slashed by the SlashFraction param and its delegators' delegations by
+// the DelegatorsSlashFraction param (using dualstaking). The slashed funds are transferred
+// to the conflict module (see DistributeSlashedFunds) and the slash is saved in the
+// provider's slash history (burn marks whether the slashed funds are going to be burned).
+func (k Keeper) SlashProvider(ctx sdk.Context, provider, chainID, reason, voteID string, burn bool) (sdk.Coin, error) {
+	denom := k.stakingKeeper.BondDenom(ctx)
+
+	providerSlashed, delegatorsSlashed, err := k.dualstakingKeeper.SlashDelegations(ctx, provider, chainID,
+		k.SlashFraction(ctx), k.DelegatorsSlashFraction(ctx), types.ModuleName)
+	if err != nil {
+		return sdk.NewCoin(denom, math.ZeroInt()), utils.LavaFormatWarning("failed to slash provider", err,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "voteID", Value: voteID},
+		)
+	}
+
+	record := types.SlashRecord{
+		Provider:          provider,
+		ChainID:           chainID,
+		Block:             uint64(ctx.BlockHeight()),
+		Reason:            reason,
+		VoteID:            voteID,
+		ProviderSlashed:   sdk.NewCoin(denom, providerSlashed),
+		DelegatorsSlashed: sdk.NewCoin(denom, delegatorsSlashed),
+		Burned:            burn,
+	}
+	k.SetSlashRecord(ctx, record)
+
+	details := map[string]string{
+		"provider":           provider,
+		"chainID":            chainID,
+		"reason":             reason,
+		"voteID":             voteID,
+		"provider_slashed":   record.ProviderSlashed.String(),
+		"delegators_slashed": record.DelegatorsSlashed.String(),
+		"burned":             strconv.FormatBool(record.Burned),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ConflictProviderSlashedEventName, details, "Provider slashed due to conflict")
+
+	return sdk.NewCoin(denom, providerSlashed.Add(delegatorsSlashed)), nil
+}
+
+// DistributeSlashedFunds handles the slashed funds held by the conflict module: if the
+// BurnSlashedFunds param is set (or there are no honest voters) they are burned. Otherwise,
+// they are redistributed to the honest voters relative to their stake. Leftovers (due to
+// rounding) are burned.
+func (k Keeper) DistributeSlashedFunds(ctx sdk.Context, slashed sdk.Coin, honestVotersStake map[string]math.Int) {
+	if !slashed.IsPositive() {
+		return
+	}
+
+	totalStake := math.ZeroInt()
+	for _, stake := range honestVotersStake {
+		totalStake = totalStake.Add(stake)
+	}
+
+	leftover := slashed
+	if !k.BurnSlashedFunds(ctx) && totalStake.IsPositive() {
+		// iterate the voters in a deterministic order
+		voters := make([]string, 0, len(honestVotersStake))
+		for voter := range honestVotersStake {
+			voters = append(voters, voter)
+		}
+		slices.Sort(voters)
+
+		for _, voter := range voters {
+			reward := slashed.Amount.Mul(honestVotersStake[voter]).Quo(totalStake)
+			if !reward.IsPositive() {
+				continue
+			}
+
+			voterAddr, err := sdk.AccAddressFromBech32(voter)
+			if err != nil {
+				utils.LavaFormatWarning("invalid voter address", err,
+					utils.Attribute{Key: "voter", Value: voter},
+				)
+				continue
+			}
+
+			rewardCoin := sdk.NewCoin(slashed.Denom, reward)
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, voterAddr, sdk.NewCoins(rewardCoin))
+			if err != nil {
+				utils.LavaFormatError("failed to reward honest voter from slashed funds", err,
+					utils.Attribute{Key: "voter", Value: voter},
+					utils.Attribute{Key: "reward", Value: rewardCoin.String()},
+				)
+				continue
+			}
+			leftover = leftover.Sub(rewardCoin)
+		}
+	}
+
+	if leftover.IsPositive() {
+		err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(leftover))
+		if err != nil {
+			utils.LavaFormatError("critical: failed to burn slashed funds", err,
+				utils.Attribute{Key: "amount", Value: leftover.String()},
+			)
+		}
+	}
+}
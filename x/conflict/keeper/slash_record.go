@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetSlashRecord set a specific slashRecord in the store
+func (k Keeper) SetSlashRecord(ctx sdk.Context, slashRecord types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&slashRecord)
+	store.Set(types.SlashRecordKey(
+		slashRecord.Provider,
+		slashRecord.ChainID,
+		slashRecord.Block,
+		slashRecord.VoteID,
+	), b)
+}
+
+// GetProviderSlashRecords returns the slashRecords of a provider (optionally filtered
+// by chain ID), sorted by block
+func (k Keeper) GetProviderSlashRecords(ctx sdk.Context, provider, chainID string) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashRecordPrefix(provider, chainID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllSlashRecord returns all slashRecord
+func (k Keeper) GetAllSlashRecord(ctx sdk.Context) (list []types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SlashRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
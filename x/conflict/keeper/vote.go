@@ -122,7 +122,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 			providersWithoutVote = append(providersWithoutVote, vote.Address)
 			bail := stake
 			bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
-			err = k.pairingKeeper.JailEntry(ctx, accAddress, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail))
+			err = k.pairingKeeper.JailEntry(ctx, accAddress, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail), types.JailReasonNoVote)
 			if err != nil {
 				utils.LavaFormatWarning("jailing failed at vote conflict", err)
 				// not skipping to continue to slash
@@ -197,6 +197,35 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					}
 				}
 			}
+
+			// slash the provider(s) that lost the vote and pass the slashed funds to the honest voters (or burn them)
+			var losers []string
+			switch winner {
+			case types.Provider0:
+				losers = []string{conflictVote.SecondProvider.Account}
+			case types.Provider1:
+				losers = []string{conflictVote.FirstProvider.Account}
+			default:
+				losers = []string{conflictVote.FirstProvider.Account, conflictVote.SecondProvider.Account}
+			}
+
+			slashedPool := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+			for _, loser := range losers {
+				slashed, err := k.SlashProvider(ctx, loser, conflictVote.ChainID, types.SlashReasonResponseConflict, conflictVote.Index, k.BurnSlashedFunds(ctx))
+				if err != nil {
+					continue
+				}
+				slashedPool = slashedPool.Add(slashed)
+			}
+
+			honestVotersStake := map[string]math.Int{}
+			for _, vote := range conflictVote.Votes {
+				if vote.Result == winner {
+					honestVotersStake[vote.Address] = votersStake[vote.Address]
+				}
+			}
+			k.DistributeSlashedFunds(ctx, slashedPool, honestVotersStake)
+			eventData = append(eventData, utils.Attribute{Key: "SlashedPool", Value: slashedPool.Amount})
 		}
 	} else {
 		eventName = types.ConflictVoteUnresolvedEventName
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/lavanet/lava/testutil/common"
@@ -425,6 +426,14 @@ func TestNoVotersConflict(t *testing.T) {
 	events := ts.Ctx.EventManager().Events()
 	LastEvent := events[len(events)-1]
 	require.Equal(t, LastEvent.Type, utils.EventPrefix+conflicttypes.ConflictVoteUnresolvedEventName)
+
+	// the voters that didn't vote were jailed, which is kept in their discipline history
+	history, err := ts.QueryPairingDisciplineHistory(ts.providers[2].Addr.String(), ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, pairingtypes.DisciplineActionJail, history.Records[0].Action)
+	require.Equal(t, conflicttypes.JailReasonNoVote, history.Records[0].Reason)
+	require.NotZero(t, history.Records[0].JailBlocks)
 }
 
 func TestNoDecisionVote(t *testing.T) {
@@ -497,3 +506,91 @@ func TestNoDecisionVote(t *testing.T) {
 	LastEvent := events[len(events)-1]
 	require.Equal(t, utils.EventPrefix+conflicttypes.ConflictVoteUnresolvedEventName, LastEvent.Type)
 }
+
+func TestSlashOnStrongMajorityVote(t *testing.T) {
+	rand.InitRandomSeed()
+	for _, burn := range []bool{false, true} {
+		t.Run(fmt.Sprintf("burn=%t", burn), func(t *testing.T) {
+			ts := newTester(t)
+			voteID, detection, relay0, _ := ts.setupForCommit()
+
+			params := ts.Keepers.Conflict.GetParams(ts.Ctx)
+			params.BurnSlashedFunds = burn
+			ts.Keepers.Conflict.SetParams(ts.Ctx, params)
+
+			// delegate to the provider that is about to lose the vote
+			_, delegator := ts.AddAccount(common.CONSUMER, 1, 10000)
+			_, err := ts.TxDualstakingDelegate(delegator, ts.providers[1].Addr.String(), ts.spec.Index, common.NewCoin(ts.TokenDenom(), 1000))
+			require.NoError(t, err)
+
+			entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[1].Addr)
+			require.True(t, found)
+			stakeBefore := entry.Stake.Amount
+			delegateTotalBefore := entry.DelegateTotal.Amount
+
+			// all voters vote for provider0, so provider1 loses the vote
+			nonce := rand.Int63()
+			relayExchange := pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0)
+			replyDataHash := sigs.HashMsg(relayExchange.DataToSign())
+
+			msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID}
+			for i := 2; i < ProvidersCount; i++ {
+				msg.Creator = ts.providers[i].Addr.String()
+				msg.Hash = conflicttypes.CommitVoteData(nonce, replyDataHash, msg.Creator)
+				_, err := ts.txConflictVoteCommit(&msg)
+				require.NoError(t, err)
+			}
+
+			ts.AdvanceEpochs(ts.VotePeriod() + 1)
+
+			msgReveal := conflicttypes.MsgConflictVoteReveal{VoteID: voteID, Hash: replyDataHash, Nonce: nonce}
+			for i := 2; i < ProvidersCount; i++ {
+				msgReveal.Creator = ts.providers[i].Addr.String()
+				_, err := ts.txConflictVoteReveal(&msgReveal)
+				require.NoError(t, err)
+			}
+
+			votersBalanceBefore := map[int]int64{}
+			for i := 2; i < ProvidersCount; i++ {
+				votersBalanceBefore[i] = ts.GetBalance(ts.providers[i].Addr)
+			}
+
+			ts.AdvanceEpochs(ts.VotePeriod())
+
+			_, found = ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+			require.False(t, found)
+
+			// the loser's self delegation and delegations were slashed
+			providerSlashed := params.SlashFraction.MulInt(stakeBefore).TruncateInt()
+			delegatorsSlashed := params.DelegatorsSlashFraction.MulInt(delegateTotalBefore).TruncateInt()
+			entry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[1].Addr)
+			require.True(t, found)
+			require.True(t, stakeBefore.Sub(providerSlashed).Equal(entry.Stake.Amount))
+			require.True(t, delegateTotalBefore.Sub(delegatorsSlashed).Equal(entry.DelegateTotal.Amount))
+
+			// the winner was not slashed
+			res, err := ts.QueryConflictSlashHistory(ts.providers[0].Addr.String(), "")
+			require.NoError(t, err)
+			require.Len(t, res.Records, 0)
+
+			res, err = ts.QueryConflictSlashHistory(ts.providers[1].Addr.String(), ts.spec.Index)
+			require.NoError(t, err)
+			require.Len(t, res.Records, 1)
+			record := res.Records[0]
+			require.Equal(t, voteID, record.VoteID)
+			require.Equal(t, conflicttypes.SlashReasonResponseConflict, record.Reason)
+			require.True(t, providerSlashed.Equal(record.ProviderSlashed.Amount))
+			require.True(t, delegatorsSlashed.Equal(record.DelegatorsSlashed.Amount))
+			require.Equal(t, burn, record.Burned)
+
+			// honest voters (with equal stake) get an equal share of the slashed funds
+			reward := providerSlashed.Add(delegatorsSlashed).QuoRaw(ProvidersCount - 2).Int64()
+			if burn {
+				reward = 0
+			}
+			for i := 2; i < ProvidersCount; i++ {
+				require.Equal(t, votersBalanceBefore[i]+reward, ts.GetBalance(ts.providers[i].Addr))
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		ConflictVoteList: []ConflictVote{},
+		SlashRecordList:  []SlashRecord{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,6 +30,17 @@ func (gs GenesisState) Validate() error {
 		}
 		conflictVoteIndexMap[index] = struct{}{}
 	}
+
+	// Check for duplicated index in slashRecord
+	slashRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SlashRecordList {
+		index := string(SlashRecordKey(elem.Provider, elem.ChainID, elem.Block, elem.VoteID))
+		if _, ok := slashRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for slashRecord")
+		}
+		slashRecordIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
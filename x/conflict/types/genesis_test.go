@@ -49,6 +49,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated slashRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashRecordList: []types.SlashRecord{
+					{
+						Provider: "provider",
+						ChainID:  "ETH1",
+						Block:    10,
+						VoteID:   "0",
+					},
+					{
+						Provider: "provider",
+						ChainID:  "ETH1",
+						Block:    10,
+						VoteID:   "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
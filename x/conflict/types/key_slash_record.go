@@ -0,0 +1,24 @@
+package types
+
+import "fmt"
+
+const (
+	// SlashRecordKeyPrefix is the prefix to retrieve all SlashRecord
+	SlashRecordKeyPrefix = "SlashRecord/value/"
+)
+
+// SlashRecordKey returns the store key to retrieve a SlashRecord. Records are keyed by
+// provider and chain ID first (so the provider's slash history can be iterated by prefix)
+// and then by block (zero padded, to keep them sorted) and vote ID.
+func SlashRecordKey(provider, chainID string, block uint64, voteID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d/%s/", provider, chainID, block, voteID))
+}
+
+// SlashRecordPrefix returns the store prefix of a provider's slash records (optionally
+// filtered to a specific chain ID)
+func SlashRecordPrefix(provider, chainID string) []byte {
+	if chainID == "" {
+		return []byte(provider + "/")
+	}
+	return []byte(provider + "/" + chainID + "/")
+}
@@ -30,6 +30,18 @@ var (
 	DefaultRewards Rewards = Rewards{WinnerRewardPercent: sdk.NewDecWithPrec(15, 2), ClientRewardPercent: sdk.NewDecWithPrec(10, 2), VotersRewardPercent: sdk.NewDecWithPrec(15, 2)}
 )
 
+var (
+	KeySlashFraction                       = []byte("SlashFraction")
+	DefaultSlashFraction           sdk.Dec = sdk.NewDecWithPrec(10, 2)
+	KeyDelegatorsSlashFraction             = []byte("DelegatorsSlashFraction")
+	DefaultDelegatorsSlashFraction         = sdk.NewDecWithPrec(2, 2)
+)
+
+var (
+	KeyBurnSlashedFunds          = []byte("BurnSlashedFunds")
+	DefaultBurnSlashedFunds bool = false
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -38,12 +50,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	majorityPercent sdk.Dec, voteStartSpan, votePeriod uint64, rewards Rewards,
+	slashFraction, delegatorsSlashFraction sdk.Dec, burnSlashedFunds bool,
 ) Params {
 	return Params{
-		MajorityPercent: majorityPercent,
-		VoteStartSpan:   voteStartSpan,
-		VotePeriod:      votePeriod,
-		Rewards:         rewards,
+		MajorityPercent:         majorityPercent,
+		VoteStartSpan:           voteStartSpan,
+		VotePeriod:              votePeriod,
+		Rewards:                 rewards,
+		SlashFraction:           slashFraction,
+		DelegatorsSlashFraction: delegatorsSlashFraction,
+		BurnSlashedFunds:        burnSlashedFunds,
 	}
 }
 
@@ -54,6 +70,9 @@ func DefaultParams() Params {
 		DefaultVoteStartSpan,
 		DefaultVotePeriod,
 		DefaultRewards,
+		DefaultSlashFraction,
+		DefaultDelegatorsSlashFraction,
+		DefaultBurnSlashedFunds,
 	)
 }
 
@@ -64,6 +83,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyVoteStartSpan, &p.VoteStartSpan, validateVoteStartSpan),
 		paramtypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validateVotePeriod),
 		paramtypes.NewParamSetPair(KeyRewards, &p.Rewards, validateRewards),
+		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyDelegatorsSlashFraction, &p.DelegatorsSlashFraction, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyBurnSlashedFunds, &p.BurnSlashedFunds, validateBurnSlashedFunds),
 	}
 }
 
@@ -85,6 +107,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSlashFraction(p.SlashFraction); err != nil {
+		return err
+	}
+
+	if err := validateSlashFraction(p.DelegatorsSlashFraction); err != nil {
+		return err
+	}
+
+	if err := validateBurnSlashedFunds(p.BurnSlashedFunds); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -160,3 +194,25 @@ func validateRewards(v interface{}) error {
 
 	return nil
 }
+
+func validateSlashFraction(v interface{}) error {
+	slashFraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if slashFraction.IsNil() || slashFraction.GT(sdk.OneDec()) || slashFraction.IsNegative() {
+		return fmt.Errorf("invalid parameter slash fraction %s (must be in [0,1])", slashFraction)
+	}
+
+	return nil
+}
+
+func validateBurnSlashedFunds(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
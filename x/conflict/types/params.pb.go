@@ -26,10 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	MajorityPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=majorityPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"majorityPercent" yaml:"majority_percent"`
-	VoteStartSpan   uint64                                 `protobuf:"varint,2,opt,name=voteStartSpan,proto3" json:"voteStartSpan,omitempty"`
-	VotePeriod      uint64                                 `protobuf:"varint,3,opt,name=votePeriod,proto3" json:"votePeriod,omitempty"`
-	Rewards         Rewards                                `protobuf:"bytes,4,opt,name=Rewards,proto3" json:"Rewards"`
+	MajorityPercent         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=majorityPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"majorityPercent" yaml:"majority_percent"`
+	VoteStartSpan           uint64                                 `protobuf:"varint,2,opt,name=voteStartSpan,proto3" json:"voteStartSpan,omitempty"`
+	VotePeriod              uint64                                 `protobuf:"varint,3,opt,name=votePeriod,proto3" json:"votePeriod,omitempty"`
+	Rewards                 Rewards                                `protobuf:"bytes,4,opt,name=Rewards,proto3" json:"Rewards"`
+	SlashFraction           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slashFraction" yaml:"slash_fraction"`
+	DelegatorsSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=delegatorsSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegatorsSlashFraction" yaml:"delegators_slash_fraction"`
+	BurnSlashedFunds        bool                                   `protobuf:"varint,7,opt,name=burnSlashedFunds,proto3" json:"burnSlashedFunds,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -85,6 +88,13 @@ func (m *Params) GetRewards() Rewards {
 	return Rewards{}
 }
 
+func (m *Params) GetBurnSlashedFunds() bool {
+	if m != nil {
+		return m.BurnSlashedFunds
+	}
+	return false
+}
+
 type Rewards struct {
 	WinnerRewardPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=winnerRewardPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"winnerRewardPercent" yaml:"winner_reward_percent"`
 	ClientRewardPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=clientRewardPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clientRewardPercent" yaml:"client_reward_percent"`
@@ -134,32 +144,37 @@ func init() {
 }
 
 var fileDescriptor_a921a7b735ec6ed8 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xc9, 0xf9, 0x79, 0x69, 0x39, 0x99, 0xc9, 0x25, 0xfa,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50, 0x35,
-	0x7a, 0x20, 0x5a, 0x0f, 0xa6, 0x46, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x42, 0x1f, 0xc4,
-	0x82, 0x28, 0x56, 0xea, 0x64, 0xe2, 0x62, 0x0b, 0x00, 0xeb, 0x16, 0x2a, 0xe6, 0xe2, 0xcf, 0x4d,
-	0xcc, 0xca, 0x2f, 0xca, 0x2c, 0xa9, 0x0c, 0x48, 0x2d, 0x4a, 0x4e, 0xcd, 0x2b, 0x91, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x74, 0xf2, 0x3c, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0x28,
-	0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x92, 0x9a, 0xfc, 0xe9,
-	0x9e, 0xbc, 0x78, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0xcc, 0xb8, 0xf8, 0x02, 0x88, 0x79, 0x4a,
-	0x41, 0xe8, 0x36, 0x08, 0xa9, 0x70, 0xf1, 0x96, 0xe5, 0x97, 0xa4, 0x06, 0x97, 0x24, 0x16, 0x95,
-	0x04, 0x17, 0x24, 0xe6, 0x49, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0xa1, 0x0a, 0x0a, 0xc9, 0x71,
-	0x71, 0x81, 0x04, 0x02, 0x52, 0x8b, 0x32, 0xf3, 0x53, 0x24, 0x98, 0xc1, 0x4a, 0x90, 0x44, 0x84,
-	0xec, 0xb8, 0xd8, 0x83, 0x52, 0xcb, 0x13, 0x8b, 0x52, 0x8a, 0x25, 0x58, 0x14, 0x18, 0x35, 0xb8,
-	0x8d, 0xe4, 0xf4, 0xb0, 0x06, 0x82, 0x1e, 0x54, 0x95, 0x13, 0x0b, 0xc8, 0x4b, 0x41, 0x30, 0x4d,
-	0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x28, 0x4d, 0x62, 0x86, 0x1b, 0x23, 0xd4, 0xc0, 0xc8, 0x25,
-	0x5c, 0x9e, 0x99, 0x97, 0x97, 0x5a, 0x04, 0x11, 0x41, 0x0d, 0x11, 0x3f, 0x92, 0x43, 0x44, 0x06,
-	0x12, 0x22, 0x10, 0x23, 0xe3, 0x8b, 0xc0, 0x66, 0x22, 0x82, 0x05, 0x9b, 0x55, 0x60, 0x27, 0x24,
-	0xe7, 0x64, 0xa6, 0xe6, 0x95, 0xa0, 0x3a, 0x81, 0x89, 0x32, 0x27, 0x40, 0x8c, 0xc4, 0x74, 0x02,
-	0x16, 0xab, 0xc0, 0x4e, 0x00, 0x05, 0x73, 0x51, 0x31, 0xaa, 0x13, 0x98, 0x29, 0x73, 0x02, 0xc4,
-	0x48, 0x4c, 0x27, 0x60, 0xb1, 0xca, 0xc9, 0xe9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0x34, 0x90, 0xac, 0x45, 0xc9, 0x16, 0x15, 0x88, 0x8c, 0x01, 0xb6, 0x3c, 0x89, 0x0d,
-	0x9c, 0xd6, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xc1, 0xa8, 0x71, 0x3e, 0x03, 0x00,
-	0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0xcf, 0x6c, 0x63, 0x57, 0x47, 0x16, 0x65, 0x74, 0xd9, 0x20, 0x32, 0x0d, 0x41, 0x24, 0x08,
+	0x26, 0xa0, 0xb7, 0x3d, 0x78, 0x28, 0xb2, 0xe2, 0x45, 0x4a, 0x7a, 0xf3, 0x52, 0xa6, 0x93, 0xd9,
+	0x6e, 0x34, 0x99, 0x09, 0x33, 0xd3, 0x5d, 0x7b, 0xf3, 0x01, 0x3c, 0x88, 0x27, 0x8f, 0xbe, 0x83,
+	0x2f, 0xb1, 0xc7, 0x3d, 0x8a, 0x87, 0x22, 0xed, 0x1b, 0xf8, 0x04, 0x92, 0x99, 0xd4, 0x6e, 0xb6,
+	0xd9, 0x43, 0xd9, 0xd3, 0x17, 0x7e, 0xfc, 0xf2, 0xfb, 0x33, 0x7c, 0x7c, 0x30, 0xc8, 0xc9, 0x29,
+	0xe1, 0x4c, 0xc7, 0xd5, 0x8c, 0xa9, 0xe0, 0xc7, 0x79, 0x46, 0x75, 0x5c, 0x12, 0x49, 0x0a, 0x15,
+	0x95, 0x52, 0x68, 0x81, 0xf6, 0x6b, 0x4e, 0x54, 0xcd, 0x68, 0xc5, 0x79, 0xf4, 0x70, 0x22, 0x26,
+	0xc2, 0x30, 0xe2, 0xea, 0xcb, 0x92, 0x83, 0x9f, 0x2e, 0xec, 0x0e, 0xcc, 0xdf, 0x48, 0xc1, 0x7b,
+	0x05, 0xf9, 0x20, 0x64, 0xa6, 0x67, 0x03, 0x26, 0x29, 0xe3, 0xda, 0x03, 0x3e, 0x08, 0xef, 0xf4,
+	0xdf, 0x9e, 0xcf, 0x7b, 0xce, 0xef, 0x79, 0xef, 0xe9, 0x24, 0xd3, 0x27, 0xd3, 0x71, 0x44, 0x45,
+	0x11, 0x53, 0xa1, 0x0a, 0xa1, 0xea, 0xf1, 0x5c, 0xa5, 0x1f, 0x63, 0x3d, 0x2b, 0x99, 0x8a, 0x5e,
+	0x33, 0xfa, 0x77, 0xde, 0x3b, 0x98, 0x91, 0x22, 0x3f, 0x0c, 0x56, 0x72, 0xa3, 0xd2, 0xea, 0x05,
+	0xc9, 0x55, 0x07, 0xf4, 0x04, 0xee, 0x9d, 0x0a, 0xcd, 0x86, 0x9a, 0x48, 0x3d, 0x2c, 0x09, 0xf7,
+	0x76, 0x7c, 0x10, 0xba, 0x49, 0x13, 0x44, 0x18, 0xc2, 0x0a, 0x18, 0x30, 0x99, 0x89, 0xd4, 0xeb,
+	0x18, 0xca, 0x25, 0x04, 0xbd, 0x82, 0xbb, 0x09, 0x3b, 0x23, 0x32, 0x55, 0x9e, 0xeb, 0x83, 0xf0,
+	0xee, 0x0b, 0x1c, 0xb5, 0x3e, 0x42, 0x54, 0xb3, 0xfa, 0x6e, 0x55, 0x29, 0x59, 0xfd, 0x84, 0x0a,
+	0xb8, 0xa7, 0x72, 0xa2, 0x4e, 0x8e, 0x24, 0xa1, 0x3a, 0x13, 0xdc, 0xbb, 0x65, 0x8a, 0xbf, 0xd9,
+	0xba, 0xf8, 0xbe, 0x2d, 0x6e, 0xc4, 0x46, 0xc7, 0xb5, 0x5a, 0x90, 0x34, 0xd5, 0xd1, 0x17, 0x00,
+	0x0f, 0x52, 0x96, 0xb3, 0x09, 0xd1, 0x42, 0xaa, 0x61, 0xc3, 0xb9, 0x6b, 0x9c, 0x93, 0xad, 0x9d,
+	0x7d, 0xeb, 0xbc, 0x96, 0x1d, 0x5d, 0x0d, 0x71, 0x9d, 0x25, 0x7a, 0x06, 0xef, 0x8f, 0xa7, 0x92,
+	0x1b, 0x90, 0xa5, 0x47, 0x53, 0x9e, 0x2a, 0x6f, 0xd7, 0x07, 0xe1, 0xed, 0x64, 0x03, 0x3f, 0x74,
+	0xbf, 0xff, 0xe8, 0x39, 0xc1, 0xb7, 0xce, 0xff, 0x07, 0x47, 0x9f, 0x01, 0x7c, 0x70, 0x96, 0x71,
+	0xce, 0xa4, 0x45, 0x9a, 0xbb, 0xf3, 0x6e, 0xeb, 0x22, 0x8f, 0x6d, 0x11, 0x2b, 0x39, 0x92, 0x46,
+	0x73, 0xbd, 0x40, 0x6d, 0x56, 0x26, 0x02, 0xcd, 0x33, 0xc6, 0x75, 0x33, 0xc2, 0xce, 0xcd, 0x22,
+	0x58, 0xc9, 0xcd, 0x08, 0x2d, 0x56, 0x26, 0x42, 0xb5, 0x90, 0x52, 0x35, 0x23, 0x74, 0x6e, 0x16,
+	0xc1, 0x4a, 0x6e, 0x46, 0x68, 0xb1, 0xea, 0xf7, 0xcf, 0x17, 0x18, 0x5c, 0x2c, 0x30, 0xf8, 0xb3,
+	0xc0, 0xe0, 0xeb, 0x12, 0x3b, 0x17, 0x4b, 0xec, 0xfc, 0x5a, 0x62, 0xe7, 0x7d, 0x78, 0xc9, 0xb6,
+	0x71, 0x40, 0x3e, 0xad, 0x4f, 0x88, 0x31, 0x1f, 0x77, 0xcd, 0x55, 0x78, 0xf9, 0x6f, 0x00, 0xfa,
+	0x4c, 0x70, 0x20, 0x68, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -182,6 +197,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnSlashedFunds {
+		i--
+		if m.BurnSlashedFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DelegatorsSlashFraction.Size()
+		i -= size
+		if _, err := m.DelegatorsSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -295,6 +340,13 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.Rewards.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DelegatorsSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BurnSlashedFunds {
+		n += 2
+	}
 	return n
 }
 
@@ -453,6 +505,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorsSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorsSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnSlashedFunds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnSlashedFunds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
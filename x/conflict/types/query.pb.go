@@ -481,6 +481,102 @@ func (m *QueryConsumerConflictsResponse) GetConflicts() []string {
 	return nil
 }
 
+type QuerySlashHistoryRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *QuerySlashHistoryRequest) Reset()         { *m = QuerySlashHistoryRequest{} }
+func (m *QuerySlashHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryRequest) ProtoMessage()    {}
+func (*QuerySlashHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{10}
+}
+func (m *QuerySlashHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryRequest.Merge(m, src)
+}
+func (m *QuerySlashHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QuerySlashHistoryRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+type QuerySlashHistoryResponse struct {
+	Records []SlashRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QuerySlashHistoryResponse) Reset()         { *m = QuerySlashHistoryResponse{} }
+func (m *QuerySlashHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryResponse) ProtoMessage()    {}
+func (*QuerySlashHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1179eb365bacd460, []int{11}
+}
+func (m *QuerySlashHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryResponse.Merge(m, src)
+}
+func (m *QuerySlashHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryResponse) GetRecords() []SlashRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.conflict.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.conflict.QueryParamsResponse")
@@ -492,54 +588,62 @@ func init() {
 	proto.RegisterType((*QueryProviderConflictsResponse)(nil), "lavanet.lava.conflict.QueryProviderConflictsResponse")
 	proto.RegisterType((*QueryConsumerConflictsRequest)(nil), "lavanet.lava.conflict.QueryConsumerConflictsRequest")
 	proto.RegisterType((*QueryConsumerConflictsResponse)(nil), "lavanet.lava.conflict.QueryConsumerConflictsResponse")
+	proto.RegisterType((*QuerySlashHistoryRequest)(nil), "lavanet.lava.conflict.QuerySlashHistoryRequest")
+	proto.RegisterType((*QuerySlashHistoryResponse)(nil), "lavanet.lava.conflict.QuerySlashHistoryResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/conflict/query.proto", fileDescriptor_1179eb365bacd460) }
 
 var fileDescriptor_1179eb365bacd460 = []byte{
-	// 662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc7, 0xe3, 0x3c, 0x4f, 0xa3, 0x66, 0xa9, 0x84, 0x58, 0x82, 0x54, 0xb9, 0x89, 0x0b, 0x06,
-	0x4a, 0x5b, 0x55, 0x5e, 0x35, 0x09, 0x5c, 0x8a, 0x90, 0x9a, 0x4a, 0xf4, 0x84, 0x54, 0x7c, 0x40,
-	0x82, 0x4b, 0xe5, 0x38, 0x8b, 0xb1, 0xe4, 0xec, 0xba, 0xf6, 0x26, 0x6a, 0x55, 0xe5, 0xc2, 0x81,
-	0x33, 0x12, 0x5f, 0x82, 0x0b, 0x57, 0x8e, 0x9c, 0x7b, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x57,
-	0xbe, 0x03, 0xca, 0xbe, 0x24, 0x8e, 0x62, 0xbb, 0xa9, 0x38, 0xc5, 0x3b, 0x9e, 0xff, 0xcc, 0x6f,
-	0x3c, 0x33, 0x1b, 0x70, 0x2f, 0x70, 0xfa, 0x0e, 0xc1, 0x0c, 0x8d, 0x7f, 0x91, 0x4b, 0xc9, 0xdb,
-	0xc0, 0x77, 0x19, 0x3a, 0xe9, 0xe1, 0xe8, 0xcc, 0x0a, 0x23, 0xca, 0x28, 0xbc, 0x23, 0x5d, 0xac,
-	0xf1, 0xaf, 0xa5, 0x5c, 0xf4, 0xaa, 0x47, 0xa9, 0x17, 0x60, 0xe4, 0x84, 0x3e, 0x72, 0x08, 0xa1,
-	0xcc, 0x61, 0x3e, 0x25, 0xb1, 0x10, 0xe9, 0xdb, 0x2e, 0x8d, 0xbb, 0x34, 0x46, 0x6d, 0x27, 0xc6,
-	0x22, 0x1a, 0xea, 0xef, 0xb6, 0x31, 0x73, 0x76, 0x51, 0xe8, 0x78, 0x3e, 0xe1, 0xce, 0xd2, 0xd7,
-	0x4c, 0x67, 0x08, 0x9d, 0xc8, 0xe9, 0xaa, 0x78, 0x5b, 0xe9, 0x3e, 0xea, 0xe1, 0xb8, 0x4f, 0x19,
-	0x96, 0xae, 0x15, 0x8f, 0x7a, 0x94, 0x3f, 0xa2, 0xf1, 0x93, 0xb0, 0x9a, 0x15, 0x00, 0x5f, 0x8e,
-	0x31, 0x8e, 0x78, 0x54, 0x1b, 0x9f, 0xf4, 0x70, 0xcc, 0x4c, 0x1b, 0xdc, 0x9e, 0xb1, 0xc6, 0x21,
-	0x25, 0x31, 0x86, 0x7b, 0xa0, 0x24, 0xb2, 0xaf, 0x6a, 0x77, 0xb5, 0xcd, 0x1b, 0xf5, 0x9a, 0x95,
-	0xfa, 0x0d, 0x2c, 0x21, 0x6b, 0xfd, 0x7f, 0xf1, 0x73, 0xbd, 0x60, 0x4b, 0x89, 0xd9, 0x00, 0x6b,
-	0x3c, 0xe6, 0x21, 0x66, 0x07, 0xd2, 0xf1, 0x15, 0x65, 0x58, 0xa6, 0x84, 0x15, 0xb0, 0xe4, 0x93,
-	0x0e, 0x3e, 0xe5, 0xa1, 0xcb, 0xb6, 0x38, 0x98, 0x5d, 0x50, 0x4d, 0x17, 0x49, 0xa2, 0x17, 0x60,
-	0xc5, 0x4d, 0xd8, 0x25, 0xd7, 0xfd, 0x0c, 0xae, 0x64, 0x08, 0x49, 0x37, 0x23, 0x37, 0xb1, 0x64,
-	0xdc, 0x0f, 0x82, 0x34, 0xc6, 0xe7, 0x00, 0x4c, 0xbb, 0x24, 0x73, 0x6d, 0x58, 0xa2, 0xa5, 0xd6,
-	0xb8, 0xa5, 0x96, 0x18, 0x10, 0xd9, 0x52, 0xeb, 0xc8, 0xf1, 0x94, 0xd6, 0x4e, 0x28, 0xcd, 0xaf,
-	0x9a, 0x2c, 0x6b, 0x2e, 0x4f, 0x66, 0x59, 0xff, 0xfd, 0x43, 0x59, 0xf0, 0x70, 0x86, 0xbb, 0xc8,
-	0xb9, 0x1f, 0x5d, 0xc9, 0x2d, 0x58, 0x66, 0xc0, 0xf7, 0x40, 0x4d, 0xcc, 0x45, 0x44, 0xfb, 0x7e,
-	0x07, 0x47, 0x2a, 0xb3, 0x1a, 0x1c, 0xa8, 0x83, 0xe5, 0x50, 0xbe, 0x93, 0x8d, 0x9c, 0x9c, 0xcd,
-	0xd7, 0xc0, 0xc8, 0x12, 0xcb, 0xb2, 0x75, 0xb0, 0x1c, 0xe1, 0x90, 0x46, 0x0c, 0x77, 0x78, 0xc9,
-	0x65, 0x7b, 0x72, 0x86, 0x6b, 0xa0, 0x4c, 0xa8, 0x18, 0xe8, 0xce, 0x6a, 0x51, 0xbc, 0x24, 0x94,
-	0xd7, 0xd7, 0x99, 0x70, 0x1d, 0x50, 0x12, 0xf7, 0xba, 0xe9, 0x5c, 0xae, 0x7c, 0xa7, 0xb8, 0xd4,
-	0xd9, 0x7c, 0x26, 0xb9, 0x52, 0xc4, 0x92, 0xab, 0x0a, 0xca, 0xea, 0x7b, 0xc6, 0x12, 0x6c, 0x6a,
-	0xa8, 0xff, 0x29, 0x81, 0x25, 0x1e, 0x00, 0x7e, 0xd0, 0x40, 0x49, 0xcc, 0x3e, 0xdc, 0xca, 0xe8,
-	0xd5, 0xfc, 0xb2, 0xe9, 0xdb, 0x8b, 0xb8, 0x0a, 0x12, 0xf3, 0xe1, 0xfb, 0xef, 0xbf, 0x3f, 0x15,
-	0xd7, 0x61, 0x0d, 0xe5, 0x5d, 0x0e, 0xf0, 0x8b, 0x06, 0x56, 0x92, 0x53, 0x01, 0xeb, 0x79, 0x39,
-	0xd2, 0x37, 0x52, 0x6f, 0x5c, 0x4b, 0x23, 0x01, 0x9b, 0x1c, 0xd0, 0x82, 0x3b, 0x68, 0x81, 0x9b,
-	0x09, 0x9d, 0xf3, 0x2d, 0x1f, 0xc0, 0xcf, 0x1a, 0xb8, 0x99, 0x0c, 0xb7, 0x1f, 0x04, 0xf9, 0xc8,
-	0xe9, 0x0b, 0x9a, 0x8f, 0x9c, 0xb1, 0x6c, 0xe6, 0x0e, 0x47, 0xde, 0x80, 0x0f, 0x16, 0x41, 0x86,
-	0xdf, 0x34, 0x70, 0x6b, 0x6e, 0x52, 0x60, 0x33, 0x2f, 0x71, 0xd6, 0x54, 0xea, 0x8f, 0xaf, 0xa9,
-	0x92, 0xc0, 0x4f, 0x39, 0xf0, 0x13, 0xd8, 0xcc, 0x06, 0xe6, 0xca, 0xe3, 0xc9, 0x8c, 0xa2, 0x73,
-	0x65, 0x1b, 0xf0, 0x02, 0xe6, 0x56, 0x30, 0xbf, 0x80, 0xac, 0x75, 0xcf, 0x2f, 0x20, 0x73, 0xcf,
-	0xaf, 0x2c, 0x40, 0x5d, 0x19, 0xc9, 0x02, 0x94, 0x6d, 0xd0, 0x6a, 0x5d, 0x0c, 0x0d, 0xed, 0x72,
-	0x68, 0x68, 0xbf, 0x86, 0x86, 0xf6, 0x71, 0x64, 0x14, 0x2e, 0x47, 0x46, 0xe1, 0xc7, 0xc8, 0x28,
-	0xbc, 0xd9, 0xf4, 0x7c, 0xf6, 0xae, 0xd7, 0xb6, 0x5c, 0xda, 0x9d, 0x8d, 0x7c, 0x3a, 0x8d, 0xcd,
-	0xce, 0x42, 0x1c, 0xb7, 0x4b, 0xfc, 0xdf, 0xaf, 0xf1, 0x37, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x09,
-	0xd5, 0x79, 0xe8, 0x07, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xd5, 0xfe, 0xd8, 0x67, 0x41, 0x1c, 0x2b, 0xac, 0xe9, 0x36, 0xd5, 0xa8, 0xb5,
+	0x2d, 0x25, 0x63, 0xbb, 0xad, 0x1e, 0x2a, 0x42, 0xb7, 0x62, 0xf5, 0x20, 0xd4, 0x08, 0x82, 0x5e,
+	0x96, 0x6c, 0x76, 0xdc, 0x0d, 0x64, 0x33, 0x69, 0x26, 0xbb, 0xb4, 0x94, 0x5e, 0x3c, 0x78, 0x16,
+	0xbc, 0xf9, 0x17, 0x88, 0xe0, 0xd5, 0xa3, 0xe7, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0xfe, 0x21,
+	0xb2, 0xf3, 0xa3, 0xcd, 0xd2, 0x24, 0xee, 0xe2, 0x29, 0x99, 0x97, 0xf7, 0x7d, 0xef, 0xf3, 0x66,
+	0xde, 0x3c, 0x02, 0x37, 0x7d, 0xa7, 0xeb, 0x04, 0x24, 0xc6, 0xbd, 0x27, 0x76, 0x69, 0xf0, 0xd6,
+	0xf7, 0xdc, 0x18, 0xef, 0x74, 0x48, 0xb4, 0x67, 0x85, 0x11, 0x8d, 0x29, 0xba, 0x26, 0x5d, 0xac,
+	0xde, 0xd3, 0x52, 0x2e, 0x7a, 0xb9, 0x49, 0x69, 0xd3, 0x27, 0xd8, 0x09, 0x3d, 0xec, 0x04, 0x01,
+	0x8d, 0x9d, 0xd8, 0xa3, 0x01, 0x13, 0x22, 0x7d, 0xd1, 0xa5, 0xac, 0x4d, 0x19, 0xae, 0x3b, 0x8c,
+	0x88, 0x68, 0xb8, 0xbb, 0x5c, 0x27, 0xb1, 0xb3, 0x8c, 0x43, 0xa7, 0xe9, 0x05, 0xdc, 0x59, 0xfa,
+	0x9a, 0xe9, 0x0c, 0xa1, 0x13, 0x39, 0x6d, 0x15, 0x6f, 0x21, 0xdd, 0x47, 0xbd, 0xd4, 0xba, 0x34,
+	0x26, 0xd2, 0x75, 0x3e, 0xdd, 0x95, 0xf9, 0x0e, 0x6b, 0xd5, 0x22, 0xe2, 0xd2, 0xa8, 0x21, 0x3d,
+	0xa7, 0x9a, 0xb4, 0x49, 0xf9, 0x2b, 0xee, 0xbd, 0x09, 0xab, 0x39, 0x05, 0xe8, 0x45, 0x0f, 0x78,
+	0x9b, 0xe7, 0xb7, 0xc9, 0x4e, 0x87, 0xb0, 0xd8, 0xb4, 0xe1, 0x6a, 0x9f, 0x95, 0x85, 0x34, 0x60,
+	0x04, 0xad, 0xc3, 0x98, 0xe0, 0x2c, 0x69, 0x37, 0xb4, 0xf9, 0x4b, 0x2b, 0x33, 0x56, 0xea, 0x6e,
+	0x59, 0x42, 0x56, 0xbd, 0x78, 0xf8, 0x6b, 0xb6, 0x60, 0x4b, 0x89, 0x59, 0x81, 0x69, 0x1e, 0x73,
+	0x8b, 0xc4, 0x9b, 0xd2, 0xf1, 0x15, 0x8d, 0x89, 0x4c, 0x89, 0xa6, 0x60, 0xd4, 0x0b, 0x1a, 0x64,
+	0x97, 0x87, 0x2e, 0xda, 0x62, 0x61, 0xb6, 0xa1, 0x9c, 0x2e, 0x92, 0x44, 0xcf, 0x61, 0xd2, 0x4d,
+	0xd8, 0x25, 0xd7, 0xad, 0x0c, 0xae, 0x64, 0x08, 0x49, 0xd7, 0x27, 0x37, 0x89, 0x64, 0xdc, 0xf0,
+	0xfd, 0x34, 0xc6, 0x27, 0x00, 0x67, 0xe7, 0x29, 0x73, 0xcd, 0x59, 0xe2, 0xf0, 0xad, 0xde, 0xe1,
+	0x5b, 0xa2, 0x95, 0xe4, 0xe1, 0x5b, 0xdb, 0x4e, 0x53, 0x69, 0xed, 0x84, 0xd2, 0xfc, 0xa6, 0x41,
+	0x39, 0x3d, 0x4f, 0x66, 0x59, 0x17, 0xfe, 0xa3, 0x2c, 0xb4, 0xd5, 0xc7, 0x3d, 0xc2, 0xb9, 0xef,
+	0xfe, 0x93, 0x5b, 0xb0, 0xf4, 0x81, 0xaf, 0xc3, 0x8c, 0xe8, 0x8b, 0x88, 0x76, 0xbd, 0x06, 0x89,
+	0x54, 0x66, 0xd5, 0x38, 0x48, 0x87, 0x89, 0x50, 0x7e, 0x93, 0x07, 0x79, 0xba, 0x36, 0x5f, 0x83,
+	0x91, 0x25, 0x96, 0x65, 0xeb, 0x30, 0x11, 0x91, 0x90, 0x46, 0x31, 0x69, 0xf0, 0x92, 0x8b, 0xf6,
+	0xe9, 0x1a, 0x4d, 0x43, 0x31, 0xa0, 0xa2, 0xf5, 0x1b, 0xa5, 0x11, 0xf1, 0x31, 0xa0, 0xbc, 0xbe,
+	0xc6, 0x29, 0xd7, 0x26, 0x0d, 0x58, 0xa7, 0x9d, 0xce, 0xe5, 0xca, 0x6f, 0x8a, 0x4b, 0xad, 0xcd,
+	0x47, 0x60, 0x64, 0x89, 0x25, 0x57, 0x19, 0x8a, 0x6a, 0x3f, 0x99, 0x04, 0x3b, 0x33, 0x98, 0xdb,
+	0x50, 0xe2, 0xfa, 0x97, 0xbd, 0x3b, 0xf7, 0xd4, 0x63, 0x31, 0x8d, 0xf6, 0x06, 0xd8, 0x0f, 0x54,
+	0x82, 0x71, 0xb7, 0xe5, 0x78, 0xc1, 0xb3, 0xc7, 0xfc, 0x48, 0x8a, 0xb6, 0x5a, 0x9a, 0x35, 0xb8,
+	0x9e, 0x12, 0x51, 0xc2, 0x54, 0x61, 0x5c, 0xdc, 0x6b, 0x26, 0xdb, 0xc2, 0xcc, 0x68, 0x0b, 0xae,
+	0xb6, 0xb9, 0xab, 0xec, 0x0a, 0x25, 0x5c, 0xf9, 0x34, 0x01, 0xa3, 0x3c, 0x03, 0x7a, 0xaf, 0xc1,
+	0x98, 0xb8, 0xae, 0x68, 0x21, 0x23, 0xce, 0xf9, 0xf9, 0xa0, 0x2f, 0x0e, 0xe2, 0x2a, 0x78, 0xcd,
+	0x3b, 0xef, 0x7e, 0xfc, 0xf9, 0x38, 0x32, 0x8b, 0x66, 0x70, 0xde, 0xe4, 0x43, 0x5f, 0x35, 0x98,
+	0x4c, 0x36, 0x32, 0x5a, 0xc9, 0xcb, 0x91, 0x3e, 0x44, 0xf4, 0xca, 0x50, 0x1a, 0x09, 0xb8, 0xca,
+	0x01, 0x2d, 0xb4, 0x84, 0x07, 0x18, 0xbb, 0x78, 0x9f, 0x0f, 0xa6, 0x03, 0xf4, 0x59, 0x83, 0xcb,
+	0xc9, 0x70, 0x1b, 0xbe, 0x9f, 0x8f, 0x9c, 0x3e, 0x53, 0xf4, 0xca, 0x50, 0x1a, 0x89, 0xbc, 0xc4,
+	0x91, 0xe7, 0xd0, 0xed, 0x41, 0x90, 0xd1, 0x77, 0x0d, 0xae, 0x9c, 0x6b, 0x6e, 0xb4, 0x9a, 0x97,
+	0x38, 0xeb, 0x22, 0xe9, 0x6b, 0x43, 0xaa, 0x24, 0xf0, 0x43, 0x0e, 0x7c, 0x1f, 0xad, 0x66, 0x03,
+	0x73, 0x65, 0x4d, 0x59, 0x18, 0xde, 0x57, 0xb6, 0x03, 0x5e, 0xc0, 0xb9, 0xa9, 0x91, 0x5f, 0x40,
+	0xd6, 0x84, 0xd2, 0xd7, 0x86, 0x54, 0x0d, 0x58, 0x80, 0xba, 0xd5, 0xc9, 0x02, 0x94, 0xed, 0x00,
+	0x7d, 0xd1, 0x60, 0x32, 0x79, 0x99, 0x11, 0xce, 0xa3, 0x48, 0x19, 0x24, 0xfa, 0xbd, 0xc1, 0x05,
+	0x92, 0xf8, 0x01, 0x27, 0x5e, 0x46, 0x18, 0xe7, 0xfd, 0x22, 0xb4, 0x84, 0x2a, 0x01, 0x5b, 0xad,
+	0x1e, 0x1e, 0x1b, 0xda, 0xd1, 0xb1, 0xa1, 0xfd, 0x3e, 0x36, 0xb4, 0x0f, 0x27, 0x46, 0xe1, 0xe8,
+	0xc4, 0x28, 0xfc, 0x3c, 0x31, 0x0a, 0x6f, 0xe6, 0x9b, 0x5e, 0xdc, 0xea, 0xd4, 0x2d, 0x97, 0xb6,
+	0xfb, 0x83, 0xee, 0x9e, 0x85, 0x8d, 0xf7, 0x42, 0xc2, 0xea, 0x63, 0xfc, 0xef, 0xa2, 0xf2, 0x77,
+	0x00, 0x1e, 0xd4, 0x02, 0x1d, 0x72, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +668,8 @@ type QueryClient interface {
 	ConsumerConflicts(ctx context.Context, in *QueryConsumerConflictsRequest, opts ...grpc.CallOption) (*QueryConsumerConflictsResponse, error)
 	// Queries a provider's conflict list (ones that the provider was reported in and ones that the provider needs to vote)
 	ProviderConflicts(ctx context.Context, in *QueryProviderConflictsRequest, opts ...grpc.CallOption) (*QueryProviderConflictsResponse, error)
+	// Queries a provider's slash history (optionally filtered by chain ID)
+	SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -619,6 +725,15 @@ func (c *queryClient) ProviderConflicts(ctx context.Context, in *QueryProviderCo
 	return out, nil
 }
 
+func (c *queryClient) SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error) {
+	out := new(QuerySlashHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.conflict.Query/SlashHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -631,6 +746,8 @@ type QueryServer interface {
 	ConsumerConflicts(context.Context, *QueryConsumerConflictsRequest) (*QueryConsumerConflictsResponse, error)
 	// Queries a provider's conflict list (ones that the provider was reported in and ones that the provider needs to vote)
 	ProviderConflicts(context.Context, *QueryProviderConflictsRequest) (*QueryProviderConflictsResponse, error)
+	// Queries a provider's slash history (optionally filtered by chain ID)
+	SlashHistory(context.Context, *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -652,6 +769,9 @@ func (*UnimplementedQueryServer) ConsumerConflicts(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) ProviderConflicts(ctx context.Context, req *QueryProviderConflictsRequest) (*QueryProviderConflictsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProviderConflicts not implemented")
 }
+func (*UnimplementedQueryServer) SlashHistory(ctx context.Context, req *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -747,6 +867,24 @@ func _Query_ProviderConflicts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.conflict.Query/SlashHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashHistory(ctx, req.(*QuerySlashHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.conflict.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -771,6 +909,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProviderConflicts",
 			Handler:    _Query_ProviderConflicts_Handler,
 		},
+		{
+			MethodName: "SlashHistory",
+			Handler:    _Query_SlashHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/conflict/query.proto",
@@ -1112,6 +1254,80 @@ func (m *QueryConsumerConflictsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySlashHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1261,6 +1477,38 @@ func (m *QueryConsumerConflictsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySlashHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2131,6 +2379,204 @@ func (m *QueryConsumerConflictsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySlashHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, SlashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/conflict/slash_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashRecord describes a single slashing of a provider (and its delegators)
+// that was caused by a proven conflict
+type SlashRecord struct {
+	Provider          string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID           string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Block             uint64     `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Reason            string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	VoteID            string     `protobuf:"bytes,5,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ProviderSlashed   types.Coin `protobuf:"bytes,6,opt,name=provider_slashed,json=providerSlashed,proto3" json:"provider_slashed"`
+	DelegatorsSlashed types.Coin `protobuf:"bytes,7,opt,name=delegators_slashed,json=delegatorsSlashed,proto3" json:"delegators_slashed"`
+	Burned            bool       `protobuf:"varint,8,opt,name=burned,proto3" json:"burned,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d66de33990b3bcd4, []int{0}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetProviderSlashed() types.Coin {
+	if m != nil {
+		return m.ProviderSlashed
+	}
+	return types.Coin{}
+}
+
+func (m *SlashRecord) GetDelegatorsSlashed() types.Coin {
+	if m != nil {
+		return m.DelegatorsSlashed
+	}
+	return types.Coin{}
+}
+
+func (m *SlashRecord) GetBurned() bool {
+	if m != nil {
+		return m.Burned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*SlashRecord)(nil), "lavanet.lava.conflict.SlashRecord")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/conflict/slash_record.proto", fileDescriptor_d66de33990b3bcd4)
+}
+
+var fileDescriptor_d66de33990b3bcd4 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x72, 0xe2, 0x30,
+	0x10, 0xc6, 0x2d, 0x0e, 0x0c, 0x27, 0x8a, 0xbb, 0xd3, 0x70, 0x8c, 0x8e, 0xc2, 0xe7, 0xb9, 0xca,
+	0x95, 0x34, 0x5c, 0xde, 0x80, 0xd0, 0x90, 0x22, 0x85, 0xe9, 0xd2, 0x30, 0xb2, 0xac, 0x18, 0x4f,
+	0x8c, 0x97, 0x91, 0x84, 0x27, 0x79, 0x8b, 0xbc, 0x50, 0x7a, 0x4a, 0xca, 0x54, 0x99, 0x0c, 0xbc,
+	0x48, 0xc6, 0xff, 0x60, 0xd2, 0xa5, 0x92, 0x7e, 0xbb, 0xdf, 0xee, 0xce, 0x37, 0x1f, 0x0e, 0x32,
+	0x51, 0x88, 0x5c, 0x59, 0x5e, 0xbe, 0x5c, 0x42, 0x7e, 0x9f, 0xa5, 0xd2, 0x72, 0x93, 0x09, 0xb3,
+	0x5e, 0x69, 0x25, 0x41, 0xc7, 0x6c, 0xab, 0xc1, 0x02, 0xf9, 0xdd, 0x28, 0x59, 0xf9, 0xb2, 0x56,
+	0x39, 0x19, 0x25, 0x90, 0x40, 0xa5, 0xe0, 0xe5, 0xaf, 0x16, 0x4f, 0x3c, 0x09, 0x66, 0x03, 0x86,
+	0x47, 0xc2, 0x28, 0x5e, 0x4c, 0x23, 0x65, 0xc5, 0x94, 0x4b, 0x48, 0xf3, 0xba, 0xff, 0xef, 0xa5,
+	0x83, 0x87, 0xcb, 0xf2, 0x46, 0x58, 0x9d, 0x20, 0x13, 0x3c, 0xd8, 0x6a, 0x28, 0xd2, 0x58, 0x69,
+	0x8a, 0x7c, 0x14, 0x7c, 0x0f, 0xcf, 0x4c, 0x28, 0xee, 0xcb, 0xb5, 0x48, 0xf3, 0xc5, 0x9c, 0x76,
+	0xaa, 0x56, 0x8b, 0x64, 0x84, 0x7b, 0x51, 0x06, 0xf2, 0x81, 0x7e, 0xf3, 0x51, 0xd0, 0x0d, 0x6b,
+	0x20, 0x63, 0xec, 0x6a, 0x25, 0x0c, 0xe4, 0xb4, 0x5b, 0xc9, 0x1b, 0x2a, 0xeb, 0x05, 0x58, 0xb5,
+	0x98, 0xd3, 0x5e, 0x5d, 0xaf, 0x89, 0xdc, 0xe0, 0x9f, 0xed, 0xad, 0x55, 0xe5, 0x5b, 0xc5, 0xd4,
+	0xf5, 0x51, 0x30, 0xfc, 0xff, 0x87, 0xd5, 0x36, 0x58, 0x69, 0x83, 0x35, 0x36, 0xd8, 0x35, 0xa4,
+	0xf9, 0xac, 0xbb, 0x7f, 0xfb, 0xeb, 0x84, 0x3f, 0xda, 0xc1, 0x65, 0x3d, 0x47, 0x6e, 0x31, 0x89,
+	0x55, 0xa6, 0x12, 0x61, 0x41, 0x9b, 0xf3, 0xb6, 0xfe, 0xd7, 0xb6, 0xfd, 0xba, 0x8c, 0xb6, 0xfb,
+	0xc6, 0xd8, 0x8d, 0x76, 0x3a, 0x57, 0x31, 0x1d, 0xf8, 0x28, 0x18, 0x84, 0x0d, 0xcd, 0x66, 0xfb,
+	0xa3, 0x87, 0x0e, 0x47, 0x0f, 0xbd, 0x1f, 0x3d, 0xf4, 0x7c, 0xf2, 0x9c, 0xc3, 0xc9, 0x73, 0x5e,
+	0x4f, 0x9e, 0x73, 0x17, 0x24, 0xa9, 0x5d, 0xef, 0x22, 0x26, 0x61, 0xc3, 0x3f, 0x65, 0xfb, 0x78,
+	0x49, 0xd7, 0x3e, 0x6d, 0x95, 0x89, 0xdc, 0x2a, 0x8a, 0xab, 0x8f, 0x01, 0x00, 0x1a, 0x50, 0x3c,
+	0x57, 0x03, 0x02, 0x00, 0x00,
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burned {
+		i--
+		if m.Burned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.DelegatorsSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ProviderSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != 0 {
+		i = encodeVarintSlashRecord(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintSlashRecord(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovSlashRecord(uint64(m.Block))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovSlashRecord(uint64(l))
+	}
+	l = m.ProviderSlashed.Size()
+	n += 1 + l + sovSlashRecord(uint64(l))
+	l = m.DelegatorsSlashed.Size()
+	n += 1 + l + sovSlashRecord(uint64(l))
+	if m.Burned {
+		n += 2
+	}
+	return n
+}
+
+func sovSlashRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashRecord(x uint64) (n int) {
+	return sovSlashRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorsSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorsSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,6 +28,7 @@ const (
 	ConflictVoteGotCommitEventName     = "conflict_vote_got_commit"
 	ConflictVoteGotRevealEventName     = "conflict_vote_got_reveal"
 	ConflictUnstakeFraudVoterEventName = "conflict_unstake_fraud_voter"
+	ConflictProviderSlashedEventName   = "conflict_provider_slashed"
 )
 
 // unstake description
@@ -35,6 +36,17 @@ const (
 	UnstakeDescriptionFraudVote = "fraud provider found in conflict detection"
 )
 
+// slash reasons
+const (
+	SlashReasonResponseConflict     = "lost response conflict vote"
+	SlashReasonFinalizationConflict = "signed conflicting finalization data"
+)
+
+// jail reasons
+const (
+	JailReasonNoVote = "did not vote in conflict"
+)
+
 func CommitVoteData(nonce int64, dataHash []byte, providerAddress string) []byte {
 	commitData := sigs.EncodeUint64(uint64(nonce))
 	commitData = append(commitData, dataHash...)
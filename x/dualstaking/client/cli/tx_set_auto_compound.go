@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [provider] [chain-id] [true/false] --from <delegator>",
+		Short: "enable/disable auto-compounding of the rewards of a delegation",
+		Long: `enable/disable auto-compounding of the rewards of a delegation. When enabled, the delegator's 
+		rewards from the provider are re-delegated to the same provider and chain ID on each rewards distribution
+		(up to the provider's delegation limit; the rest of the rewards can be claimed as usual)`,
+		Example: `lavad tx dualstaking set-auto-compound lava@12345 ETH1 true --from <delegator>`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			provider := args[0]
+			chainID := args[1]
+			enable, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(
+				clientCtx.GetFromAddress().String(),
+				provider,
+				chainID,
+				enable,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// SetAutoCompound enables/disables the auto-compounding of a delegation's rewards. When enabled,
+// the delegator's rewards are re-delegated to the same provider and chain on each rewards
+// distribution (see compoundDelegatorReward).
+// (effective on next epoch)
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delegator, provider, chainID string, enable bool) error {
+	if delegator == provider {
+		return utils.LavaFormatWarning("cannot set auto-compound", types.ErrAutoCompoundSelfDelegation,
+			utils.Attribute{Key: "provider", Value: provider},
+		)
+	}
+
+	nextEpoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+
+	var delegationEntry types.Delegation
+	index := types.DelegationKey(provider, delegator, chainID)
+	found := k.delegationFS.FindEntry(ctx, index, nextEpoch, &delegationEntry)
+	if !found {
+		return utils.LavaFormatWarning("cannot set auto-compound", types.ErrDelegationNotFound,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	if delegationEntry.AutoCompound == enable {
+		return nil
+	}
+
+	delegationEntry.AutoCompound = enable
+	err := k.delegationFS.AppendEntry(ctx, index, nextEpoch, &delegationEntry)
+	if err != nil {
+		// append should never fail here
+		return utils.LavaFormatError("critical: append delegation entry", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
+	return nil
+}
+
+// compoundDelegatorReward re-delegates the reward of a delegation with auto-compound to the
+// same provider and chain. The compounded amount is capped so the provider's total delegations
+// will not exceed its delegate limit (delegations above the limit do not earn rewards); the
+// rest of the reward remains claimable. Like any delegation, the compounded amount is also
+// delegated to a validator (the delegator's validator with the largest delegation).
+func (k Keeper) compoundDelegatorReward(ctx sdk.Context, delegation types.Delegation) {
+	rewardMapKey := types.DelegationKey(delegation.Provider, delegation.Delegator, delegation.ChainID)
+	delegatorReward, found := k.GetDelegatorReward(ctx, rewardMapKey)
+	if !found || delegatorReward.Amount.IsZero() {
+		return
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(delegation.Provider)
+	if err != nil {
+		return
+	}
+
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, delegation.ChainID, providerAddr)
+	if !found {
+		return
+	}
+
+	amount := math.MinInt(delegatorReward.Amount.Amount, stakeEntry.DelegateLimit.Amount.Sub(stakeEntry.DelegateTotal.Amount))
+	if !amount.IsPositive() {
+		return
+	}
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegation.Delegator)
+	if err != nil {
+		return
+	}
+
+	var validator string
+	largest := math.ZeroInt()
+	for _, d := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegatorAddr) {
+		val, found := k.stakingKeeper.GetValidator(ctx, d.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		if tokens := val.TokensFromShares(d.Shares).TruncateInt(); tokens.GT(largest) {
+			largest = tokens
+			validator = d.ValidatorAddress
+		}
+	}
+	if validator == "" {
+		utils.LavaFormatWarning("cannot compound delegator reward: no validator delegation", nil,
+			utils.Attribute{Key: "delegator", Value: delegation.Delegator},
+			utils.Attribute{Key: "provider", Value: delegation.Provider},
+		)
+		return
+	}
+
+	// the reward is delegated like a regular delegation: it is sent to the delegator
+	// and then delegated from its account (use a cache context so that a failure will
+	// leave the reward claimable)
+	compound := sdk.NewCoin(delegatorReward.Amount.Denom, amount)
+	cacheCtx, writeCache := ctx.CacheContext()
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, delegatorAddr, sdk.NewCoins(compound))
+	if err == nil {
+		err = k.DelegateFull(cacheCtx, delegation.Delegator, validator, delegation.Provider, delegation.ChainID, compound)
+	}
+	if err != nil {
+		utils.LavaFormatError("failed to compound delegator reward", err,
+			utils.Attribute{Key: "delegator", Value: delegation.Delegator},
+			utils.Attribute{Key: "provider", Value: delegation.Provider},
+			utils.Attribute{Key: "chainID", Value: delegation.ChainID},
+			utils.Attribute{Key: "amount", Value: compound.String()},
+		)
+		return
+	}
+	writeCache()
+
+	delegatorReward.Amount = delegatorReward.Amount.Sub(compound)
+	if delegatorReward.Amount.IsZero() {
+		k.RemoveDelegatorReward(ctx, rewardMapKey)
+	} else {
+		k.SetDelegatorReward(ctx, delegatorReward)
+	}
+
+	details := map[string]string{
+		"delegator": delegation.Delegator,
+		"provider":  delegation.Provider,
+		"chainID":   delegation.ChainID,
+		"amount":    compound.String(),
+		"leftover":  delegatorReward.Amount.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.CompoundRewardsEventName, details, "Delegator reward compounded")
+}
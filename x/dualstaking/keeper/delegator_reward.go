@@ -252,6 +252,11 @@ func (k Keeper) rewardDelegator(ctx sdk.Context, delegation types.Delegation, am
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount)))
 	if err != nil {
 		utils.LavaFormatError("failed to send rewards to module", err, utils.LogAttr("sender", senderModule), utils.LogAttr("amount", amount.String()))
+		return
+	}
+
+	if delegation.AutoCompound && delegation.Delegator != delegation.Provider {
+		k.compoundDelegatorReward(ctx, delegation)
 	}
 }
 
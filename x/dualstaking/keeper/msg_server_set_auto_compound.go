@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return &types.MsgSetAutoCompoundResponse{}, err
+	}
+
+	err := k.Keeper.SetAutoCompound(ctx, msg.Creator, msg.Provider, msg.ChainID, msg.Enable)
+	if err == nil {
+		logger := k.Keeper.Logger(ctx)
+		details := map[string]string{
+			"delegator": msg.Creator,
+			"provider":  msg.Provider,
+			"chainID":   msg.ChainID,
+			"enable":    strconv.FormatBool(msg.Enable),
+		}
+		utils.LogLavaEvent(ctx, logger, types.AutoCompoundEventName, details, "Set Auto Compound")
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, err
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// SlashDelegations slashes the delegations of a provider on a specific chain: the provider's
+// self delegation is slashed by providerFraction and the delegations of all other delegators
+// by delegatorsFraction. Each slashed amount is removed from the provider (like an unbond)
+// and the delegator's validators delegations are reduced accordingly (without an unbonding
+// period). The slashed funds are transferred to the recipient module, which may then burn
+// or redistribute them.
+func (k Keeper) SlashDelegations(ctx sdk.Context, provider, chainID string, providerFraction, delegatorsFraction sdk.Dec, recipientModule string) (providerSlashed math.Int, delegatorsSlashed math.Int, err error) {
+	providerSlashed, delegatorsSlashed = math.ZeroInt(), math.ZeroInt()
+
+	epoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	delegations, err := k.GetProviderDelegators(ctx, provider, epoch)
+	if err != nil {
+		return providerSlashed, delegatorsSlashed, err
+	}
+
+	for _, delegation := range delegations {
+		if delegation.ChainID != chainID {
+			continue
+		}
+
+		fraction := delegatorsFraction
+		if delegation.Delegator == provider {
+			fraction = providerFraction
+		}
+
+		amount := fraction.MulInt(delegation.Amount.Amount).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		// slash each delegation separately so a failure in one of them will not leave
+		// the delegations and validators delegations of that delegator unbalanced
+		cacheCtx, writeCache := ctx.CacheContext()
+		slashed, err := k.slashDelegation(cacheCtx, delegation.Delegator, provider, chainID, sdk.NewCoin(delegation.Amount.Denom, amount), recipientModule)
+		if err != nil {
+			utils.LavaFormatError("failed to slash delegation", err,
+				utils.Attribute{Key: "delegator", Value: delegation.Delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "amount", Value: amount.String()},
+			)
+			continue
+		}
+		writeCache()
+
+		if delegation.Delegator == provider {
+			providerSlashed = providerSlashed.Add(slashed)
+		} else {
+			delegatorsSlashed = delegatorsSlashed.Add(slashed)
+		}
+	}
+
+	details := map[string]string{
+		"provider":            provider,
+		"chainID":             chainID,
+		"provider_fraction":   providerFraction.String(),
+		"delegators_fraction": delegatorsFraction.String(),
+		"provider_slashed":    providerSlashed.String(),
+		"delegators_slashed":  delegatorsSlashed.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderSlashEventName, details, "Provider delegations slashed")
+
+	return providerSlashed, delegatorsSlashed, nil
+}
+
+// slashDelegation removes an amount from a delegator's delegation to a provider, and removes
+// the same amount from the delegator's validators delegations. Like UnbondFull, the amount
+// is first moved to the empty provider so that the staking hooks will unbond it from there.
+// Unlike UnbondFull, the tokens are not returned to the delegator but transferred to the
+// recipient module.
+func (k Keeper) slashDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, recipientModule string) (math.Int, error) {
+	slashed := math.ZeroInt()
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return slashed, err
+	}
+
+	err = k.Redelegate(
+		ctx,
+		delegator,
+		provider,
+		types.EMPTY_PROVIDER,
+		chainID,
+		types.EMPTY_PROVIDER_CHAINID,
+		amount,
+	)
+	if err != nil {
+		return slashed, err
+	}
+
+	remaining := amount.Amount
+	for _, d := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegatorAddress) {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, d.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		tokens := math.MinInt(remaining, validator.TokensFromShares(d.Shares).TruncateInt())
+		if !tokens.IsPositive() {
+			continue
+		}
+
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegatorAddress, d.GetValidatorAddr(), tokens)
+		if err != nil {
+			return slashed, err
+		}
+
+		unbonded, err := k.stakingKeeper.Unbond(ctx, delegatorAddress, d.GetValidatorAddr(), shares)
+		if err != nil {
+			return slashed, err
+		}
+
+		// Unbond() does not move the tokens between the staking pools, so they are
+		// still held in the pool that matches the validator's status
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+
+		if unbonded.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(amount.Denom, unbonded))
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, recipientModule, coins); err != nil {
+				return slashed, err
+			}
+		}
+
+		slashed = slashed.Add(unbonded)
+		remaining = remaining.Sub(tokens)
+	}
+
+	if remaining.IsPositive() {
+		return slashed, utils.LavaFormatError("insufficient validators delegations to slash", types.ErrInsufficientDelegation,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "remaining", Value: remaining.String()},
+		)
+	}
+
+	return slashed, nil
+}
@@ -25,11 +25,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Delegation struct {
-	Provider  string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	ChainID   string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Delegator string     `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
-	Timestamp int64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Provider     string     `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID      string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Delegator    string     `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Timestamp    int64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AutoCompound bool       `protobuf:"varint,6,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *Delegation) Reset()         { *m = Delegation{} }
@@ -100,6 +101,13 @@ func (m *Delegation) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *Delegation) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
 type Delegator struct {
 	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
@@ -154,27 +162,28 @@ func init() {
 }
 
 var fileDescriptor_547eac7f30bf94d4 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x5a, 0x0a, 0x31, 0x5b, 0xc4, 0x60, 0xaa, 0xca, 0x44, 0x5d, 0x28, 0x42, 0xb2,
-	0x55, 0x18, 0xd8, 0x4b, 0x19, 0x58, 0x3b, 0xb2, 0x39, 0xa9, 0x95, 0x5a, 0x34, 0xbe, 0x28, 0x76,
-	0x23, 0x78, 0x0b, 0xde, 0x86, 0x57, 0xe8, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0x93, 0x34,
-	0xa5, 0xd3, 0xf9, 0xfe, 0xff, 0x97, 0xef, 0xd3, 0x1d, 0xbe, 0x59, 0x8b, 0x42, 0x68, 0x69, 0xb9,
-	0xab, 0x7c, 0xb9, 0x11, 0x6b, 0x63, 0xc5, 0x9b, 0xd2, 0x09, 0x5f, 0xca, 0xb5, 0x4c, 0x84, 0x95,
-	0x2c, 0xcb, 0xc1, 0x42, 0x40, 0xda, 0x20, 0x73, 0x95, 0xfd, 0x0b, 0x0e, 0x2f, 0x13, 0x48, 0xa0,
-	0x0e, 0x71, 0xf7, 0x6a, 0xf2, 0x43, 0x1a, 0x83, 0x49, 0xc1, 0xf0, 0x48, 0x18, 0xc9, 0x8b, 0x69,
-	0x24, 0xad, 0x98, 0xf2, 0x18, 0x94, 0x6e, 0xfc, 0xf1, 0x17, 0xc2, 0x78, 0xde, 0x8c, 0x50, 0xa0,
-	0x83, 0x21, 0x3e, 0xcf, 0x72, 0x28, 0xd4, 0x52, 0xe6, 0x04, 0x85, 0x68, 0xe2, 0x2f, 0xba, 0x3e,
-	0x20, 0xf8, 0x2c, 0x5e, 0x09, 0xa5, 0x5f, 0xe6, 0xe4, 0xa4, 0xb6, 0xf6, 0x6d, 0x30, 0xc2, 0x7e,
-	0x8b, 0x09, 0x39, 0xe9, 0xd5, 0xde, 0x41, 0x08, 0x1e, 0xf1, 0x40, 0xa4, 0xb0, 0xd1, 0x96, 0xf4,
-	0x43, 0x34, 0xb9, 0xb8, 0xbf, 0x62, 0x0d, 0x13, 0x73, 0x4c, 0xac, 0x65, 0x62, 0x4f, 0xa0, 0xf4,
-	0xac, 0xbf, 0xfd, 0xb9, 0xf6, 0x16, 0x6d, 0xdc, 0x7d, 0x6b, 0x55, 0x2a, 0x8d, 0x15, 0x69, 0x46,
-	0x4e, 0x43, 0x34, 0xe9, 0x2d, 0x0e, 0xc2, 0xf8, 0x16, 0xfb, 0xf3, 0x6e, 0xc6, 0x08, 0xfb, 0x7b,
-	0x4e, 0x43, 0x50, 0xd8, 0x73, 0x04, 0x9d, 0x30, 0x7b, 0xde, 0x96, 0x14, 0xed, 0x4a, 0x8a, 0x7e,
-	0x4b, 0x8a, 0x3e, 0x2b, 0xea, 0xed, 0x2a, 0xea, 0x7d, 0x57, 0xd4, 0x7b, 0xbd, 0x4b, 0x94, 0x5d,
-	0x6d, 0x22, 0x16, 0x43, 0xca, 0x8f, 0x4e, 0xf0, 0x7e, 0x74, 0x04, 0xfb, 0x91, 0x49, 0x13, 0x0d,
-	0xea, 0x95, 0x3d, 0xfc, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xa0, 0xb8, 0x13, 0xad, 0x01, 0x00,
-	0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0xdb, 0xde, 0xde, 0xc6, 0x17, 0x96, 0x88, 0xc1, 0x54, 0x55, 0x88, 0xca, 0x40,
+	0x10, 0x92, 0xad, 0xc2, 0xc0, 0xde, 0x96, 0x81, 0x35, 0x23, 0x0b, 0x72, 0x12, 0x2b, 0xb5, 0x68,
+	0x7c, 0xa2, 0xd8, 0xa9, 0xe0, 0x2d, 0x78, 0xac, 0x8e, 0x1d, 0x19, 0x10, 0x42, 0xed, 0x8b, 0x20,
+	0x27, 0x69, 0x4b, 0xa7, 0xe3, 0xf3, 0xff, 0xbf, 0xec, 0xcf, 0xe7, 0xe0, 0xab, 0x05, 0x5f, 0x72,
+	0x25, 0x0c, 0xb3, 0x95, 0xa5, 0x15, 0x5f, 0x68, 0xc3, 0x5f, 0xa4, 0xca, 0x58, 0x2a, 0x16, 0x22,
+	0xe3, 0x46, 0xd0, 0xa2, 0x04, 0x03, 0x1e, 0x69, 0x83, 0xd4, 0x56, 0xfa, 0x2b, 0x38, 0x38, 0xcb,
+	0x20, 0x83, 0x3a, 0xc4, 0xec, 0xa9, 0xc9, 0x0f, 0xfc, 0x04, 0x74, 0x0e, 0x9a, 0xc5, 0x5c, 0x0b,
+	0xb6, 0x1c, 0xc7, 0xc2, 0xf0, 0x31, 0x4b, 0x40, 0xaa, 0xc6, 0x1f, 0x7d, 0x22, 0x8c, 0x67, 0xcd,
+	0x13, 0x12, 0x94, 0x37, 0xc0, 0xfd, 0xa2, 0x84, 0xa5, 0x4c, 0x45, 0x49, 0x50, 0x80, 0x42, 0x37,
+	0xda, 0xf7, 0x1e, 0xc1, 0xff, 0x92, 0x39, 0x97, 0xea, 0x71, 0x46, 0xfe, 0xd4, 0xd6, 0xae, 0xf5,
+	0x86, 0xd8, 0x6d, 0x31, 0xa1, 0x24, 0x9d, 0xda, 0x3b, 0x08, 0xde, 0x3d, 0xee, 0xf1, 0x1c, 0x2a,
+	0x65, 0x48, 0x37, 0x40, 0xe1, 0xff, 0xdb, 0x73, 0xda, 0x30, 0x51, 0xcb, 0x44, 0x5b, 0x26, 0x3a,
+	0x05, 0xa9, 0x26, 0xdd, 0xd5, 0xd7, 0x85, 0x13, 0xb5, 0x71, 0x7b, 0xad, 0x91, 0xb9, 0xd0, 0x86,
+	0xe7, 0x05, 0xf9, 0x1b, 0xa0, 0xb0, 0x13, 0x1d, 0x04, 0xef, 0x12, 0x9f, 0xf2, 0xca, 0xc0, 0x73,
+	0x02, 0x79, 0x01, 0x95, 0x4a, 0x49, 0x2f, 0x40, 0x61, 0x3f, 0x3a, 0xb1, 0xe2, 0xb4, 0xd5, 0x46,
+	0xd7, 0xd8, 0x9d, 0xed, 0x41, 0x86, 0xd8, 0xdd, 0x7d, 0x46, 0x13, 0x14, 0x74, 0x2c, 0xe6, 0x5e,
+	0x98, 0x3c, 0xac, 0x36, 0x3e, 0x5a, 0x6f, 0x7c, 0xf4, 0xbd, 0xf1, 0xd1, 0xfb, 0xd6, 0x77, 0xd6,
+	0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0xce, 0xd3, 0x4d, 0x26, 0xcd, 0xbc, 0x8a, 0x69, 0x02, 0x39, 0x3b,
+	0xda, 0xd3, 0xeb, 0xd1, 0xa6, 0xcc, 0x5b, 0x21, 0x74, 0xdc, 0xab, 0xe7, 0x7a, 0xf7, 0x33, 0x00,
+	0x64, 0x2d, 0xf4, 0x15, 0xd2, 0x01, 0x00, 0x00,
 }
 
 func (m *Delegation) Marshal() (dAtA []byte, err error) {
@@ -197,6 +206,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintDelegate(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -302,6 +321,9 @@ func (m *Delegation) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovDelegate(uint64(m.Timestamp))
 	}
+	if m.AutoCompound {
+		n += 2
+	}
 	return n
 }
 
@@ -503,6 +525,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegate(dAtA[iNdEx:])
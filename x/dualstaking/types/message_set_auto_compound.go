@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAutoCompound = "set_auto_compound"
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+func NewMsgSetAutoCompound(delegator string, provider string, chainID string, enable bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Creator:  delegator,
+		Provider: provider,
+		ChainID:  chainID,
+		Enable:   enable,
+	}
+}
+
+func (msg *MsgSetAutoCompound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+func (msg *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg *MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if msg.ChainID == "" {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "empty chain ID")
+	}
+
+	return nil
+}
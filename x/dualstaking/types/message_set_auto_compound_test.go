@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetAutoCompound_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetAutoCompound
+		err  error
+	}{
+		{
+			name: "invalid delegator address",
+			msg: MsgSetAutoCompound{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+				ChainID:  "LAV1",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider address",
+			msg: MsgSetAutoCompound{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+				ChainID:  "LAV1",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "empty chain ID",
+			msg: MsgSetAutoCompound{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgSetAutoCompound{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+				ChainID:  "LAV1",
+				Enable:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -461,6 +461,110 @@ func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
 
+type MsgSetAutoCompound struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Enable   bool   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{8}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c4c178d368211c, []int{9}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDelegate)(nil), "lavanet.lava.dualstaking.MsgDelegate")
 	proto.RegisterType((*MsgDelegateResponse)(nil), "lavanet.lava.dualstaking.MsgDelegateResponse")
@@ -470,44 +574,50 @@ func init() {
 	proto.RegisterType((*MsgUnbondResponse)(nil), "lavanet.lava.dualstaking.MsgUnbondResponse")
 	proto.RegisterType((*MsgClaimRewards)(nil), "lavanet.lava.dualstaking.MsgClaimRewards")
 	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "lavanet.lava.dualstaking.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "lavanet.lava.dualstaking.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "lavanet.lava.dualstaking.MsgSetAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/dualstaking/tx.proto", fileDescriptor_29c4c178d368211c) }
 
 var fileDescriptor_29c4c178d368211c = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x12, 0x42, 0x33, 0x69, 0x85, 0x70, 0xa9, 0xea, 0x5a, 0xe0, 0xb6, 0xa9, 0x10,
-	0x45, 0x15, 0x6b, 0xa5, 0x1c, 0xb8, 0x37, 0x45, 0x88, 0x83, 0x25, 0x64, 0x89, 0x4b, 0x2f, 0x65,
-	0x1d, 0x6f, 0xb7, 0x16, 0xb6, 0x27, 0xf2, 0x6e, 0x42, 0x79, 0x0b, 0xae, 0xbc, 0x06, 0xe2, 0x21,
-	0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x8d, 0xa7, 0x40, 0xfe, 0xda, 0x38, 0x41, 0x98, 0x70, 0xec,
-	0x69, 0x77, 0x67, 0x7f, 0xf3, 0xf1, 0x9f, 0xfd, 0x80, 0xfd, 0x90, 0x4e, 0x68, 0xcc, 0xa4, 0x9d,
-	0x8e, 0xb6, 0x3f, 0xa6, 0xa1, 0x90, 0xf4, 0x43, 0x10, 0x73, 0x5b, 0x5e, 0x91, 0x51, 0x82, 0x12,
-	0x75, 0xa3, 0x40, 0x48, 0x3a, 0x92, 0x0a, 0x62, 0x5a, 0x43, 0x14, 0x11, 0x0a, 0xdb, 0xa3, 0x82,
-	0xd9, 0x93, 0xbe, 0xc7, 0x24, 0xed, 0xdb, 0x43, 0x0c, 0xe2, 0xdc, 0xd3, 0x7c, 0xc8, 0x91, 0x63,
-	0x36, 0xb5, 0xd3, 0x59, 0x6e, 0xed, 0x7d, 0xd3, 0xa0, 0xeb, 0x08, 0x7e, 0xca, 0x42, 0xc6, 0xa9,
-	0x64, 0xba, 0x01, 0xf7, 0x86, 0x09, 0xa3, 0x12, 0x13, 0x43, 0xdb, 0xd3, 0x0e, 0x3b, 0x6e, 0xb9,
-	0xd4, 0x1f, 0x41, 0x67, 0x42, 0xc3, 0xc0, 0xcf, 0xf6, 0xee, 0x66, 0x7b, 0x73, 0x83, 0x6e, 0xc2,
-	0xda, 0x28, 0xc1, 0x49, 0xe0, 0xb3, 0xc4, 0xb8, 0x93, 0x6d, 0xaa, 0x75, 0x16, 0xf3, 0x92, 0x06,
-	0xf1, 0x9b, 0x53, 0xa3, 0x59, 0xc4, 0xcc, 0x97, 0xfa, 0x4b, 0x68, 0xd3, 0x08, 0xc7, 0xb1, 0x34,
-	0x5a, 0x7b, 0xda, 0x61, 0xf7, 0x78, 0x87, 0xe4, 0x22, 0x48, 0x2a, 0x82, 0x14, 0x22, 0xc8, 0x00,
-	0x83, 0xf8, 0xa4, 0x75, 0xfd, 0x63, 0xb7, 0xe1, 0x16, 0x78, 0x6f, 0x0b, 0x36, 0x2b, 0x55, 0xbb,
-	0x4c, 0x8c, 0x30, 0x16, 0xac, 0xf7, 0x4b, 0x83, 0x0d, 0x47, 0x70, 0x97, 0xf9, 0xff, 0xd6, 0x73,
-	0x00, 0x1b, 0x17, 0x09, 0x46, 0xe7, 0x4b, 0x65, 0xaf, 0xa7, 0xc6, 0xb7, 0x65, 0xe9, 0xbb, 0xd0,
-	0x95, 0x38, 0x47, 0xf2, 0xf2, 0x41, 0xa2, 0x02, 0xf6, 0x21, 0x73, 0x38, 0x2f, 0x05, 0xb6, 0x32,
-	0xa2, 0x9b, 0xda, 0x06, 0x85, 0xc8, 0xc7, 0x00, 0x12, 0x15, 0x50, 0x74, 0x4e, 0xe2, 0xe0, 0x8f,
-	0x1e, 0xb4, 0xff, 0xaf, 0x07, 0xdb, 0xb0, 0xb5, 0xa0, 0x55, 0x75, 0xe1, 0xab, 0x06, 0x1d, 0x47,
-	0xf0, 0x77, 0xb1, 0x87, 0xb1, 0x7f, 0x5b, 0x4e, 0x74, 0x13, 0x1e, 0xa8, 0x9a, 0x95, 0x92, 0xd7,
-	0x70, 0xdf, 0x11, 0x7c, 0x10, 0xd2, 0x20, 0x72, 0xd9, 0x47, 0x9a, 0xf8, 0xa2, 0x46, 0x4e, 0x4d,
-	0xc1, 0xbd, 0x1d, 0xd8, 0x5e, 0x0a, 0x54, 0xe6, 0x38, 0xfe, 0xd2, 0x84, 0xa6, 0x23, 0xb8, 0xfe,
-	0x1e, 0xd6, 0xd4, 0x2b, 0x78, 0x42, 0xfe, 0xf6, 0xcc, 0x48, 0xe5, 0xda, 0x99, 0xcf, 0x57, 0xc2,
-	0xca, 0x4c, 0xfa, 0x05, 0x40, 0xe5, 0x66, 0x3e, 0xad, 0x75, 0x9e, 0x83, 0xa6, 0xbd, 0x22, 0xa8,
-	0xf2, 0x9c, 0x41, 0xbb, 0x38, 0xfb, 0x83, 0x5a, 0xd7, 0x1c, 0x32, 0x8f, 0x56, 0x80, 0x54, 0xec,
-	0x10, 0xd6, 0x17, 0x8e, 0xe3, 0x59, 0xad, 0x73, 0x15, 0x35, 0xfb, 0x2b, 0xa3, 0x65, 0xb6, 0x93,
-	0x57, 0xd7, 0x53, 0x4b, 0xbb, 0x99, 0x5a, 0xda, 0xcf, 0xa9, 0xa5, 0x7d, 0x9e, 0x59, 0x8d, 0x9b,
-	0x99, 0xd5, 0xf8, 0x3e, 0xb3, 0x1a, 0x67, 0x47, 0x3c, 0x90, 0x97, 0x63, 0x8f, 0x0c, 0x31, 0xb2,
-	0x17, 0x7e, 0xcd, 0xab, 0xc5, 0x7f, 0xf3, 0xd3, 0x88, 0x09, 0xaf, 0x9d, 0xfd, 0x75, 0x2f, 0x7e,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xba, 0x8a, 0xf3, 0x60, 0x05, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x1a, 0x42, 0x33, 0x69, 0x55, 0xe1, 0x52, 0xea, 0x5a, 0xc5, 0x6d, 0x53, 0x21,
+	0x8a, 0x0a, 0xb6, 0x52, 0x90, 0x38, 0xd3, 0x14, 0x21, 0x0e, 0x96, 0x90, 0x11, 0x97, 0x5e, 0xca,
+	0x3a, 0xde, 0xba, 0x16, 0xf6, 0x4e, 0xe4, 0x5d, 0x87, 0x22, 0xf1, 0x10, 0x7d, 0x16, 0xc4, 0x43,
+	0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x1b, 0x4f, 0x81, 0xfc, 0xb5, 0xf9, 0xa8, 0x30, 0x29, 0x37,
+	0x4e, 0xf6, 0xcc, 0xfe, 0xe6, 0xe3, 0x3f, 0xde, 0xf5, 0xc2, 0x4e, 0x48, 0x06, 0x84, 0x51, 0x61,
+	0xa5, 0x4f, 0xcb, 0x4b, 0x48, 0xc8, 0x05, 0xf9, 0x18, 0x30, 0xdf, 0x12, 0xe7, 0x66, 0x3f, 0x46,
+	0x81, 0xaa, 0x56, 0x20, 0x66, 0xfa, 0x34, 0x27, 0x10, 0xdd, 0xe8, 0x21, 0x8f, 0x90, 0x5b, 0x2e,
+	0xe1, 0xd4, 0x1a, 0x74, 0x5c, 0x2a, 0x48, 0xc7, 0xea, 0x61, 0xc0, 0xf2, 0x48, 0xfd, 0x9e, 0x8f,
+	0x3e, 0x66, 0xaf, 0x56, 0xfa, 0x96, 0x7b, 0xdb, 0xdf, 0x14, 0x68, 0xd9, 0xdc, 0x3f, 0xa2, 0x21,
+	0xf5, 0x89, 0xa0, 0xaa, 0x06, 0x77, 0x7a, 0x31, 0x25, 0x02, 0x63, 0x4d, 0xd9, 0x56, 0xf6, 0x9a,
+	0x4e, 0x69, 0xaa, 0x9b, 0xd0, 0x1c, 0x90, 0x30, 0xf0, 0xb2, 0xb5, 0xdb, 0xd9, 0xda, 0xd8, 0xa1,
+	0xea, 0xb0, 0xd8, 0x8f, 0x71, 0x10, 0x78, 0x34, 0xd6, 0x6e, 0x65, 0x8b, 0xd2, 0xce, 0x72, 0x9e,
+	0x91, 0x80, 0xbd, 0x39, 0xd2, 0x16, 0x8a, 0x9c, 0xb9, 0xa9, 0xbe, 0x80, 0x06, 0x89, 0x30, 0x61,
+	0x42, 0xab, 0x6f, 0x2b, 0x7b, 0xad, 0x83, 0x0d, 0x33, 0x17, 0x61, 0xa6, 0x22, 0xcc, 0x42, 0x84,
+	0xd9, 0xc5, 0x80, 0x1d, 0xd6, 0x2f, 0x7f, 0x6c, 0xd5, 0x9c, 0x02, 0x6f, 0xaf, 0xc1, 0xea, 0x44,
+	0xd7, 0x0e, 0xe5, 0x7d, 0x64, 0x9c, 0xb6, 0x7f, 0x29, 0xb0, 0x6c, 0x73, 0xdf, 0xa1, 0xde, 0xdf,
+	0xf5, 0xec, 0xc2, 0xf2, 0x69, 0x8c, 0xd1, 0xc9, 0x4c, 0xdb, 0x4b, 0xa9, 0xf3, 0x6d, 0xd9, 0xfa,
+	0x16, 0xb4, 0x04, 0x8e, 0x91, 0xbc, 0x7d, 0x10, 0x28, 0x81, 0x1d, 0xc8, 0x02, 0x4e, 0x4a, 0x81,
+	0xf5, 0x8c, 0x68, 0xa5, 0xbe, 0x6e, 0x21, 0xf2, 0x01, 0x80, 0x40, 0x09, 0x14, 0x93, 0x13, 0xd8,
+	0xbd, 0x36, 0x83, 0xc6, 0xcd, 0x66, 0xb0, 0x0e, 0x6b, 0x53, 0x5a, 0xe5, 0x14, 0xbe, 0x2a, 0xd0,
+	0xb4, 0xb9, 0xff, 0x9e, 0xb9, 0xc8, 0xbc, 0xff, 0xe5, 0x8b, 0xae, 0xc2, 0x5d, 0xd9, 0xb3, 0x54,
+	0xf2, 0x1a, 0x56, 0x6c, 0xee, 0x77, 0x43, 0x12, 0x44, 0x0e, 0xfd, 0x44, 0x62, 0x8f, 0x57, 0xc8,
+	0xa9, 0x68, 0xb8, 0xbd, 0x01, 0xeb, 0x33, 0x89, 0x64, 0x8d, 0x2f, 0xa0, 0xda, 0xdc, 0x7f, 0x47,
+	0xc5, 0xcb, 0x44, 0x60, 0x17, 0xa3, 0x3e, 0x26, 0xcc, 0xfb, 0xb7, 0x32, 0x15, 0x73, 0xb9, 0x0f,
+	0x0d, 0xca, 0x88, 0x1b, 0xd2, 0x6c, 0x2e, 0x8b, 0x4e, 0x61, 0xb5, 0x37, 0x41, 0xbf, 0x5e, 0xbd,
+	0xec, 0xed, 0xe0, 0xa2, 0x0e, 0x0b, 0x36, 0xf7, 0xd5, 0x0f, 0xb0, 0x28, 0x4f, 0xe8, 0x43, 0xf3,
+	0x4f, 0xbf, 0x00, 0x73, 0xe2, 0x48, 0xe8, 0x4f, 0xe7, 0xc2, 0xca, 0x4a, 0xea, 0x29, 0xc0, 0xc4,
+	0xa9, 0x79, 0x54, 0x19, 0x3c, 0x06, 0x75, 0x6b, 0x4e, 0x50, 0xd6, 0x39, 0x86, 0x46, 0xb1, 0x2f,
+	0x77, 0x2b, 0x43, 0x73, 0x48, 0xdf, 0x9f, 0x03, 0x92, 0xb9, 0x43, 0x58, 0x9a, 0xda, 0x2a, 0x8f,
+	0x2b, 0x83, 0x27, 0x51, 0xbd, 0x33, 0x37, 0x2a, 0xab, 0x25, 0xb0, 0x32, 0xbb, 0x69, 0x9e, 0x54,
+	0x66, 0x99, 0xa1, 0xf5, 0xe7, 0x37, 0xa1, 0xcb, 0xb2, 0x87, 0xaf, 0x2e, 0x87, 0x86, 0x72, 0x35,
+	0x34, 0x94, 0x9f, 0x43, 0x43, 0xb9, 0x18, 0x19, 0xb5, 0xab, 0x91, 0x51, 0xfb, 0x3e, 0x32, 0x6a,
+	0xc7, 0xfb, 0x7e, 0x20, 0xce, 0x12, 0xd7, 0xec, 0x61, 0x64, 0x4d, 0x5d, 0x24, 0xe7, 0xd3, 0x57,
+	0xc9, 0xe7, 0x3e, 0xe5, 0x6e, 0x23, 0xfb, 0xfd, 0x3f, 0xfb, 0x3d, 0x00, 0x01, 0x4d, 0x5c, 0x55,
+	0x73, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -526,6 +636,7 @@ type MsgClient interface {
 	Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
 	Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error)
 	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -572,12 +683,22 @@ func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.dualstaking.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
 	Redelegate(context.Context, *MsgRedelegate) (*MsgRedelegateResponse, error)
 	Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error)
 	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -596,6 +717,9 @@ func (*UnimplementedMsgServer) Unbond(ctx context.Context, req *MsgUnbond) (*Msg
 func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -673,6 +797,24 @@ func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.dualstaking.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.dualstaking.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -693,6 +835,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRewards",
 			Handler:    _Msg_ClaimRewards_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/dualstaking/tx.proto",
@@ -1017,6 +1163,83 @@ func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1166,6 +1389,39 @@ func (m *MsgClaimRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2151,6 +2407,222 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,8 +5,11 @@ const (
 	UnbondingEventName         = "unbond_from_provider"
 	RedelegateEventName        = "redelegate_between_providers"
 	ClaimRewardsEventName      = "delegator_claim_rewards"
+	AutoCompoundEventName      = "delegator_set_auto_compound"
+	CompoundRewardsEventName   = "delegator_compound_rewards"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
+	ProviderSlashEventName     = "provider_delegations_slash"
 )
 
 const (
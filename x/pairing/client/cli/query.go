@@ -43,6 +43,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdSdkPairing())
 	cmd.AddCommand(CmdProviderMonthlyPayout())
 	cmd.AddCommand(CmdSubscriptionMonthlyPayout())
+	cmd.AddCommand(CmdProviderQos())
+	cmd.AddCommand(CmdDisciplineHistory())
 
 	// this line is used by starport scaffolding # 1
 
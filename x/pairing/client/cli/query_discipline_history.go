@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdDisciplineHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "discipline-history [provider]",
+		Short: `Query to show the discipline history of a specific provider: every freeze, unfreeze and jail of 
+		its stake entries with the block, epoch and reason (and the complainers CU for unresponsiveness)`,
+		Example: `lavad q pairing discipline-history lava@12345
+		lavad q pairing discipline-history lava@12345 --chain-id ETH1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			provider := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(chainIDFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDisciplineHistoryRequest{
+				Provider: provider,
+				ChainID:  chainID,
+			}
+
+			res, err := queryClient.DisciplineHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(chainIDFlagName, "", "show only the provider's discipline history for this chain ID")
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	chainIDFlagName = "chain-id"
+	clusterFlagName = "cluster"
+)
+
+func CmdProviderQos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "provider-qos [provider]",
+		Short: `Query to show the aggregated QoS excellence of a specific provider per chain and cluster. It shows the 
+		provider's QoS and the QoS reports of the current epoch (which are merged at the end of the epoch)`,
+		Example: `lavad q pairing provider-qos lava@12345
+		lavad q pairing provider-qos lava@12345 --chain-id ETH1 --cluster free`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			provider := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := cmd.Flags().GetString(chainIDFlagName)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := cmd.Flags().GetString(clusterFlagName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProviderQosRequest{
+				Provider: provider,
+				ChainID:  chainID,
+				Cluster:  cluster,
+			}
+
+			res, err := queryClient.ProviderQos(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(chainIDFlagName, "", "show only the provider's QoS for this chain ID")
+	cmd.Flags().String(clusterFlagName, "", "show only the provider's QoS for this cluster")
+
+	return cmd
+}
@@ -26,6 +26,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetBadgeUsedCu(ctx, elem)
 	}
 
+	// Set all the providerQosAggregation
+	for _, elem := range genState.ProviderQosAggregationList {
+		k.SetProviderQosAggregation(ctx, elem)
+	}
+
+	// Set all the disciplineRecord
+	for _, elem := range genState.DisciplineRecordList {
+		k.SetDisciplineRecord(ctx, elem)
+	}
+
 	k.InitBadgeTimers(ctx, genState.BadgesTS)
 	k.InitProviderQoS(ctx, genState.ProviderQosFS)
 	// this line is used by starport scaffolding # genesis/module/init
@@ -43,6 +53,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BadgeUsedCuList = k.GetAllBadgeUsedCu(ctx)
 	genesis.BadgesTS = k.ExportBadgesTimers(ctx)
 	genesis.ProviderQosFS = k.ExportProviderQoS(ctx)
+	genesis.ProviderQosAggregationList = k.GetAllProviderQosAggregation(ctx)
+	genesis.DisciplineRecordList = k.GetAllDisciplineRecord(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
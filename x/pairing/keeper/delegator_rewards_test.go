@@ -646,3 +646,86 @@ func TestRedelegationFirstMonthReward(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(resRewards.Rewards))
 }
+
+// TestDelegatorRewardAutoCompound checks that the reward of a delegation with auto-compound is
+// re-delegated to the same provider (up to the provider's delegate limit) and the rest of the
+// reward remains claimable
+func TestDelegatorRewardAutoCompound(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)                   // 1 provider, 1 client, 1 providersToPair
+	ts.AddAccount(common.CONSUMER, 1, testBalance) // add delegator1
+
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	_, delegator := ts.GetAccount(common.CONSUMER, 1)
+	makeProviderCommissionZero(ts, ts.spec.Index, providerAcc.Addr)
+
+	// the provider's self delegation cannot be auto-compounded
+	_, err := ts.TxDualstakingSetAutoCompound(provider, provider, ts.spec.Index, true)
+	require.Error(t, err)
+
+	// no delegation yet
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, true)
+	require.Error(t, err)
+
+	delegationAmount := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	_, err = ts.TxDualstakingDelegate(delegator, provider, ts.spec.Index, delegationAmount)
+	require.NoError(t, err)
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, true)
+	require.NoError(t, err)
+	ts.AdvanceEpoch() // apply delegation
+
+	// set the delegate limit so that only half of the delegator's reward can be compounded
+	// (the delegator gets half of the reward since its delegation is equal to the provider's stake)
+	reward := sdk.NewInt(testStake)
+	stakeEntry, found, stakeEntryIndex := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	stakeEntry.DelegateLimit = stakeEntry.DelegateLimit.AddAmount(reward.QuoRaw(4))
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, stakeEntry, stakeEntryIndex)
+	ts.AdvanceMonths(1) // the delegation gets rewards only after its first month
+	ts.AdvanceEpoch()
+
+	err = ts.Keepers.BankKeeper.SetBalance(ts.Ctx,
+		ts.Keepers.AccountKeeper.GetModuleAddress(subscriptiontypes.ModuleName),
+		sdk.NewCoins(sdk.NewCoin(ts.TokenDenom(), reward)))
+	require.NoError(t, err)
+
+	_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, providerAcc.Addr, ts.spec.Index,
+		reward, subscriptiontypes.ModuleName, false, false, true)
+	require.NoError(t, err)
+
+	// the compounded part was added to the delegation, the rest is claimable
+	compounded := reward.QuoRaw(4)
+	resRewards, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, resRewards.Rewards, 1)
+	require.Equal(t, reward.QuoRaw(2).Sub(compounded), resRewards.Rewards[0].Amount.Amount)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.Equal(t, stakeEntry.DelegateLimit, stakeEntry.DelegateTotal)
+
+	res, err := ts.QueryDualstakingDelegatorProviders(delegator, true)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 1)
+	require.Equal(t, delegationAmount.AddAmount(compounded), res.Delegations[0].Amount)
+	require.True(t, res.Delegations[0].AutoCompound)
+
+	// disable auto-compound and verify the whole reward is claimable
+	_, err = ts.TxDualstakingSetAutoCompound(delegator, provider, ts.spec.Index, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	err = ts.Keepers.BankKeeper.SetBalance(ts.Ctx,
+		ts.Keepers.AccountKeeper.GetModuleAddress(subscriptiontypes.ModuleName),
+		sdk.NewCoins(sdk.NewCoin(ts.TokenDenom(), reward)))
+	require.NoError(t, err)
+
+	_, _, err = ts.Keepers.Dualstaking.RewardProvidersAndDelegators(ts.Ctx, providerAcc.Addr, ts.spec.Index,
+		reward, subscriptiontypes.ModuleName, false, false, true)
+	require.NoError(t, err)
+
+	resRewards2, err := ts.QueryDualstakingDelegatorRewards(delegator, provider, ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, resRewards2.Rewards, 1)
+	require.True(t, resRewards2.Rewards[0].Amount.Amount.GT(resRewards.Rewards[0].Amount.Amount))
+}
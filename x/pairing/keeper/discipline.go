@@ -2,10 +2,19 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, jailStartBlock, jailBlocks uint64, bail sdk.Coin) error {
+// JailEntry jails a provider for jailBlocks blocks and records it in the provider's discipline history
+func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, jailStartBlock, jailBlocks uint64, bail sdk.Coin, reason string) error {
 	// todo - provider will not get pairing and payment for this period
+	k.recordDiscipline(ctx, types.DisciplineRecord{
+		Provider:   account.String(),
+		ChainID:    chainID,
+		Action:     types.DisciplineActionJail,
+		Reason:     reason,
+		JailBlocks: jailBlocks,
+	})
 	return nil
 }
 
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetDisciplineRecord set a specific disciplineRecord in the store
+func (k Keeper) SetDisciplineRecord(ctx sdk.Context, record types.DisciplineRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DisciplineRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.DisciplineRecordKey(
+		record.Provider,
+		record.ChainID,
+		record.Block,
+		record.Action,
+	), b)
+}
+
+// GetProviderDisciplineRecords returns the disciplineRecords of a provider (optionally
+// filtered by chain ID), sorted by chain ID and then by block
+func (k Keeper) GetProviderDisciplineRecords(ctx sdk.Context, provider, chainID string) (list []types.DisciplineRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DisciplineRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DisciplineRecordPrefix(provider, chainID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DisciplineRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllDisciplineRecord returns all disciplineRecord
+func (k Keeper) GetAllDisciplineRecord(ctx sdk.Context) (list []types.DisciplineRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DisciplineRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DisciplineRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordDiscipline saves a disciplinary action in the provider's discipline history and prunes
+// the oldest records of the provider's chain (if it has more than MaxDisciplineRecordsPerChain)
+func (k Keeper) recordDiscipline(ctx sdk.Context, record types.DisciplineRecord) {
+	record.Block = uint64(ctx.BlockHeight())
+	record.Epoch = k.epochStorageKeeper.GetEpochStart(ctx)
+	k.SetDisciplineRecord(ctx, record)
+	k.pruneDisciplineRecords(ctx, record.Provider, record.ChainID)
+}
+
+// pruneDisciplineRecords removes the oldest discipline records of a provider on a chain so
+// that at most MaxDisciplineRecordsPerChain records are kept
+func (k Keeper) pruneDisciplineRecords(ctx sdk.Context, provider, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DisciplineRecordKeyPrefix))
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.DisciplineRecordPrefix(provider, chainID))
+
+	// the records are sorted by block, so the reverse iteration starts with the newest
+	keysToRemove := [][]byte{}
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > types.MaxDisciplineRecordsPerChain {
+			keysToRemove = append(keysToRemove, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keysToRemove {
+		store.Delete(key)
+	}
+}
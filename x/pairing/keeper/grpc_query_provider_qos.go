@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderQos(goCtx context.Context, req *types.QueryProviderQosRequest) (*types.QueryProviderQosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the QoS keys are "chainID/cluster/provider" so we can narrow the search only by chain ID
+	prefix := ""
+	if req.ChainID != "" {
+		prefix = req.ChainID + "/"
+	}
+
+	qosList := []types.ProviderQos{}
+	listed := map[string]struct{}{}
+	for _, index := range k.GetAllQosIndices(ctx, prefix) {
+		provider, chainID, cluster := types.DecodeProviderQosKey(index)
+		if provider != req.Provider || (req.Cluster != "" && cluster != req.Cluster) {
+			continue
+		}
+
+		qos, err := k.GetQos(ctx, chainID, cluster, provider)
+		if err != nil {
+			continue
+		}
+
+		providerQos := types.ProviderQos{ChainID: chainID, Cluster: cluster, Qos: qos}
+		if aggregation, found := k.GetProviderQosAggregation(ctx, index); found && aggregation.TotalCu != 0 {
+			pending := aggregation.WeightedReportSum.Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(aggregation.TotalCu)))
+			providerQos.PendingEpochQos = &pending
+		}
+		qosList = append(qosList, providerQos)
+		listed[index] = struct{}{}
+	}
+
+	// providers that got reports only in the current epoch have no QoS yet
+	for _, aggregation := range k.GetAllProviderQosAggregation(ctx) {
+		provider, chainID, cluster := types.DecodeProviderQosKey(aggregation.Index)
+		if provider != req.Provider || !strings.HasPrefix(aggregation.Index, prefix) ||
+			(req.Cluster != "" && cluster != req.Cluster) || aggregation.TotalCu == 0 {
+			continue
+		}
+		if _, ok := listed[aggregation.Index]; ok {
+			continue
+		}
+
+		pending := aggregation.WeightedReportSum.Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(aggregation.TotalCu)))
+		qosList = append(qosList, types.ProviderQos{
+			ChainID:         chainID,
+			Cluster:         cluster,
+			Qos:             types.ZeroQualityOfServiceReport(),
+			PendingEpochQos: &pending,
+		})
+	}
+
+	return &types.QueryProviderQosResponse{Qos: qosList}, nil
+}
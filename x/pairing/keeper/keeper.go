@@ -109,6 +109,8 @@ func (k Keeper) BeginBlock(ctx sdk.Context) {
 	if k.epochStorageKeeper.IsEpochStart(ctx) {
 		// remove old session payments
 		k.RemoveOldEpochPayment(ctx)
+		// merge the QoS excellence reports of the past epoch into the providers' QoS
+		k.UpdateProviderQos(ctx)
 		// unstake any unstaking providers
 		k.CheckUnstakingForCommit(ctx)
 		// unstake/jail unresponsive providers
@@ -21,6 +21,12 @@ func (k msgServer) FreezeProvider(goCtx context.Context, msg *types.MsgFreezePro
 }
 
 func (k Keeper) FreezeProvider(ctx sdk.Context, provider string, chainIDs []string, reason string) error {
+	return k.freezeProvider(ctx, provider, chainIDs, reason, 0, 0)
+}
+
+// freezeProvider freezes the provider's stake entries and saves the freeze in its discipline
+// history (with the complainers and serviced CU, when the freeze is due to unresponsiveness)
+func (k Keeper) freezeProvider(ctx sdk.Context, provider string, chainIDs []string, reason string, complainerCU, servicedCU uint64) error {
 	providerAddr, err := sdk.AccAddressFromBech32(provider)
 	if err != nil {
 		return utils.LavaFormatWarning("Freeze_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: provider})
@@ -41,6 +47,19 @@ func (k Keeper) FreezeProvider(ctx sdk.Context, provider string, chainIDs []stri
 		// freeze the provider by making the StakeAppliedBlock be max. This will remove the provider from the pairing list in the next epoch
 		stakeEntry.Freeze()
 		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry, index)
+
+		recordReason := reason
+		if recordReason == "" {
+			recordReason = types.DisciplineReasonProviderRequest
+		}
+		k.recordDiscipline(ctx, types.DisciplineRecord{
+			Provider:     provider,
+			ChainID:      chainId,
+			Action:       types.DisciplineActionFreeze,
+			Reason:       recordReason,
+			ComplainerCu: complainerCU,
+			ServicedCu:   servicedCU,
+		})
 	}
 
 	utils.LogLavaEvent(ctx, ctx.Logger(), "freeze_provider", map[string]string{"providerAddress": providerAddr.String(), "chainIDs": strings.Join(chainIDs, ","), "freezeRequestBlock": strconv.FormatInt(ctx.BlockHeight(), 10), "freezeReason": reason}, "Provider Freeze")
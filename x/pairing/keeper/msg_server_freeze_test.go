@@ -5,6 +5,7 @@ import (
 
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,6 +38,7 @@ func TestFreeze(t *testing.T) {
 	// freeze the first provider
 	_, err = ts.TxPairingFreezeProvider(providerToFreeze.Address, ts.spec.Index)
 	require.NoError(t, err)
+	freezeBlock := ts.BlockHeight()
 
 	// check that the provider is still shown in the pairing list
 	res, err = ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
@@ -82,6 +84,40 @@ func TestFreeze(t *testing.T) {
 		}
 	}
 	require.True(t, foundUnfrozenProvider)
+
+	// verify the discipline history shows the freeze and the unfreeze (and not the
+	// first unfreeze attempt, which did nothing)
+	history, err := ts.QueryPairingDisciplineHistory(providerToFreeze.Address, ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, history.Records, 2)
+	require.Equal(t, types.DisciplineActionFreeze, history.Records[0].Action)
+	require.Equal(t, freezeBlock, history.Records[0].Block)
+	require.Equal(t, "test", history.Records[0].Reason) // the tester's freeze reason
+	require.Equal(t, types.DisciplineActionUnfreeze, history.Records[1].Action)
+	require.Equal(t, types.DisciplineReasonProviderRequest, history.Records[1].Reason)
+	require.Less(t, history.Records[0].Block, history.Records[1].Block)
+}
+
+// Test that the discipline history of a provider is pruned (keeping the newest records)
+func TestDisciplineHistoryPruning(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1) // 1 provider, 1 client, 1 provider-to-pair
+
+	provider, _ := ts.GetAccount(common.PROVIDER, 0)
+
+	freezes := types.MaxDisciplineRecordsPerChain + 10
+	for i := 0; i < freezes; i++ {
+		_, err := ts.TxPairingFreezeProvider(provider.Addr.String(), ts.spec.Index)
+		require.NoError(t, err)
+		ts.AdvanceBlock()
+	}
+	lastFreezeBlock := ts.BlockHeight() - 1
+
+	history, err := ts.QueryPairingDisciplineHistory(provider.Addr.String(), ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, history.Records, types.MaxDisciplineRecordsPerChain)
+	require.Equal(t, lastFreezeBlock, history.Records[len(history.Records)-1].Block)
+	require.Equal(t, lastFreezeBlock-uint64(types.MaxDisciplineRecordsPerChain-1), history.Records[0].Block)
 }
 
 // Test the freeze effect on the "providers" query
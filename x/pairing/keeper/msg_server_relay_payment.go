@@ -250,6 +250,14 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return nil, utils.LavaFormatError("Failed charging CU to project and subscription", err)
 		}
 
+		if relay.QosExcellenceReport != nil {
+			sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+			if found {
+				// an invalid report is not a reason to fail the payment (it's only ignored)
+				_ = k.AggregateQosExcellenceReport(ctx, relay.Provider, relay.SpecId, sub.Cluster, *relay.QosExcellenceReport, relay.CuSum)
+			}
+		}
+
 		// update provider payment storage with complainer's CU
 		err = k.updateProviderPaymentStorageWithComplainerCU(ctx, relay.UnresponsiveProviders, logger, epochStart, relay.SpecId, relay.CuSum, servicersToPair, project.Index)
 		if err != nil {
@@ -40,6 +40,12 @@ func (k msgServer) UnfreezeProvider(goCtx context.Context, msg *types.MsgUnfreez
 			stakeEntry.UnFreeze(currentBlock)
 			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainId, stakeEntry, index)
 			unfrozen_chains = append(unfrozen_chains, chainId)
+			k.recordDiscipline(ctx, types.DisciplineRecord{
+				Provider: msg.GetCreator(),
+				ChainID:  chainId,
+				Action:   types.DisciplineActionUnfreeze,
+				Reason:   types.DisciplineReasonProviderRequest,
+			})
 		}
 		// else case does not throw an error because we don't want to fail unfreezing other chains
 	}
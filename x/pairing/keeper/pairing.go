@@ -159,11 +159,12 @@ func (k Keeper) getPairingForClient(ctx sdk.Context, chainID string, block uint6
 	}
 
 	// calculate score (always on the diff in score components of consecutive groups) and pick providers
+	strategy := pairingscores.GetStrategy(strictestPolicy.ScoreStrategyWeights)
 	prevGroupSlot := pairingscores.NewPairingSlotGroup(pairingscores.NewPairingSlot(-1)) // init dummy slot to compare to
 	for idx, group := range slotGroups {
 		hashData := pairingscores.PrepareHashData(project.Index, chainID, epochHash, idx)
 		diffSlot := group.Subtract(prevGroupSlot)
-		err := pairingscores.CalcPairingScore(providerScores, pairingscores.GetStrategy(), diffSlot)
+		err := pairingscores.CalcPairingScore(providerScores, strategy, diffSlot)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -211,11 +212,14 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
+	scoreStrategyWeights := k.CalculateEffectiveScoreStrategyWeightsFromPolicies(policies)
+
 	strictestPolicy := &planstypes.Policy{
 		GeolocationProfile:    geolocation,
 		MaxProvidersToPair:    providersToPair,
 		SelectedProvidersMode: selectedProvidersMode,
 		SelectedProviders:     selectedProvidersList,
+		ScoreStrategyWeights:  scoreStrategyWeights,
 		ChainPolicies:         []planstypes.ChainPolicy{chainPolicy},
 		EpochCuLimit:          allowedCUEpoch,
 		TotalCuLimit:          allowedCUTotal,
@@ -242,6 +246,28 @@ func (k Keeper) CalculateEffectiveSelectedProviders(policies []*planstypes.Polic
 	return effectiveMode, effectiveSelectedProviders
 }
 
+// CalculateEffectiveScoreStrategyWeightsFromPolicies returns the score strategy weights. Weights are
+// not restrictions, so the most specific policy that sets a weight wins (admin > subscription > plan)
+func (k Keeper) CalculateEffectiveScoreStrategyWeightsFromPolicies(policies []*planstypes.Policy) planstypes.ScoreStrategyWeights {
+	weights := planstypes.ScoreStrategyWeights{}
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		if policy.ScoreStrategyWeights.Stake != 0 {
+			weights.Stake = policy.ScoreStrategyWeights.Stake
+		}
+		if policy.ScoreStrategyWeights.Qos != 0 {
+			weights.Qos = policy.ScoreStrategyWeights.Qos
+		}
+		if policy.ScoreStrategyWeights.Geo != 0 {
+			weights.Geo = policy.ScoreStrategyWeights.Geo
+		}
+	}
+
+	return weights
+}
+
 func (k Keeper) CalculateEffectiveGeolocationFromPolicies(policies []*planstypes.Policy) (int32, error) {
 	geolocation := int32(math.MaxInt32)
 
@@ -1099,7 +1099,7 @@ func TestGeolocationPairingScores(t *testing.T) {
 
 			// calc scores and verify the scores are as expected
 			for _, slot := range slots {
-				err = pairingscores.CalcPairingScore(providerScores, pairingscores.GetStrategy(), slot)
+				err = pairingscores.CalcPairingScore(providerScores, pairingscores.GetStrategy(planstypes.ScoreStrategyWeights{}), slot)
 				require.NoError(t, err)
 
 				ok := verifyGeoScoreForTesting(providerScores, slot, geoSeen)
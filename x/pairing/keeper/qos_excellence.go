@@ -3,19 +3,130 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) UpdateProviderQos(epochPayments pairingtypes.EpochPayments) {
+// SetProviderQosAggregation set a specific providerQosAggregation in the store from its index
+func (k Keeper) SetProviderQosAggregation(ctx sdk.Context, aggregation pairingtypes.ProviderQosAggregation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderQosAggregationKeyPrefix))
+	b := k.cdc.MustMarshal(&aggregation)
+	store.Set([]byte(aggregation.Index), b)
 }
 
-// GetQos gets a provider's QoS excellence report from the providerQosFS
-func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error) {
+// GetProviderQosAggregation returns a providerQosAggregation from its index
+func (k Keeper) GetProviderQosAggregation(ctx sdk.Context, index string) (val pairingtypes.ProviderQosAggregation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderQosAggregationKeyPrefix))
+
+	b := store.Get([]byte(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderQosAggregation removes a providerQosAggregation from the store
+func (k Keeper) RemoveProviderQosAggregation(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderQosAggregationKeyPrefix))
+	store.Delete([]byte(index))
+}
+
+// GetAllProviderQosAggregation returns all providerQosAggregation
+func (k Keeper) GetAllProviderQosAggregation(ctx sdk.Context) (list []pairingtypes.ProviderQosAggregation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderQosAggregationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val pairingtypes.ProviderQosAggregation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AggregateQosExcellenceReport adds a QoS excellence report to the provider's QoS aggregation of the
+// current epoch. The report is weighted by the reporter's CU (so heavier consumers influence more)
+func (k Keeper) AggregateQosExcellenceReport(ctx sdk.Context, provider string, chainID string, cluster string, report pairingtypes.QualityOfServiceReport, cu uint64) error {
+	if cu == 0 {
+		return nil
+	}
+
+	if _, err := report.ComputeQoSExcellence(); err != nil {
+		return utils.LavaFormatWarning("invalid QoS excellence report", err,
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "report", Value: report},
+		)
+	}
+
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	aggregation, found := k.GetProviderQosAggregation(ctx, key)
+	if !found {
+		aggregation = pairingtypes.ProviderQosAggregation{
+			Index:             key,
+			WeightedReportSum: pairingtypes.ZeroQualityOfServiceReport(),
+		}
+	}
+
+	aggregation.WeightedReportSum = aggregation.WeightedReportSum.Add(report.Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(cu))))
+	aggregation.TotalCu += cu
+	k.SetProviderQosAggregation(ctx, aggregation)
+
+	return nil
+}
+
+// UpdateProviderQos merges the QoS excellence reports that were aggregated in the past epoch into
+// the providers' QoS (in the providerQosFS). The epoch's average report (weighted by the reporters'
+// CU) is merged with the previous QoS with an exponential decay:
+// newQoS = oldQoS * decay + epochQoS * (1 - decay)
+func (k Keeper) UpdateProviderQos(ctx sdk.Context) {
+	block := uint64(ctx.BlockHeight())
+	decay := pairingtypes.QosDecayFactor()
+
+	for _, aggregation := range k.GetAllProviderQosAggregation(ctx) {
+		k.RemoveProviderQosAggregation(ctx, aggregation.Index)
+		if aggregation.TotalCu == 0 {
+			continue
+		}
+
+		qos := aggregation.WeightedReportSum.Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(aggregation.TotalCu)))
+
+		var prevQos pairingtypes.QualityOfServiceReport
+		if k.providerQosFS.FindEntry(ctx, aggregation.Index, block, &prevQos) {
+			qos = prevQos.Mul(decay).Add(qos.Mul(sdk.OneDec().Sub(decay)))
+		}
+
+		err := k.providerQosFS.AppendEntry(ctx, aggregation.Index, block, &qos)
+		if err != nil {
+			// this should not happen; to avoid panic we simply skip this one (the provider's
+			// QoS will remain unchanged for this epoch)
+			utils.LavaFormatError("critical: failed to update provider QoS", err,
+				utils.Attribute{Key: "index", Value: aggregation.Index},
+				utils.Attribute{Key: "block", Value: block},
+			)
+		}
+	}
+}
+
+// FindQos gets a provider's QoS excellence report from the providerQosFS, an empty report is returned when
+// the provider has none
+func (k Keeper) FindQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, bool) {
 	var qos pairingtypes.QualityOfServiceReport
 	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
 	found := k.providerQosFS.FindEntry(ctx, key, uint64(ctx.BlockHeight()), &qos)
+	return qos, found
+}
+
+// GetQos gets a provider's QoS excellence report from the providerQosFS
+func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error) {
+	qos, found := k.FindQos(ctx, chainID, cluster, provider)
 	if !found {
 		return qos, utils.LavaFormatWarning("provider of chain and cluster was not found in the store", fmt.Errorf("qos not found"),
 			utils.Attribute{Key: "provider", Value: provider},
@@ -25,3 +136,8 @@ func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider
 	}
 	return qos, nil
 }
+
+// GetAllQosIndices gets all the providerQosFS indices that start with a prefix (chainID/cluster/provider)
+func (k Keeper) GetAllQosIndices(ctx sdk.Context, prefix string) []string {
+	return k.providerQosFS.GetAllEntryIndicesWithPrefix(ctx, prefix)
+}
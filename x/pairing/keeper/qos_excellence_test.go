@@ -2,16 +2,132 @@ package keeper_test
 
 import (
 	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/utils/slices"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
 )
 
-// TODO: All tests are not implemented since providerQosFS update and Qos score are not implemented yet
+// TODO: Some tests are not implemented since the Qos score is not implemented yet
 
 // TestProviderQosMap checks that getting a providers' Qos map for specific chainID and cluster works properly
 func TestProviderQosMap(t *testing.T) {
 }
 
+func newQosReport(latency, availability, sync int64) types.QualityOfServiceReport {
+	return types.QualityOfServiceReport{
+		Latency:      sdk.NewDec(latency),
+		Availability: sdk.NewDecWithPrec(availability, 2),
+		Sync:         sdk.NewDec(sync),
+	}
+}
+
 // TestGetQos checks that using GetQos() returns the right Qos
 func TestGetQos(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+	chainID := ts.spec.Index
+	cluster := "cluster"
+	keeper := ts.Keepers.Pairing
+
+	// no QoS before the first reports
+	_, err := keeper.GetQos(ts.Ctx, chainID, cluster, provider)
+	require.Error(t, err)
+	qos, found := keeper.FindQos(ts.Ctx, chainID, cluster, provider)
+	require.False(t, found)
+	require.Equal(t, types.QualityOfServiceReport{}, qos)
+
+	// reports are weighted by CU: (1*100 + 4*300) / 400 = 3.25
+	err = keeper.AggregateQosExcellenceReport(ts.Ctx, provider, chainID, cluster, newQosReport(1, 100, 1), 100)
+	require.NoError(t, err)
+	err = keeper.AggregateQosExcellenceReport(ts.Ctx, provider, chainID, cluster, newQosReport(4, 100, 1), 300)
+	require.NoError(t, err)
+
+	// invalid reports are ignored
+	err = keeper.AggregateQosExcellenceReport(ts.Ctx, provider, chainID, cluster, newQosReport(0, 100, 1), 300)
+	require.Error(t, err)
+
+	// reports are merged only at the end of the epoch
+	_, err = keeper.GetQos(ts.Ctx, chainID, cluster, provider)
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+
+	qos, err = keeper.GetQos(ts.Ctx, chainID, cluster, provider)
+	require.NoError(t, err)
+	require.True(t, qos.Latency.Equal(sdk.MustNewDecFromStr("3.25")))
+	require.True(t, qos.Availability.Equal(sdk.OneDec()))
+	require.True(t, qos.Sync.Equal(sdk.OneDec()))
+	foundQos, found := keeper.FindQos(ts.Ctx, chainID, cluster, provider)
+	require.True(t, found)
+	require.Equal(t, qos, foundQos)
+
+	// no reports in the epoch -> QoS doesn't change
+	ts.AdvanceEpoch()
+	qos, err = keeper.GetQos(ts.Ctx, chainID, cluster, provider)
+	require.NoError(t, err)
+	require.True(t, qos.Latency.Equal(sdk.MustNewDecFromStr("3.25")))
+
+	// new reports are merged with decay: 3.25 * 0.8 + 1 * 0.2 = 2.8
+	err = keeper.AggregateQosExcellenceReport(ts.Ctx, provider, chainID, cluster, newQosReport(1, 100, 1), 100)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	qos, err = keeper.GetQos(ts.Ctx, chainID, cluster, provider)
+	require.NoError(t, err)
+	require.True(t, qos.Latency.Equal(sdk.MustNewDecFromStr("2.8")))
+
+	// other clusters are not affected
+	_, err = keeper.GetQos(ts.Ctx, chainID, "other", provider)
+	require.Error(t, err)
+}
+
+// TestQosReportsInRelayPayment checks that QoS excellence reports of relay payments are aggregated
+// in the consumer's cluster and can be queried
+func TestQosReportsInRelayPayment(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, client := ts.GetAccount(common.CONSUMER, 0)
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	sub, found := ts.Keepers.Subscription.GetSubscription(ts.Ctx, client)
+	require.True(t, found)
+
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+	relaySession := ts.newRelaySession(provider, 0, cuSum, ts.BlockHeight(), 0)
+	report := newQosReport(2, 90, 1)
+	relaySession.QosExcellenceReport = &report
+	sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+	relaySession.Sig = sig
+	require.NoError(t, err)
+
+	ts.relayPaymentWithoutPay(types.MsgRelayPayment{Creator: provider, Relays: slices.Slice(relaySession)}, true)
+
+	// before the epoch ends the reports are pending
+	res, err := ts.QueryPairingProviderQos(provider, "", "")
+	require.NoError(t, err)
+	require.Len(t, res.Qos, 1)
+	require.Equal(t, sub.Cluster, res.Qos[0].Cluster)
+	require.NotNil(t, res.Qos[0].PendingEpochQos)
+	require.True(t, res.Qos[0].PendingEpochQos.Latency.Equal(report.Latency))
+
+	ts.AdvanceEpoch()
+
+	res, err = ts.QueryPairingProviderQos(provider, ts.spec.Index, sub.Cluster)
+	require.NoError(t, err)
+	require.Len(t, res.Qos, 1)
+	require.Nil(t, res.Qos[0].PendingEpochQos)
+	require.True(t, res.Qos[0].Qos.Availability.Equal(report.Availability))
+
+	res, err = ts.QueryPairingProviderQos(provider, ts.spec.Index, "other")
+	require.NoError(t, err)
+	require.Len(t, res.Qos, 0)
 }
 
 // TestQosReqForSlots checks that if Qos req is active, all slots are assigned with Qos req
@@ -7,8 +7,8 @@ import (
 )
 
 const (
-	// TODO: temp strategy weight until we implement taking it out of the policy
-	UNIFORM_WEIGHT uint64 = 1
+	// default strategy weight for requirements without a weight in the policy
+	DEFAULT_WEIGHT uint64 = 1
 )
 
 // PairingScore holds a provider's score with respect to a set of requirements (ScoreReq), indexed by their unique name.
@@ -7,10 +7,20 @@ import (
 	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
-const qosReqName = "qos-req"
+const (
+	qosReqName = "qos-req"
+
+	// QoS excellence score range (in percent). The score is clamped to this range so that a single
+	// provider's QoS can't dominate the pairing score
+	minQosScore int64 = 50
+	maxQosScore int64 = 200
+
+	// scale of the QoS score component (the score component is an integer)
+	qosScoreScale = 10
+)
 
 type QosGetter interface {
-	GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error)
+	FindQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, bool)
 }
 
 // QosReq implements the ScoreReq interface for provider staking requirement(s)
@@ -20,18 +30,30 @@ func (qr *QosReq) Init(policy planstypes.Policy) bool {
 	return true
 }
 
-// Score calculates the the provider's qos score
+// Score calculates the the provider's qos score. The QoS excellence score is clamped to the range
+// [minQosScore, maxQosScore] and scaled by qosScoreScale (score components are integers). Providers
+// without QoS data (e.g. new providers) get the neutral score (1 * qosScoreScale)
 func (qr *QosReq) Score(score PairingScore) math.Uint {
-	// TODO: update Qos in providerQosFS properly and uncomment this code below
-	// Also, the qos score should range between 0.5-2
+	neutralScore := math.NewUint(qosScoreScale)
+	report := score.QosExcellenceReport
+	if report.Latency.IsNil() || report.Availability.IsNil() || report.Sync.IsNil() {
+		return neutralScore
+	}
+
+	qosScore, err := report.ComputeQoSExcellence()
+	if err != nil {
+		return neutralScore
+	}
 
-	// qosScore, err := score.QosExcellenceReport.ComputeQoS()
-	// if err != nil {
-	// 	return math.NewUint(1)
-	// }
+	minScore := sdk.NewDecWithPrec(minQosScore, 2)
+	maxScore := sdk.NewDecWithPrec(maxQosScore, 2)
+	if qosScore.LT(minScore) {
+		qosScore = minScore
+	} else if qosScore.GT(maxScore) {
+		qosScore = maxScore
+	}
 
-	// return math.Uint(qosScore)
-	return math.NewUint(1)
+	return math.Uint(qosScore.MulInt64(qosScoreScale).TruncateInt())
 }
 
 func (qr *QosReq) GetName() string {
@@ -26,9 +26,11 @@ package scores
 //
 // A pairing score strategy defines the weight of each score requirement in the final score calculation
 // for a <provider, slot> combination. For example, given a slot with several requirements, then the
-// overall pairing score would be calculated as score1^w1 + score2^w2 + ... (where score1 is the score
+// overall pairing score would be calculated as score1^w1 * score2^w2 * ... (where score1 is the score
 // of the provider with respect to the first requirement, score2 with respect to the second requirement
-// and so on).
+// and so on). The weights are taken from the policy's score strategy weights (default weight is 1).
+// To avoid overflows with large score components (e.g. stake), a weighted component is normalized by the
+// highest component of that requirement across providers: max * (score/max)^w.
 //
 //
 // To add a new requirement, create an object implementing the ScoreReq interface and add the new requirement in GetAllReqs().
@@ -48,19 +50,6 @@ import (
 	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
-var uniformStrategy ScoreStrategy
-
-// TODO: currently we'll use weight=1 for all reqs. In the future, we'll get it from policy
-func init() {
-	reqs := GetAllReqs()
-
-	// init strategy
-	uniformStrategy = make(ScoreStrategy)
-	for _, req := range reqs {
-		uniformStrategy[req.GetName()] = 1
-	}
-}
-
 func GetAllReqs() []ScoreReq {
 	return []ScoreReq{
 		&StakeReq{},
@@ -119,9 +108,22 @@ func GroupSlots(slots []*PairingSlot) []*PairingSlotGroup {
 	return uniqueSlots
 }
 
-// TODO: currently we'll use weight=1 for all reqs. In the future, we'll get it from policy
-func GetStrategy() ScoreStrategy {
-	return uniformStrategy
+// GetStrategy returns the score strategy defined by the policy's score strategy weights
+// (requirements without a weight get the default weight)
+func GetStrategy(weights planstypes.ScoreStrategyWeights) ScoreStrategy {
+	strategy := ScoreStrategy{
+		stakeReqName: weights.Stake,
+		qosReqName:   weights.Qos,
+		geoReqName:   weights.Geo,
+	}
+
+	for _, req := range GetAllReqs() {
+		if strategy[req.GetName()] == 0 {
+			strategy[req.GetName()] = DEFAULT_WEIGHT
+		}
+	}
+
+	return strategy
 }
 
 // CalcPairingScore calculates the final pairing score for a pairing slot (with strategy)
@@ -136,33 +138,55 @@ func CalcPairingScore(scores []*PairingScore, strategy ScoreStrategy, diffSlot P
 	}
 	sort.Strings(keys)
 
-	for _, score := range scores {
-		for _, key := range keys {
-			req := requirements[key]
-			reqName := req.GetName()
-			weight, ok := strategy[reqName]
-			if !ok {
-				return utils.LavaFormatError("req not found in strategy", fmt.Errorf("cannot calculate pairing score"),
-					utils.Attribute{Key: "req", Value: reqName},
-				)
-			}
+	for _, key := range keys {
+		req := requirements[key]
+		reqName := req.GetName()
+		weight, ok := strategy[reqName]
+		if !ok {
+			return utils.LavaFormatError("req not found in strategy", fmt.Errorf("cannot calculate pairing score"),
+				utils.Attribute{Key: "req", Value: reqName},
+			)
+		}
 
+		// raw components can be large (e.g. the stake in ulava), so raising them to the
+		// weight's power overflows. Instead, each component is normalized by the max
+		// component: max * (comp/max)^weight keeps the ratio between providers at
+		// (comp1/comp2)^weight while bounding the weighted component by max
+		scoreComps := make([]math.Uint, len(scores))
+		maxScoreComp := math.ZeroUint()
+		for i, score := range scores {
 			newScoreComp := req.Score(*score)
-			if newScoreComp == math.ZeroUint() {
+			if newScoreComp.IsZero() {
 				return utils.LavaFormatError("new score component is zero", fmt.Errorf("cannot calculate pairing score"),
 					utils.Attribute{Key: "score component", Value: reqName},
 					utils.Attribute{Key: "provider", Value: score.Provider.Address},
 				)
 			}
-			newScoreCompDec := sdk.NewDecFromInt(math.Int(newScoreComp))
-			newScoreCompDec = newScoreCompDec.Power(weight)
-			newScoreComp = math.Uint(newScoreCompDec.TruncateInt())
+			scoreComps[i] = newScoreComp
+			if newScoreComp.GT(maxScoreComp) {
+				maxScoreComp = newScoreComp
+			}
+		}
+
+		for i, score := range scores {
+			newScoreComp := scoreComps[i]
+			if weight > 1 {
+				maxScoreCompDec := sdk.NewDecFromInt(math.Int(maxScoreComp))
+				ratio := sdk.NewDecFromInt(math.Int(newScoreComp)).Quo(maxScoreCompDec)
+				weighted := ratio.Power(weight).Mul(maxScoreCompDec).TruncateInt()
+				if weighted.IsZero() {
+					weighted = math.OneInt()
+				}
+				newScoreComp = math.Uint(weighted)
+			}
 
 			// update the score component map
 			score.ScoreComponents[reqName] = newScoreComp
 		}
+	}
 
-		// calc new score
+	// calc new score
+	for _, score := range scores {
 		newScore := math.OneUint()
 		for _, scoreComp := range score.ScoreComponents {
 			newScore = newScore.Mul(scoreComp)
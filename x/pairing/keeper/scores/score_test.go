@@ -2,9 +2,14 @@ package scores
 
 import (
 	"math/rand"
+	"strconv"
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	planstypes "github.com/lavanet/lava/x/plans/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -83,3 +88,113 @@ func generateScoresWithRandomFilter(count int) []*PairingScore {
 	}
 	return ret
 }
+
+func TestGetStrategy(t *testing.T) {
+	strategy := GetStrategy(planstypes.ScoreStrategyWeights{})
+	for _, req := range GetAllReqs() {
+		require.Equal(t, DEFAULT_WEIGHT, strategy[req.GetName()])
+	}
+
+	strategy = GetStrategy(planstypes.ScoreStrategyWeights{Qos: 3, Geo: 2})
+	require.Equal(t, DEFAULT_WEIGHT, strategy[stakeReqName])
+	require.Equal(t, uint64(3), strategy[qosReqName])
+	require.Equal(t, uint64(2), strategy[geoReqName])
+}
+
+func TestCalcPairingScoreRealisticStakes(t *testing.T) {
+	stakes := []int64{
+		1_000_000_000_000, // 1M lava
+		500_000_000_000,   // 500K lava
+		10_000_000,        // 10 lava
+	}
+	scores := []*PairingScore{}
+	for i, stake := range stakes {
+		stakeEntry := &epochstoragetypes.StakeEntry{
+			Address:       strconv.Itoa(i),
+			Stake:         sdk.NewCoin("ulava", math.NewInt(stake)),
+			DelegateLimit: sdk.NewCoin("ulava", math.ZeroInt()),
+			DelegateTotal: sdk.NewCoin("ulava", math.ZeroInt()),
+		}
+		scores = append(scores, NewPairingScore(stakeEntry, pairingtypes.QualityOfServiceReport{}))
+	}
+
+	slot := NewPairingSlot(0)
+	slot.Reqs[stakeReqName] = &StakeReq{}
+	strategy := ScoreStrategy{stakeReqName: planstypes.MAX_SCORE_STRATEGY_WEIGHT}
+
+	err := CalcPairingScore(scores, strategy, slot)
+	require.NoError(t, err)
+
+	// the highest stake keeps its stake as score and the ratio between providers is raised to the weight's power
+	require.Equal(t, math.NewUint(uint64(stakes[0])), scores[0].Score)
+	ratio := sdk.NewDecFromInt(math.Int(scores[0].Score)).Quo(sdk.NewDecFromInt(math.Int(scores[1].Score)))
+	require.True(t, ratio.Equal(sdk.NewDec(8)))
+	// a tiny stake still gets a non zero score
+	require.Equal(t, math.OneUint(), scores[2].Score)
+}
+
+func TestQosReqScore(t *testing.T) {
+	newReport := func(latency, availability, sync string) pairingtypes.QualityOfServiceReport {
+		return pairingtypes.QualityOfServiceReport{
+			Latency:      sdk.MustNewDecFromStr(latency),
+			Availability: sdk.MustNewDecFromStr(availability),
+			Sync:         sdk.MustNewDecFromStr(sync),
+		}
+	}
+
+	templates := []struct {
+		name     string
+		report   pairingtypes.QualityOfServiceReport
+		expected uint64
+	}{
+		{"no qos data", pairingtypes.QualityOfServiceReport{}, qosScoreScale},
+		{"invalid qos data", newReport("0", "1", "1"), qosScoreScale},
+		{"neutral qos", newReport("1", "1", "1"), qosScoreScale},
+		{"excellent qos", newReport("1", "1", "0.125"), 2 * qosScoreScale},
+		{"poor qos", newReport("8", "1", "1"), qosScoreScale / 2},
+		{"qos above max is clamped", newReport("0.001", "1", "0.001"), 2 * qosScoreScale},
+		{"qos below min is clamped", newReport("1000", "0.001", "1"), qosScoreScale / 2},
+	}
+
+	for _, tt := range templates {
+		t.Run(tt.name, func(t *testing.T) {
+			qosReq := &QosReq{}
+			score := qosReq.Score(PairingScore{QosExcellenceReport: tt.report})
+			require.Equal(t, math.NewUint(tt.expected), score)
+		})
+	}
+}
+
+func TestCalcPairingScoreWithQos(t *testing.T) {
+	stake := sdk.NewCoin("ulava", math.NewInt(1_000_000_000_000))
+	zeroCoin := sdk.NewCoin("ulava", math.ZeroInt())
+	reports := []pairingtypes.QualityOfServiceReport{
+		{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.NewDecWithPrec(125, 3)},
+		{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()},
+		{},
+	}
+	scores := []*PairingScore{}
+	for i, report := range reports {
+		stakeEntry := &epochstoragetypes.StakeEntry{
+			Address:       strconv.Itoa(i),
+			Stake:         stake,
+			DelegateLimit: zeroCoin,
+			DelegateTotal: zeroCoin,
+		}
+		scores = append(scores, NewPairingScore(stakeEntry, report))
+	}
+
+	slot := NewPairingSlot(0)
+	slot.Reqs[stakeReqName] = &StakeReq{}
+	slot.Reqs[qosReqName] = &QosReq{}
+	strategy := GetStrategy(planstypes.ScoreStrategyWeights{})
+
+	err := CalcPairingScore(scores, strategy, slot)
+	require.NoError(t, err)
+
+	// same stake, so the provider with the better QoS gets the higher score
+	require.True(t, scores[0].Score.GT(scores[1].Score))
+	require.Equal(t, scores[0].Score, scores[1].Score.MulUint64(2))
+	// providers without QoS data get a neutral score
+	require.Equal(t, scores[1].Score, scores[2].Score)
+}
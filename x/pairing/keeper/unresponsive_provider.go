@@ -212,7 +212,7 @@ func (k Keeper) getCurrentProviderStakeStorageList(ctx sdk.Context) []epochstora
 // Function that punishes providers. Current punishment is freeze
 func (k Keeper) punishUnresponsiveProvider(ctx sdk.Context, epoch uint64, providerPaymentStorageKeyList []string, providerAddress, chainID string, complaintCU uint64, servicedCU uint64) error {
 	// freeze the unresponsive provider
-	err := k.FreezeProvider(ctx, providerAddress, []string{chainID}, "unresponsiveness")
+	err := k.freezeProvider(ctx, providerAddress, []string{chainID}, types.DisciplineReasonUnresponsiveness, complaintCU, servicedCU)
 	if err != nil {
 		utils.LavaFormatError("unable to freeze provider entry due to unresponsiveness", err,
 			utils.Attribute{Key: "provider", Value: providerAddress},
@@ -191,6 +191,15 @@ func TestFreezingProviderForUnresponsiveness(t *testing.T) {
 	ts.checkProviderFreeze(provider1_addr, true)
 	ts.checkComplainerReset(provider1_addr, relayEpoch)
 	ts.checkProviderStaked(provider0_addr)
+
+	// verify the freeze was saved in the provider's discipline history
+	history, err := ts.QueryPairingDisciplineHistory(provider1_addr.String(), "")
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, types.DisciplineActionFreeze, history.Records[0].Action)
+	require.Equal(t, types.DisciplineReasonUnresponsiveness, history.Records[0].Reason)
+	require.Equal(t, ts.spec.Index, history.Records[0].ChainID)
+	require.NotZero(t, history.Records[0].ComplainerCu)
 }
 
 func TestFreezingProviderForUnresponsivenessContinueComplainingAfterFreeze(t *testing.T) {
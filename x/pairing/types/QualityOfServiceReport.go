@@ -24,3 +24,34 @@ func (qos *QualityOfServiceReport) ComputeQoSExcellence() (sdk.Dec, error) {
 	}
 	return qos.Availability.Quo(qos.Sync).Quo(qos.Latency).ApproxRoot(3)
 }
+
+func ZeroQualityOfServiceReport() QualityOfServiceReport {
+	return QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+}
+
+// Add returns the sum of two reports (field by field)
+func (qos QualityOfServiceReport) Add(other QualityOfServiceReport) QualityOfServiceReport {
+	return QualityOfServiceReport{
+		Latency:      qos.Latency.Add(other.Latency),
+		Availability: qos.Availability.Add(other.Availability),
+		Sync:         qos.Sync.Add(other.Sync),
+	}
+}
+
+// Mul returns the report with all its fields multiplied by val
+func (qos QualityOfServiceReport) Mul(val sdk.Dec) QualityOfServiceReport {
+	return QualityOfServiceReport{
+		Latency:      qos.Latency.Mul(val),
+		Availability: qos.Availability.Mul(val),
+		Sync:         qos.Sync.Mul(val),
+	}
+}
+
+// Quo returns the report with all its fields divided by val
+func (qos QualityOfServiceReport) Quo(val sdk.Dec) QualityOfServiceReport {
+	return QualityOfServiceReport{
+		Latency:      qos.Latency.Quo(val),
+		Availability: qos.Availability.Quo(val),
+		Sync:         qos.Sync.Quo(val),
+	}
+}
@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/discipline_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisciplineRecord describes a single disciplinary action (freeze, unfreeze or jail)
+// that was applied to a provider's stake entry
+type DisciplineRecord struct {
+	Provider     string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID      string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Block        uint64 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Epoch        uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Action       string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Reason       string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ComplainerCu uint64 `protobuf:"varint,7,opt,name=complainer_cu,json=complainerCu,proto3" json:"complainer_cu,omitempty"`
+	ServicedCu   uint64 `protobuf:"varint,8,opt,name=serviced_cu,json=servicedCu,proto3" json:"serviced_cu,omitempty"`
+	JailBlocks   uint64 `protobuf:"varint,9,opt,name=jail_blocks,json=jailBlocks,proto3" json:"jail_blocks,omitempty"`
+}
+
+func (m *DisciplineRecord) Reset()         { *m = DisciplineRecord{} }
+func (m *DisciplineRecord) String() string { return proto.CompactTextString(m) }
+func (*DisciplineRecord) ProtoMessage()    {}
+func (*DisciplineRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66f2e3ea970e2fdb, []int{0}
+}
+func (m *DisciplineRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisciplineRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisciplineRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisciplineRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisciplineRecord.Merge(m, src)
+}
+func (m *DisciplineRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisciplineRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisciplineRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisciplineRecord proto.InternalMessageInfo
+
+func (m *DisciplineRecord) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *DisciplineRecord) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *DisciplineRecord) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *DisciplineRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DisciplineRecord) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *DisciplineRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DisciplineRecord) GetComplainerCu() uint64 {
+	if m != nil {
+		return m.ComplainerCu
+	}
+	return 0
+}
+
+func (m *DisciplineRecord) GetServicedCu() uint64 {
+	if m != nil {
+		return m.ServicedCu
+	}
+	return 0
+}
+
+func (m *DisciplineRecord) GetJailBlocks() uint64 {
+	if m != nil {
+		return m.JailBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DisciplineRecord)(nil), "lavanet.lava.pairing.DisciplineRecord")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/discipline_record.proto", fileDescriptor_66f2e3ea970e2fdb)
+}
+
+var fileDescriptor_66f2e3ea970e2fdb = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xeb, 0x7e, 0xfd, 0xf5, 0x07, 0x12, 0xb2, 0x2a, 0x64, 0x31, 0x98, 0x0a, 0x06, 0x3a,
+	0xa0, 0x64, 0xe0, 0x0a, 0x28, 0x5d, 0x58, 0x3b, 0xb2, 0x54, 0xae, 0x63, 0x35, 0x07, 0x52, 0xdb,
+	0x72, 0x7e, 0x04, 0xd7, 0xc0, 0xc2, 0x65, 0x31, 0x76, 0x64, 0x44, 0xc9, 0x8d, 0x20, 0xdb, 0x69,
+	0x10, 0xd3, 0xd1, 0xfb, 0xf8, 0x39, 0x47, 0xd6, 0x8b, 0x6f, 0x33, 0x5e, 0x71, 0x25, 0x8b, 0xd8,
+	0xcd, 0xd8, 0x70, 0xb0, 0xa0, 0x76, 0x71, 0x02, 0xb9, 0x00, 0x93, 0x81, 0x92, 0x1b, 0x2b, 0x85,
+	0xb6, 0x49, 0x64, 0xac, 0x2e, 0x34, 0x99, 0xb5, 0x76, 0xe4, 0x66, 0xd4, 0xda, 0x57, 0xef, 0x7d,
+	0x7c, 0xb6, 0xea, 0x36, 0xd6, 0x7e, 0x81, 0x5c, 0xe0, 0x89, 0xb1, 0xba, 0x82, 0x44, 0x5a, 0x8a,
+	0xe6, 0x68, 0x31, 0x5d, 0x77, 0x99, 0x50, 0x3c, 0x16, 0x29, 0x07, 0xf5, 0xb8, 0xa2, 0x7d, 0xff,
+	0x74, 0x8c, 0x64, 0x86, 0x87, 0xdb, 0x4c, 0x8b, 0x17, 0xfa, 0x6f, 0x8e, 0x16, 0x83, 0x75, 0x08,
+	0x8e, 0x4a, 0xa3, 0x45, 0x4a, 0x07, 0x81, 0xfa, 0x40, 0xce, 0xf1, 0x88, 0x8b, 0x02, 0xb4, 0xa2,
+	0x43, 0x7f, 0xa4, 0x4d, 0x8e, 0x5b, 0xc9, 0x73, 0xad, 0xe8, 0x28, 0xf0, 0x90, 0xc8, 0x35, 0x3e,
+	0x15, 0x7a, 0x6f, 0x32, 0x0e, 0x4a, 0xda, 0x8d, 0x28, 0xe9, 0xd8, 0x5f, 0x3b, 0xf9, 0x85, 0x0f,
+	0x25, 0xb9, 0xc4, 0xff, 0x73, 0x69, 0x2b, 0x10, 0x32, 0x71, 0xca, 0xc4, 0x2b, 0xf8, 0x88, 0x82,
+	0xf0, 0xcc, 0x21, 0xdb, 0xf8, 0x9f, 0xe5, 0x74, 0x1a, 0x04, 0x87, 0x96, 0x9e, 0x2c, 0xef, 0x3f,
+	0x6b, 0x86, 0x0e, 0x35, 0x43, 0xdf, 0x35, 0x43, 0x1f, 0x0d, 0xeb, 0x1d, 0x1a, 0xd6, 0xfb, 0x6a,
+	0x58, 0xef, 0xe9, 0x66, 0x07, 0x45, 0x5a, 0x6e, 0x23, 0xa1, 0xf7, 0xf1, 0x9f, 0xda, 0x5f, 0xbb,
+	0xe2, 0x8b, 0x37, 0x23, 0xf3, 0xed, 0xc8, 0xb7, 0x7d, 0xf7, 0x33, 0x00, 0x2f, 0x02, 0x60, 0xab,
+	0x9d, 0x01, 0x00, 0x00,
+}
+
+func (m *DisciplineRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisciplineRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisciplineRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailBlocks != 0 {
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(m.JailBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ServicedCu != 0 {
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(m.ServicedCu))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ComplainerCu != 0 {
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(m.ComplainerCu))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Block != 0 {
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDisciplineRecord(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDisciplineRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDisciplineRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DisciplineRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDisciplineRecord(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovDisciplineRecord(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovDisciplineRecord(uint64(m.Block))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDisciplineRecord(uint64(m.Epoch))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovDisciplineRecord(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDisciplineRecord(uint64(l))
+	}
+	if m.ComplainerCu != 0 {
+		n += 1 + sovDisciplineRecord(uint64(m.ComplainerCu))
+	}
+	if m.ServicedCu != 0 {
+		n += 1 + sovDisciplineRecord(uint64(m.ServicedCu))
+	}
+	if m.JailBlocks != 0 {
+		n += 1 + sovDisciplineRecord(uint64(m.JailBlocks))
+	}
+	return n
+}
+
+func sovDisciplineRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDisciplineRecord(x uint64) (n int) {
+	return sovDisciplineRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DisciplineRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDisciplineRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisciplineRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisciplineRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplainerCu", wireType)
+			}
+			m.ComplainerCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplainerCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicedCu", wireType)
+			}
+			m.ServicedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServicedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailBlocks", wireType)
+			}
+			m.JailBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDisciplineRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDisciplineRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDisciplineRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDisciplineRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDisciplineRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDisciplineRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDisciplineRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDisciplineRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDisciplineRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDisciplineRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDisciplineRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,6 +19,7 @@ func DefaultGenesis() *GenesisState {
 		BadgeUsedCuList:                        []BadgeUsedCu{},
 		BadgesTS:                               *timerstoretypes.DefaultGenesis(),
 		ProviderQosFS:                          *fixationtypes.DefaultGenesis(),
+		ProviderQosAggregationList:             []ProviderQosAggregation{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -63,6 +64,27 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("badgeUsedCuList is not empty")
 	}
 
+	// Check for duplicated index in providerQosAggregation
+	providerQosAggregationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderQosAggregationList {
+		if _, ok := providerQosAggregationIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for providerQosAggregation")
+		}
+		providerQosAggregationIndexMap[elem.Index] = struct{}{}
+	}
+
+	// Check for duplicated index in disciplineRecord
+	disciplineRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DisciplineRecordList {
+		index := string(DisciplineRecordKey(elem.Provider, elem.ChainID, elem.Block, elem.Action))
+		if _, ok := disciplineRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for disciplineRecord")
+		}
+		disciplineRecordIndexMap[index] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -86,6 +86,8 @@ type GenesisState struct {
 	BadgeUsedCuList                        []BadgeUsedCu                        `protobuf:"bytes,5,rep,name=badgeUsedCuList,proto3" json:"badgeUsedCuList"`
 	BadgesTS                               types.GenesisState                   `protobuf:"bytes,6,opt,name=badgesTS,proto3" json:"badgesTS"`
 	ProviderQosFS                          types1.GenesisState                  `protobuf:"bytes,7,opt,name=providerQosFS,proto3" json:"providerQosFS"`
+	ProviderQosAggregationList             []ProviderQosAggregation             `protobuf:"bytes,8,rep,name=providerQosAggregationList,proto3" json:"providerQosAggregationList"`
+	DisciplineRecordList                   []DisciplineRecord                   `protobuf:"bytes,9,rep,name=disciplineRecordList,proto3" json:"disciplineRecordList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -170,6 +172,20 @@ func (m *GenesisState) GetProviderQosFS() types1.GenesisState {
 	return types1.GenesisState{}
 }
 
+func (m *GenesisState) GetProviderQosAggregationList() []ProviderQosAggregation {
+	if m != nil {
+		return m.ProviderQosAggregationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisciplineRecordList() []DisciplineRecord {
+	if m != nil {
+		return m.DisciplineRecordList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BadgeUsedCu)(nil), "lavanet.lava.pairing.BadgeUsedCu")
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
@@ -180,39 +196,43 @@ func init() {
 }
 
 var fileDescriptor_dbd1e49b8b57595b = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x36, 0x4d, 0xd1, 0xa6, 0x80, 0xba, 0xaa, 0x84, 0x15, 0x21, 0x93, 0xa6, 0x02,
-	0x52, 0x09, 0xd9, 0x52, 0x7b, 0x41, 0xdc, 0x9a, 0x0a, 0x7a, 0x80, 0x43, 0x3e, 0x5a, 0x21, 0x71,
-	0xb1, 0x36, 0xc9, 0xe0, 0xae, 0x68, 0xbc, 0xc6, 0xbb, 0xae, 0x9a, 0xb7, 0xe0, 0xc4, 0x03, 0xf0,
-	0x34, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0xc7, 0x93, 0x34, 0x2e, 0xcb, 0xc7, 0xc9,
-	0xde, 0xf8, 0x37, 0xbf, 0xff, 0x66, 0x66, 0x97, 0xb5, 0x2e, 0xc4, 0xa5, 0x88, 0xc1, 0x04, 0xf9,
-	0x33, 0x48, 0x84, 0x4c, 0x65, 0x1c, 0x05, 0x11, 0xc4, 0xa0, 0xa5, 0xf6, 0x93, 0x54, 0x19, 0xc5,
-	0x77, 0x88, 0xf1, 0xf3, 0xa7, 0x4f, 0x4c, 0x63, 0x27, 0x52, 0x91, 0x42, 0x20, 0xc8, 0xdf, 0x0a,
-	0xb6, 0xb1, 0x6b, 0xf5, 0x25, 0x22, 0x15, 0x13, 0xd2, 0x35, 0x8e, 0xac, 0x48, 0x16, 0xcb, 0xcf,
-	0x19, 0x84, 0x89, 0x98, 0x4e, 0x20, 0x36, 0xa1, 0x36, 0x2a, 0x15, 0x11, 0x84, 0xa3, 0x0b, 0x99,
-	0x2f, 0x93, 0x54, 0x5d, 0xca, 0x31, 0xa4, 0xa4, 0x38, 0xb4, 0xa7, 0x10, 0x74, 0x57, 0x42, 0x45,
-	0xfb, 0xd6, 0x22, 0x48, 0xd4, 0xe8, 0x7c, 0x51, 0xa1, 0xad, 0xe8, 0x47, 0x79, 0x25, 0x8c, 0x54,
-	0x71, 0xae, 0x83, 0xe5, 0x8a, 0xd0, 0xbd, 0x12, 0x6a, 0xe4, 0x04, 0xd2, 0x82, 0xc3, 0xd7, 0x02,
-	0x6a, 0xf5, 0x58, 0xbd, 0x23, 0xc6, 0x11, 0x9c, 0x69, 0x18, 0x1f, 0x67, 0x7c, 0x9f, 0x6d, 0x0f,
-	0xf3, 0x65, 0x98, 0x69, 0x18, 0x87, 0xa3, 0x2c, 0xfc, 0x04, 0x53, 0xd7, 0x69, 0x3a, 0xed, 0xad,
-	0xfe, 0x83, 0xe1, 0x2d, 0xf7, 0x16, 0xa6, 0xfc, 0x11, 0xdb, 0x24, 0xc8, 0x5d, 0x6b, 0x3a, 0xed,
-	0x6a, 0xbf, 0x96, 0xe1, 0xb7, 0xd6, 0xb7, 0x0d, 0xb6, 0x75, 0x52, 0x8c, 0x69, 0x60, 0x84, 0x01,
-	0xfe, 0x8a, 0xd5, 0x8a, 0x36, 0xa3, 0xa9, 0x7e, 0xf0, 0xd8, 0xb7, 0x8d, 0xcd, 0xef, 0x22, 0xd3,
-	0xa9, 0x5e, 0xff, 0x78, 0x52, 0xe9, 0x53, 0x05, 0xff, 0xea, 0xb0, 0x67, 0xc5, 0x00, 0xba, 0x45,
-	0x23, 0x06, 0x45, 0xe7, 0x8e, 0xb1, 0xfb, 0x5d, 0xea, 0xeb, 0x3b, 0xa9, 0x8d, 0xbb, 0xd6, 0x5c,
-	0x6f, 0xd7, 0x0f, 0x5e, 0xda, 0xe5, 0x67, 0xff, 0x74, 0x50, 0xf0, 0x7f, 0xa6, 0xf1, 0x94, 0x35,
-	0x16, 0x53, 0x2d, 0xb3, 0xb8, 0x97, 0x75, 0xdc, 0xcb, 0x8b, 0x3f, 0xfc, 0x51, 0x6b, 0x1d, 0xe5,
-	0xff, 0xc5, 0xca, 0xdf, 0xb3, 0x6d, 0x3c, 0x14, 0xf4, 0x49, 0x63, 0x54, 0x15, 0xa3, 0xf6, 0xec,
-	0x51, 0xaf, 0x57, 0x71, 0x4a, 0xf8, 0xdd, 0xc1, 0x7b, 0xec, 0xe1, 0xca, 0x74, 0x51, 0xbb, 0x81,
-	0xda, 0x5d, 0xbb, 0x76, 0xe5, 0xc8, 0x90, 0xf4, 0x6e, 0x3d, 0x3f, 0x61, 0xf7, 0xf0, 0x27, 0x7d,
-	0x3a, 0x70, 0x6b, 0x38, 0xf6, 0xa7, 0x65, 0xd7, 0xed, 0x81, 0xf4, 0x57, 0x4f, 0x0b, 0xf9, 0x96,
-	0xc5, 0xfc, 0x94, 0xdd, 0x5f, 0xb4, 0xa4, 0xa7, 0xf4, 0x9b, 0x81, 0xbb, 0x89, 0xb6, 0x76, 0xd9,
-	0x56, 0xba, 0x09, 0x36, 0x61, 0x59, 0xd2, 0x39, 0xba, 0x9e, 0x79, 0xce, 0xcd, 0xcc, 0x73, 0x7e,
-	0xce, 0x3c, 0xe7, 0xcb, 0xdc, 0xab, 0xdc, 0xcc, 0xbd, 0xca, 0xf7, 0xb9, 0x57, 0xf9, 0xf0, 0x3c,
-	0x92, 0xe6, 0x3c, 0x1b, 0xfa, 0x23, 0x35, 0x09, 0x4a, 0x37, 0xe8, 0x6a, 0x79, 0x33, 0xcd, 0x34,
-	0x01, 0x3d, 0xac, 0xe1, 0x0d, 0x3a, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x86, 0x08, 0xe8, 0x13,
-	0xa9, 0x04, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xad, 0x74, 0xc3, 0x1d, 0xa0, 0x59, 0x95, 0xa8, 0x2a, 0x14, 0xba, 0x4e, 0x6c,
+	0x9d, 0x34, 0xa5, 0xd2, 0x76, 0x41, 0xdc, 0xda, 0x01, 0x3b, 0xc0, 0xa1, 0x7f, 0x36, 0x21, 0x71,
+	0x09, 0x69, 0x62, 0x3c, 0x8b, 0x36, 0xce, 0x6c, 0x67, 0x5a, 0xbf, 0x05, 0x27, 0x3e, 0xd3, 0x8e,
+	0x3b, 0x72, 0x42, 0xa8, 0xfd, 0x12, 0x1c, 0x51, 0xde, 0xb8, 0x6d, 0xd2, 0x99, 0xb1, 0x53, 0xe2,
+	0xe4, 0x79, 0x7f, 0x8f, 0xed, 0xe7, 0xb5, 0x51, 0x63, 0xe4, 0x5d, 0x79, 0x21, 0x51, 0xad, 0xe4,
+	0xd9, 0x8a, 0x3c, 0x26, 0x58, 0x48, 0x5b, 0x94, 0x84, 0x44, 0x32, 0xe9, 0x44, 0x82, 0x2b, 0x8e,
+	0x2b, 0x5a, 0xe3, 0x24, 0x4f, 0x47, 0x6b, 0x6a, 0x15, 0xca, 0x29, 0x07, 0x41, 0x2b, 0x79, 0x4b,
+	0xb5, 0xb5, 0x1d, 0x23, 0x2f, 0xf2, 0x84, 0x37, 0xd6, 0xb8, 0x5a, 0xdb, 0x28, 0x89, 0x43, 0x76,
+	0x19, 0x13, 0x37, 0xf2, 0x26, 0x63, 0x12, 0x2a, 0x57, 0x2a, 0x2e, 0x3c, 0x4a, 0x5c, 0x7f, 0xc4,
+	0x92, 0x61, 0x24, 0xf8, 0x15, 0x0b, 0x88, 0xd0, 0x88, 0x63, 0xb3, 0x8b, 0x16, 0xad, 0x42, 0x74,
+	0xd1, 0x81, 0xb1, 0x88, 0x44, 0xdc, 0xbf, 0x98, 0x57, 0x48, 0xa3, 0xf4, 0x2b, 0xbb, 0xf6, 0x14,
+	0xe3, 0x61, 0x82, 0x23, 0x8b, 0x91, 0x96, 0xee, 0xe6, 0xa4, 0x8a, 0x8d, 0x89, 0x48, 0x75, 0xf0,
+	0xaa, 0x45, 0xfb, 0xf7, 0xcf, 0xf7, 0x92, 0xcf, 0x8d, 0x0f, 0x8d, 0xc2, 0x80, 0x49, 0x9f, 0x45,
+	0x23, 0x16, 0x12, 0x57, 0x10, 0x9f, 0x8b, 0x20, 0x55, 0x37, 0x7a, 0xa8, 0xdc, 0xf1, 0x02, 0x4a,
+	0xce, 0x25, 0x09, 0x4e, 0x62, 0x7c, 0x80, 0xb6, 0x87, 0xc9, 0xd0, 0x8d, 0x25, 0x09, 0x5c, 0x3f,
+	0x76, 0xbf, 0x91, 0x49, 0xd5, 0xaa, 0x5b, 0xcd, 0xad, 0xfe, 0xd3, 0xe1, 0x52, 0xf7, 0x81, 0x4c,
+	0xf0, 0x73, 0xb4, 0xa1, 0x45, 0xd5, 0xb5, 0xba, 0xd5, 0x2c, 0xf6, 0x4b, 0x31, 0xfc, 0x6b, 0xfc,
+	0x29, 0xa1, 0xad, 0xd3, 0x34, 0xfd, 0x81, 0xf2, 0x14, 0xc1, 0x6f, 0x50, 0x29, 0x4d, 0x0f, 0x48,
+	0xe5, 0xa3, 0x17, 0x8e, 0xa9, 0x1b, 0x9c, 0x2e, 0x68, 0x3a, 0xc5, 0x9b, 0x5f, 0x2f, 0x0b, 0x7d,
+	0x5d, 0x81, 0x7f, 0x58, 0x68, 0x2f, 0xcd, 0xb5, 0x9b, 0xee, 0xef, 0x20, 0x0d, 0xe4, 0x04, 0x42,
+	0xed, 0xea, 0xe5, 0x7f, 0x64, 0x52, 0x55, 0xd7, 0xea, 0xeb, 0xcd, 0xf2, 0xd1, 0x6b, 0x33, 0xfc,
+	0xfc, 0xbf, 0x0c, 0x6d, 0xfc, 0x40, 0x37, 0x2c, 0x50, 0x6d, 0xbe, 0xf9, 0x79, 0x2d, 0xcc, 0x65,
+	0x1d, 0xe6, 0x72, 0xf8, 0x8f, 0x85, 0x1a, 0xeb, 0xb4, 0xff, 0x3d, 0x54, 0xfc, 0x09, 0x6d, 0x43,
+	0xaf, 0xe9, 0x5f, 0x12, 0xac, 0x8a, 0x60, 0xb5, 0x6b, 0xb6, 0x7a, 0x97, 0x95, 0x6b, 0x87, 0xbb,
+	0x0c, 0xdc, 0x43, 0xcf, 0x32, 0xe9, 0x02, 0xf6, 0x11, 0x60, 0x77, 0xcc, 0xd8, 0x4c, 0xcb, 0x68,
+	0xe8, 0x6a, 0x3d, 0x3e, 0x45, 0x9b, 0xf0, 0x49, 0x9e, 0x0d, 0xaa, 0x25, 0x88, 0xfd, 0x55, 0x9e,
+	0xb5, 0xec, 0x73, 0x27, 0xdb, 0x2d, 0x9a, 0xb7, 0x28, 0xc6, 0x67, 0xe8, 0xc9, 0x7c, 0x4b, 0x7a,
+	0x5c, 0xbe, 0x1f, 0x54, 0x37, 0x80, 0xd6, 0xcc, 0xd3, 0x72, 0x07, 0xcc, 0x04, 0xcc, 0x43, 0xb2,
+	0xf1, 0xf5, 0xb8, 0x6c, 0x53, 0x2a, 0x08, 0x05, 0x00, 0x2c, 0x7e, 0xf3, 0x21, 0xf1, 0xe5, 0xeb,
+	0x56, 0xe3, 0xbb, 0x4b, 0xc5, 0x5f, 0x50, 0x65, 0x79, 0x0c, 0xfb, 0x70, 0x0a, 0xc1, 0xed, 0x31,
+	0xb8, 0xed, 0x99, 0xdd, 0xde, 0xae, 0x54, 0x68, 0x1f, 0x23, 0xa9, 0xd3, 0xbe, 0x99, 0xda, 0xd6,
+	0xed, 0xd4, 0xb6, 0x7e, 0x4f, 0x6d, 0xeb, 0xfb, 0xcc, 0x2e, 0xdc, 0xce, 0xec, 0xc2, 0xcf, 0x99,
+	0x5d, 0xf8, 0xbc, 0x4f, 0x99, 0xba, 0x88, 0x87, 0x8e, 0xcf, 0xc7, 0xad, 0xdc, 0x05, 0x71, 0xbd,
+	0xb8, 0x22, 0xd4, 0x24, 0x22, 0x72, 0x58, 0x82, 0x7b, 0xe1, 0xf8, 0xef, 0x00, 0x67, 0x24, 0x75,
+	0xfe, 0xd6, 0x05, 0x00, 0x00,
 }
 
 func (m *BadgeUsedCu) Marshal() (dAtA []byte, err error) {
@@ -270,6 +290,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DisciplineRecordList) > 0 {
+		for iNdEx := len(m.DisciplineRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisciplineRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProviderQosAggregationList) > 0 {
+		for iNdEx := len(m.ProviderQosAggregationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderQosAggregationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.ProviderQosFS.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -422,6 +470,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ProviderQosFS.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProviderQosAggregationList) > 0 {
+		for _, e := range m.ProviderQosAggregationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisciplineRecordList) > 0 {
+		for _, e := range m.DisciplineRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -798,6 +858,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderQosAggregationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderQosAggregationList = append(m.ProviderQosAggregationList, ProviderQosAggregation{})
+			if err := m.ProviderQosAggregationList[len(m.ProviderQosAggregationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisciplineRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisciplineRecordList = append(m.DisciplineRecordList, DisciplineRecord{})
+			if err := m.DisciplineRecordList[len(m.DisciplineRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -95,6 +95,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated disciplineRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DisciplineRecordList: []types.DisciplineRecord{
+					{
+						Provider: "0",
+						ChainID:  "0",
+						Block:    1,
+						Action:   types.DisciplineActionFreeze,
+					},
+					{
+						Provider: "0",
+						ChainID:  "0",
+						Block:    1,
+						Action:   types.DisciplineActionFreeze,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
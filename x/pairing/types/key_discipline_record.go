@@ -0,0 +1,44 @@
+package types
+
+import "fmt"
+
+const (
+	// DisciplineRecordKeyPrefix is the prefix to retrieve all DisciplineRecord
+	DisciplineRecordKeyPrefix = "DisciplineRecord/value/"
+)
+
+// discipline record actions
+const (
+	DisciplineActionFreeze   = "freeze"
+	DisciplineActionUnfreeze = "unfreeze"
+	DisciplineActionJail     = "jail"
+)
+
+// discipline record reasons
+const (
+	// DisciplineReasonUnresponsiveness is the freeze reason of unresponsive providers
+	DisciplineReasonUnresponsiveness = "unresponsiveness"
+	// DisciplineReasonProviderRequest is the reason of actions requested by the provider itself
+	// (unfreeze, or freeze without a reason)
+	DisciplineReasonProviderRequest = "provider request"
+)
+
+// MaxDisciplineRecordsPerChain is the max number of discipline records kept for a provider on
+// a single chain. When exceeded, the oldest records are pruned
+const MaxDisciplineRecordsPerChain = 100
+
+// DisciplineRecordKey returns the store key to retrieve a DisciplineRecord. Records are keyed
+// by provider and chain ID first (so the provider's history can be iterated by prefix) and
+// then by block (zero padded, to keep them sorted) and action.
+func DisciplineRecordKey(provider, chainID string, block uint64, action string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d/%s/", provider, chainID, block, action))
+}
+
+// DisciplineRecordPrefix returns the store prefix of a provider's discipline records
+// (optionally filtered to a specific chain ID)
+func DisciplineRecordPrefix(provider, chainID string) []byte {
+	if chainID == "" {
+		return []byte(provider + "/")
+	}
+	return []byte(provider + "/" + chainID + "/")
+}
@@ -2,10 +2,30 @@ package types
 
 import (
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ProviderQosStorePrefix          = "ProviderQosStore/"
+	ProviderQosAggregationKeyPrefix = "ProviderQosAggregation/"
 )
 
-const ProviderQosStorePrefix = "ProviderQosStore/"
+// QosDecayFactor is the weight of a provider's previous QoS when merging the QoS
+// excellence reports of a new epoch (newQoS = oldQoS * decay + epochQoS * (1 - decay))
+func QosDecayFactor() sdk.Dec {
+	return sdk.NewDecWithPrec(8, 1) // 0.8
+}
 
 func ProviderQosKey(provider string, chainID string, cluster string) string {
 	return strings.Join([]string{chainID, cluster, provider}, "/")
 }
+
+// DecodeProviderQosKey decodes a provider QoS key to its components
+func DecodeProviderQosKey(key string) (provider string, chainID string, cluster string) {
+	split := strings.Split(key, "/")
+	if len(split) != 3 {
+		return "", "", ""
+	}
+	return split[2], split[0], split[1]
+}
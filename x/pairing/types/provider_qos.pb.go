@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lavanet/lava/pairing/provider_qos.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderQosAggregation holds the QoS excellence reports of a provider (for a specific chain and
+// cluster) that were received in the current epoch. The reports are summed with weights of the
+// reporters' CU and are merged into the provider QoS fixation store at the end of the epoch
+type ProviderQosAggregation struct {
+	Index             string                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	WeightedReportSum QualityOfServiceReport `protobuf:"bytes,2,opt,name=weighted_report_sum,json=weightedReportSum,proto3" json:"weighted_report_sum"`
+	TotalCu           uint64                 `protobuf:"varint,3,opt,name=total_cu,json=totalCu,proto3" json:"total_cu,omitempty"`
+}
+
+func (m *ProviderQosAggregation) Reset()         { *m = ProviderQosAggregation{} }
+func (m *ProviderQosAggregation) String() string { return proto.CompactTextString(m) }
+func (*ProviderQosAggregation) ProtoMessage()    {}
+func (*ProviderQosAggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4002a5ae098b5f6a, []int{0}
+}
+func (m *ProviderQosAggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQosAggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQosAggregation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQosAggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQosAggregation.Merge(m, src)
+}
+func (m *ProviderQosAggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQosAggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQosAggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQosAggregation proto.InternalMessageInfo
+
+func (m *ProviderQosAggregation) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ProviderQosAggregation) GetWeightedReportSum() QualityOfServiceReport {
+	if m != nil {
+		return m.WeightedReportSum
+	}
+	return QualityOfServiceReport{}
+}
+
+func (m *ProviderQosAggregation) GetTotalCu() uint64 {
+	if m != nil {
+		return m.TotalCu
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ProviderQosAggregation)(nil), "lavanet.lava.pairing.ProviderQosAggregation")
+}
+
+func init() {
+	proto.RegisterFile("lavanet/lava/pairing/provider_qos.proto", fileDescriptor_4002a5ae098b5f6a)
+}
+
+var fileDescriptor_4002a5ae098b5f6a = []byte{
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xcd, 0x4e, 0xbb, 0x40,
+	0x14, 0xc5, 0x99, 0xff, 0xbf, 0x7e, 0xe1, 0x4a, 0x24, 0x06, 0xbb, 0x18, 0x89, 0x9b, 0xb2, 0x30,
+	0x43, 0xa2, 0x4f, 0xd0, 0xfa, 0x00, 0x5a, 0xba, 0x73, 0x43, 0x06, 0x18, 0xa7, 0x93, 0x00, 0x17,
+	0x87, 0x19, 0x2c, 0x6f, 0xe1, 0x8b, 0xf8, 0x1e, 0x5d, 0x76, 0xe9, 0xca, 0x18, 0x78, 0x11, 0xc3,
+	0x87, 0x26, 0x26, 0x5d, 0x9d, 0x39, 0x93, 0xdf, 0x3d, 0xf7, 0x1e, 0x73, 0x96, 0xd2, 0x8a, 0xe6,
+	0x4c, 0xf9, 0x9d, 0xfa, 0x05, 0x15, 0x52, 0xe4, 0xdc, 0x2f, 0x24, 0x54, 0x22, 0x61, 0x32, 0x7c,
+	0x81, 0x92, 0x14, 0x12, 0x14, 0x58, 0xf6, 0x08, 0x92, 0x4e, 0xc9, 0x08, 0x4e, 0x6d, 0x0e, 0x1c,
+	0x7a, 0xc0, 0xef, 0x5e, 0x03, 0x3b, 0x75, 0xf7, 0x86, 0x4a, 0x96, 0xd2, 0x7a, 0x20, 0xae, 0xdf,
+	0x91, 0x79, 0xf1, 0x38, 0x2e, 0x59, 0x42, 0x39, 0xe7, 0x5c, 0x32, 0x4e, 0x95, 0x80, 0xdc, 0xb2,
+	0xcd, 0x03, 0x91, 0x27, 0x6c, 0xe3, 0x20, 0x17, 0x79, 0x27, 0xc1, 0x60, 0xac, 0xc8, 0x3c, 0x7f,
+	0x65, 0x82, 0xaf, 0x15, 0x4b, 0x42, 0xc9, 0x0a, 0x90, 0x2a, 0x2c, 0x75, 0xe6, 0xfc, 0x73, 0x91,
+	0x77, 0x7a, 0x7b, 0x43, 0xf6, 0x1d, 0x47, 0x96, 0x9a, 0xa6, 0x42, 0xd5, 0x0f, 0xcf, 0x2b, 0x26,
+	0x2b, 0x11, 0xb3, 0xa0, 0x9f, 0x5b, 0x4c, 0xb6, 0x9f, 0x57, 0x46, 0x70, 0xf6, 0x13, 0x37, 0xfc,
+	0xae, 0x74, 0x66, 0x5d, 0x9a, 0xc7, 0x0a, 0x14, 0x4d, 0xc3, 0x58, 0x3b, 0xff, 0x5d, 0xe4, 0x4d,
+	0x82, 0xa3, 0xde, 0xdf, 0xeb, 0xc5, 0x7c, 0xdb, 0x60, 0xb4, 0x6b, 0x30, 0xfa, 0x6a, 0x30, 0x7a,
+	0x6b, 0xb1, 0xb1, 0x6b, 0xb1, 0xf1, 0xd1, 0x62, 0xe3, 0x69, 0xc6, 0x85, 0x5a, 0xeb, 0x88, 0xc4,
+	0x90, 0xf9, 0x7f, 0x6a, 0x6f, 0x7e, 0x8b, 0xab, 0xba, 0x60, 0x65, 0x74, 0xd8, 0x37, 0xbf, 0xfb,
+	0x1e, 0x00, 0xd8, 0x59, 0xa1, 0x26, 0x72, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderQosAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQosAggregation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQosAggregation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalCu != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.TotalCu))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.WeightedReportSum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderQos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderQos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderQosAggregation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = m.WeightedReportSum.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	if m.TotalCu != 0 {
+		n += 1 + sovProviderQos(uint64(m.TotalCu))
+	}
+	return n
+}
+
+func sovProviderQos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderQos(x uint64) (n int) {
+	return sovProviderQos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderQosAggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQosAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQosAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedReportSum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedReportSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCu", wireType)
+			}
+			m.TotalCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderQos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderQos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderQos
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderQos
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderQos
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderQos        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderQos          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderQos = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1781,6 +1781,274 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) GetDetails() []*ChainIDPayout {
 	return nil
 }
 
+type QueryProviderQosRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Cluster  string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (m *QueryProviderQosRequest) Reset()         { *m = QueryProviderQosRequest{} }
+func (m *QueryProviderQosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderQosRequest) ProtoMessage()    {}
+func (*QueryProviderQosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{34}
+}
+func (m *QueryProviderQosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderQosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderQosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderQosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderQosRequest.Merge(m, src)
+}
+func (m *QueryProviderQosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderQosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderQosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderQosRequest proto.InternalMessageInfo
+
+func (m *QueryProviderQosRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryProviderQosRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryProviderQosRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+type ProviderQos struct {
+	ChainID         string                  `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Cluster         string                  `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Qos             QualityOfServiceReport  `protobuf:"bytes,3,opt,name=qos,proto3" json:"qos"`
+	PendingEpochQos *QualityOfServiceReport `protobuf:"bytes,4,opt,name=pending_epoch_qos,json=pendingEpochQos,proto3" json:"pending_epoch_qos,omitempty"`
+}
+
+func (m *ProviderQos) Reset()         { *m = ProviderQos{} }
+func (m *ProviderQos) String() string { return proto.CompactTextString(m) }
+func (*ProviderQos) ProtoMessage()    {}
+func (*ProviderQos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{35}
+}
+func (m *ProviderQos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQos.Merge(m, src)
+}
+func (m *ProviderQos) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQos) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQos proto.InternalMessageInfo
+
+func (m *ProviderQos) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderQos) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *ProviderQos) GetQos() QualityOfServiceReport {
+	if m != nil {
+		return m.Qos
+	}
+	return QualityOfServiceReport{}
+}
+
+func (m *ProviderQos) GetPendingEpochQos() *QualityOfServiceReport {
+	if m != nil {
+		return m.PendingEpochQos
+	}
+	return nil
+}
+
+type QueryProviderQosResponse struct {
+	Qos []ProviderQos `protobuf:"bytes,1,rep,name=qos,proto3" json:"qos"`
+}
+
+func (m *QueryProviderQosResponse) Reset()         { *m = QueryProviderQosResponse{} }
+func (m *QueryProviderQosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderQosResponse) ProtoMessage()    {}
+func (*QueryProviderQosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{36}
+}
+func (m *QueryProviderQosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderQosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderQosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderQosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderQosResponse.Merge(m, src)
+}
+func (m *QueryProviderQosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderQosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderQosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderQosResponse proto.InternalMessageInfo
+
+func (m *QueryProviderQosResponse) GetQos() []ProviderQos {
+	if m != nil {
+		return m.Qos
+	}
+	return nil
+}
+
+type QueryDisciplineHistoryRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID  string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *QueryDisciplineHistoryRequest) Reset()         { *m = QueryDisciplineHistoryRequest{} }
+func (m *QueryDisciplineHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisciplineHistoryRequest) ProtoMessage()    {}
+func (*QueryDisciplineHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{37}
+}
+func (m *QueryDisciplineHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisciplineHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisciplineHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisciplineHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisciplineHistoryRequest.Merge(m, src)
+}
+func (m *QueryDisciplineHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisciplineHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisciplineHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisciplineHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDisciplineHistoryRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryDisciplineHistoryRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+type QueryDisciplineHistoryResponse struct {
+	Records []DisciplineRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryDisciplineHistoryResponse) Reset()         { *m = QueryDisciplineHistoryResponse{} }
+func (m *QueryDisciplineHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisciplineHistoryResponse) ProtoMessage()    {}
+func (*QueryDisciplineHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{38}
+}
+func (m *QueryDisciplineHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisciplineHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisciplineHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisciplineHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisciplineHistoryResponse.Merge(m, src)
+}
+func (m *QueryDisciplineHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisciplineHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisciplineHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisciplineHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDisciplineHistoryResponse) GetRecords() []DisciplineRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.pairing.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
@@ -1816,138 +2084,158 @@ func init() {
 	proto.RegisterType((*ChainIDPayout)(nil), "lavanet.lava.pairing.ChainIDPayout")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutRequest)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutRequest")
 	proto.RegisterType((*QuerySubscriptionMonthlyPayoutResponse)(nil), "lavanet.lava.pairing.QuerySubscriptionMonthlyPayoutResponse")
+	proto.RegisterType((*QueryProviderQosRequest)(nil), "lavanet.lava.pairing.QueryProviderQosRequest")
+	proto.RegisterType((*ProviderQos)(nil), "lavanet.lava.pairing.ProviderQos")
+	proto.RegisterType((*QueryProviderQosResponse)(nil), "lavanet.lava.pairing.QueryProviderQosResponse")
+	proto.RegisterType((*QueryDisciplineHistoryRequest)(nil), "lavanet.lava.pairing.QueryDisciplineHistoryRequest")
+	proto.RegisterType((*QueryDisciplineHistoryResponse)(nil), "lavanet.lava.pairing.QueryDisciplineHistoryResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/query.proto", fileDescriptor_9e149ce9d21da0d8) }
 
 var fileDescriptor_9e149ce9d21da0d8 = []byte{
-	// 2001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0xdc, 0x6a,
-	0x15, 0xaf, 0x27, 0x8f, 0x26, 0xa7, 0x4d, 0x5b, 0x7d, 0x37, 0x49, 0x13, 0x93, 0x9b, 0xa6, 0x5f,
-	0x5f, 0x09, 0x0d, 0xe3, 0x9b, 0xe9, 0xe3, 0x46, 0x7d, 0x41, 0xd2, 0x17, 0x29, 0x81, 0x9b, 0x4c,
-	0x08, 0x0b, 0x36, 0x96, 0xe3, 0xf9, 0x32, 0x71, 0xe3, 0xb1, 0x5d, 0x3f, 0xa6, 0x09, 0xa3, 0x01,
-	0x04, 0x62, 0x7b, 0x85, 0xc4, 0x65, 0x81, 0xc4, 0xf2, 0x4a, 0x88, 0x05, 0xec, 0x91, 0xd8, 0x21,
-	0xd0, 0x5d, 0x20, 0x74, 0xa5, 0x6e, 0x58, 0x00, 0x42, 0x2d, 0xff, 0x00, 0xff, 0x01, 0xf2, 0xf7,
-	0x1d, 0x7b, 0xec, 0x89, 0xc7, 0x33, 0xd3, 0x44, 0x6c, 0xda, 0x7c, 0xf6, 0x79, 0xfc, 0xce, 0xef,
-	0x1c, 0xfb, 0x9c, 0xe3, 0x81, 0x39, 0x53, 0xab, 0x6b, 0x16, 0xf3, 0x95, 0xf0, 0x7f, 0xc5, 0xd1,
-	0x0c, 0xd7, 0xb0, 0xaa, 0xca, 0xab, 0x80, 0xb9, 0x87, 0x45, 0xc7, 0xb5, 0x7d, 0x9b, 0x8c, 0xa3,
-	0x44, 0x31, 0xfc, 0xbf, 0x88, 0x12, 0xf2, 0x78, 0xd5, 0xae, 0xda, 0x5c, 0x40, 0x09, 0xff, 0x12,
-	0xb2, 0xf2, 0x4c, 0xd5, 0xb6, 0xab, 0x26, 0x53, 0x34, 0xc7, 0x50, 0x34, 0xcb, 0xb2, 0x7d, 0xcd,
-	0x37, 0x6c, 0xcb, 0xc3, 0xbb, 0x5f, 0xd5, 0x6d, 0xaf, 0x66, 0x7b, 0xca, 0x8e, 0xe6, 0x31, 0xe1,
-	0x42, 0xa9, 0x2f, 0xed, 0x30, 0x5f, 0x5b, 0x52, 0x1c, 0xad, 0x6a, 0x58, 0x5c, 0x18, 0x65, 0x2f,
-	0x67, 0xe2, 0x72, 0x34, 0x57, 0xab, 0x45, 0xe6, 0x16, 0x32, 0x45, 0x98, 0x63, 0xeb, 0x7b, 0xaa,
-	0xa3, 0x1d, 0xd6, 0x98, 0xe5, 0x47, 0xa2, 0x33, 0x29, 0x51, 0xcf, 0x61, 0x3a, 0xff, 0x07, 0xef,
-	0x5e, 0x4a, 0x1b, 0x32, 0x35, 0xcb, 0x53, 0x1c, 0xdb, 0x34, 0x74, 0xa4, 0x40, 0xbe, 0x95, 0x0d,
-	0xc6, 0xb5, 0xeb, 0x46, 0x85, 0xb9, 0x91, 0x33, 0xd5, 0xf3, 0x6d, 0x57, 0xab, 0x32, 0x54, 0x5a,
-	0xc9, 0x54, 0x0a, 0x2c, 0xe3, 0x55, 0xc0, 0xda, 0x55, 0x54, 0xdd, 0x34, 0xc2, 0x63, 0x64, 0x12,
-	0x4d, 0xdc, 0x4c, 0x99, 0xe0, 0x91, 0xa1, 0x82, 0xe2, 0xf9, 0xda, 0x3e, 0x53, 0x99, 0xe5, 0x47,
-	0x79, 0x92, 0x17, 0xd3, 0x31, 0x06, 0x3b, 0x9e, 0xee, 0x1a, 0x4e, 0x48, 0x69, 0xea, 0x80, 0xd2,
-	0x57, 0xd2, 0xe8, 0x5c, 0xfb, 0x25, 0xd3, 0x7d, 0x2f, 0xfa, 0x03, 0x85, 0x6e, 0xa4, 0x84, 0x2a,
-	0xf6, 0x6b, 0xcb, 0x37, 0x6a, 0x4c, 0xa9, 0x2f, 0xc5, 0x7f, 0x0b, 0x41, 0x3a, 0x0e, 0x64, 0x33,
-	0xcc, 0xe7, 0x06, 0xcf, 0x4f, 0x99, 0xbd, 0x0a, 0x98, 0xe7, 0xd3, 0x4d, 0xf8, 0x20, 0x75, 0xd5,
-	0x73, 0x6c, 0xcb, 0x63, 0xe4, 0x1e, 0x0c, 0x8b, 0x3c, 0x4e, 0x49, 0x73, 0xd2, 0xfc, 0x99, 0xd2,
-	0x4c, 0x31, 0xab, 0xc2, 0x8a, 0x42, 0x6b, 0x75, 0xf0, 0x8b, 0x7f, 0x5d, 0x3a, 0x55, 0x46, 0x0d,
-	0xba, 0x09, 0x13, 0xc2, 0x24, 0x12, 0x15, 0xf9, 0x22, 0x53, 0x70, 0x5a, 0xdf, 0xd3, 0x0c, 0x6b,
-	0xed, 0x09, 0xb7, 0x3a, 0x5a, 0x8e, 0x8e, 0x64, 0x16, 0xc0, 0xdb, 0xb3, 0x5f, 0x3f, 0x73, 0xed,
-	0x1f, 0x30, 0x6b, 0xaa, 0x30, 0x27, 0xcd, 0x8f, 0x94, 0x13, 0x57, 0xe8, 0x3e, 0x4c, 0xb6, 0x9b,
-	0x44, 0xa0, 0xdf, 0x02, 0xe0, 0x34, 0x3f, 0x0d, 0x59, 0x9e, 0x92, 0xe6, 0x06, 0xe6, 0xcf, 0x94,
-	0xae, 0xa5, 0xc1, 0x26, 0x73, 0x52, 0xdc, 0x8a, 0x85, 0x11, 0x75, 0x42, 0xfd, 0xc5, 0xe0, 0x48,
-	0xe1, 0xc2, 0x00, 0x7d, 0x81, 0xce, 0x9e, 0x33, 0x7f, 0x43, 0xc4, 0xd9, 0x3d, 0x80, 0x49, 0x18,
-	0x16, 0xe5, 0xc1, 0xc1, 0x8f, 0x96, 0xf1, 0x44, 0x7f, 0x57, 0x80, 0x8b, 0x47, 0x8c, 0x21, 0xf4,
-	0x35, 0x18, 0x8d, 0x6a, 0xc9, 0x7b, 0x1f, 0xe4, 0x2d, 0x6d, 0x72, 0x05, 0xc6, 0xf4, 0xc0, 0x75,
-	0xc3, 0xf2, 0xe4, 0x3a, 0x1c, 0xc5, 0x60, 0xf9, 0x2c, 0x5e, 0x7c, 0x1a, 0x5e, 0x23, 0xcb, 0x30,
-	0x1d, 0x96, 0x83, 0x6a, 0xb2, 0x5d, 0x5f, 0xf5, 0x6d, 0xd5, 0x62, 0x07, 0xbe, 0x8a, 0x99, 0x9c,
-	0x1a, 0xe0, 0x0a, 0x13, 0xa1, 0xc0, 0x3a, 0xdb, 0xf5, 0xbf, 0x6b, 0x7f, 0x87, 0x1d, 0x44, 0x88,
-	0xc9, 0x1d, 0xb8, 0x18, 0x3e, 0x8a, 0xaa, 0xa9, 0x79, 0xbe, 0x1a, 0x38, 0x15, 0xcd, 0x67, 0x15,
-	0x75, 0xc7, 0xb4, 0xf5, 0xfd, 0xa9, 0x41, 0xae, 0x37, 0x1e, 0xde, 0x5e, 0xd7, 0x3c, 0x7f, 0x5b,
-	0xdc, 0x5c, 0x0d, 0xef, 0x91, 0x25, 0x98, 0xe0, 0x42, 0xaa, 0xbd, 0x9b, 0x76, 0x36, 0xc4, 0x95,
-	0x08, 0xbf, 0xf9, 0xc9, 0x6e, 0xc2, 0x13, 0xfd, 0x11, 0x4c, 0x73, 0xba, 0xbe, 0xc7, 0x5c, 0x63,
-	0xf7, 0xf0, 0xb8, 0xf4, 0x13, 0x19, 0x46, 0x22, 0x92, 0x78, 0x84, 0xa3, 0xe5, 0xf8, 0x4c, 0xc6,
-	0x61, 0x28, 0x19, 0x82, 0x38, 0xd0, 0xcf, 0x25, 0x90, 0xb3, 0x10, 0x60, 0xce, 0xc6, 0x61, 0xa8,
-	0xae, 0x99, 0x46, 0x85, 0x03, 0x18, 0x29, 0x8b, 0x03, 0x59, 0x80, 0x0b, 0x61, 0x68, 0xac, 0xa2,
-	0xb6, 0x12, 0x2a, 0x08, 0x3d, 0x2f, 0xae, 0xc7, 0x75, 0x4b, 0xe6, 0xe0, 0xac, 0x1e, 0xa8, 0x0e,
-	0x73, 0x31, 0x51, 0xc2, 0x39, 0xe8, 0xc1, 0x06, 0x73, 0x45, 0x9a, 0x3e, 0x04, 0xc0, 0x27, 0x5c,
-	0x35, 0x2a, 0x9c, 0xaa, 0x51, 0x9e, 0xea, 0xf0, 0xca, 0x5a, 0x05, 0x6b, 0x74, 0x0d, 0x96, 0xa2,
-	0xb2, 0xda, 0xe6, 0x6f, 0xab, 0x0d, 0xf1, 0xb2, 0xda, 0x12, 0xc5, 0xf2, 0x98, 0x87, 0x1f, 0x79,
-	0x8d, 0xf8, 0x1b, 0x87, 0x21, 0xc3, 0xaa, 0xb0, 0x03, 0x64, 0x4f, 0x1c, 0xe8, 0x9f, 0x25, 0x28,
-	0xf5, 0x63, 0x0b, 0x99, 0xf8, 0x54, 0x02, 0x1a, 0x74, 0x15, 0xc7, 0xd7, 0xc7, 0x72, 0xf6, 0xeb,
-	0xa3, 0xbb, 0x3b, 0x2c, 0xf5, 0x1e, 0x3c, 0xd1, 0x06, 0x52, 0xb2, 0x62, 0x9a, 0xbd, 0x53, 0xf2,
-	0x0c, 0xa0, 0xd5, 0xd6, 0x10, 0xec, 0xf5, 0xa2, 0xe8, 0x81, 0xc5, 0xb0, 0x07, 0x16, 0x45, 0x9b,
-	0xc5, 0x1e, 0x58, 0xdc, 0xd0, 0xaa, 0x0c, 0x75, 0xcb, 0x09, 0x4d, 0xfa, 0x69, 0x01, 0x49, 0xec,
-	0xd1, 0x7b, 0xbf, 0x24, 0x0e, 0xfc, 0x7f, 0x48, 0x24, 0xcf, 0x53, 0x7c, 0x14, 0x38, 0x1f, 0x37,
-	0xba, 0xf2, 0x21, 0xa2, 0x49, 0x11, 0xf2, 0x10, 0xae, 0xc5, 0xef, 0x3d, 0x34, 0x9e, 0x76, 0x9c,
-	0x5f, 0x94, 0x9f, 0x49, 0x70, 0xbd, 0x9b, 0x3e, 0x72, 0xf8, 0x12, 0x26, 0x9d, 0x4c, 0x09, 0x4c,
-	0xe7, 0x62, 0x87, 0xd6, 0x95, 0xa9, 0x83, 0x54, 0x75, 0xb0, 0x48, 0x6d, 0x8c, 0x6a, 0xc5, 0x34,
-	0xf3, 0xa3, 0x3a, 0xa9, 0xba, 0xfa, 0x67, 0xc4, 0x43, 0x8e, 0xc7, 0x1e, 0x78, 0x18, 0x38, 0x59,
-	0x1e, 0x4e, 0xae, 0x4c, 0x6e, 0xc3, 0x4c, 0x94, 0x66, 0xfe, 0xf6, 0x43, 0x3f, 0x5e, 0x7e, 0x75,
-	0x38, 0xf0, 0x61, 0x07, 0x2d, 0xe4, 0xe2, 0x13, 0x18, 0x63, 0xc9, 0x1b, 0x98, 0x81, 0x2b, 0xd9,
-	0x14, 0xa4, 0x6c, 0x60, 0xe4, 0x69, 0x7d, 0xba, 0x8b, 0x38, 0x57, 0x4c, 0x33, 0x13, 0xe7, 0x49,
-	0xe5, 0xfb, 0x0f, 0x12, 0x86, 0x76, 0xd4, 0x51, 0xe7, 0xd0, 0x06, 0x8e, 0x13, 0xda, 0xc9, 0xe5,
-	0x52, 0xc3, 0xb9, 0x6f, 0xdb, 0x63, 0x2e, 0x9f, 0x53, 0x12, 0x7d, 0x5b, 0xab, 0x54, 0x5c, 0xe6,
-	0x79, 0x51, 0xdf, 0xc6, 0x63, 0xb2, 0xa3, 0x17, 0xd2, 0x1d, 0x3d, 0xee, 0xce, 0x03, 0xc9, 0xee,
-	0xfc, 0x1a, 0x47, 0xb3, 0x84, 0x0b, 0xa4, 0xe5, 0x39, 0x8c, 0xe8, 0xb6, 0xe5, 0x05, 0xb5, 0xb8,
-	0xe7, 0xf4, 0x35, 0x4b, 0xc5, 0xca, 0xa1, 0xe3, 0x9a, 0x76, 0xf0, 0x78, 0x1b, 0x47, 0x28, 0x71,
-	0xa0, 0xf7, 0xe1, 0x12, 0x77, 0xbc, 0x15, 0x6e, 0x4b, 0x7a, 0xdc, 0xce, 0xd7, 0x0d, 0xcf, 0xef,
-	0x3a, 0x9d, 0xd0, 0x1a, 0xcc, 0x75, 0x56, 0x3e, 0xf1, 0x61, 0x90, 0x6e, 0xc2, 0x57, 0xb8, 0xbb,
-	0xa7, 0xbb, 0xbb, 0x4c, 0xf7, 0x8d, 0x3a, 0xdb, 0xe0, 0x7b, 0x52, 0x84, 0x53, 0x6e, 0x63, 0x6a,
-	0x34, 0x11, 0xfc, 0x24, 0x0c, 0x87, 0x93, 0x5c, 0x9c, 0x0e, 0x3c, 0xd1, 0x5f, 0x4a, 0x58, 0xff,
-	0x47, 0x6c, 0x22, 0xfc, 0x12, 0x0c, 0x8b, 0x6d, 0x0c, 0xc9, 0x97, 0xdb, 0xca, 0x31, 0xdc, 0xd7,
-	0x8a, 0xa8, 0x83, 0x92, 0x64, 0x05, 0xce, 0x39, 0xcc, 0xaa, 0x18, 0x56, 0x55, 0x45, 0xdd, 0x42,
-	0x57, 0xdd, 0x31, 0xd4, 0x10, 0x47, 0xfa, 0x5f, 0x09, 0xc7, 0xeb, 0xad, 0xca, 0x7e, 0xfb, 0xa8,
-	0xf6, 0x1c, 0x4e, 0x47, 0xf3, 0xa6, 0xc0, 0xf4, 0xb5, 0xec, 0x47, 0xa4, 0xc3, 0x78, 0x5e, 0x8e,
-	0xb4, 0xc9, 0x04, 0x0c, 0xd7, 0xb4, 0x03, 0x55, 0x0f, 0x92, 0x25, 0x11, 0x90, 0x9b, 0x30, 0x18,
-	0xb2, 0xc3, 0x0b, 0xf4, 0x4c, 0xe9, 0x62, 0xda, 0x38, 0xdf, 0x5c, 0xb7, 0x1c, 0xa6, 0x97, 0xb9,
-	0x10, 0x59, 0x83, 0xf3, 0xd1, 0x3a, 0xa6, 0xe2, 0x62, 0x35, 0xc8, 0xf5, 0xe6, 0xd2, 0x7a, 0xf1,
-	0xce, 0x56, 0x5f, 0xc2, 0xe5, 0xaa, 0x7c, 0x2e, 0xba, 0x26, 0xce, 0xf4, 0xeb, 0x70, 0x39, 0xb5,
-	0x0b, 0x7d, 0xdb, 0xb6, 0xfc, 0x3d, 0xf3, 0x70, 0x43, 0x3b, 0xb4, 0x03, 0x3f, 0x91, 0x64, 0x27,
-	0x39, 0x82, 0x25, 0x06, 0x5f, 0xba, 0x0f, 0x64, 0x2b, 0xb1, 0x6c, 0x0a, 0x45, 0x42, 0xe1, 0x6c,
-	0x72, 0x05, 0x45, 0xad, 0xd4, 0x35, 0x32, 0x0d, 0x23, 0xbc, 0xa6, 0xc3, 0xc1, 0x34, 0xf5, 0xbc,
-	0x56, 0xc2, 0xca, 0xd1, 0x6a, 0x76, 0x60, 0xf9, 0xf8, 0xc0, 0xe2, 0x89, 0xfe, 0x10, 0x68, 0x1e,
-	0xda, 0xd6, 0x58, 0xed, 0xdb, 0xbe, 0x66, 0x72, 0xaf, 0x83, 0x65, 0x71, 0x20, 0xab, 0x70, 0xba,
-	0xc2, 0x7c, 0xcd, 0x30, 0xbd, 0xa9, 0x02, 0x7f, 0x22, 0xe6, 0xb3, 0x33, 0x78, 0x34, 0x9a, 0x72,
-	0xa4, 0x48, 0x9f, 0xc0, 0xb9, 0x44, 0x87, 0x0b, 0x03, 0xcd, 0xa1, 0x26, 0x11, 0x45, 0x21, 0x15,
-	0xc5, 0x4b, 0x18, 0x7b, 0x2c, 0x1e, 0x66, 0x34, 0x92, 0x64, 0x42, 0x4a, 0x33, 0xf1, 0x28, 0xac,
-	0xbb, 0x50, 0x28, 0x42, 0x7d, 0xb5, 0x6b, 0xe3, 0xe5, 0x88, 0x51, 0x89, 0x3e, 0xc6, 0x19, 0x23,
-	0x19, 0x55, 0xa7, 0x1c, 0x77, 0x7a, 0x90, 0x69, 0x13, 0xc7, 0x86, 0x1c, 0x23, 0xb9, 0xd4, 0x3f,
-	0x6c, 0xa7, 0xbe, 0x43, 0x7f, 0x49, 0xb1, 0x12, 0xb3, 0x5e, 0xfa, 0xf5, 0x14, 0x0c, 0x71, 0xff,
-	0xe4, 0xa7, 0x12, 0x0c, 0x8b, 0xc2, 0x25, 0xf3, 0x39, 0xcf, 0x5f, 0xea, 0xa3, 0x84, 0xbc, 0xd0,
-	0x83, 0xa4, 0x80, 0x4f, 0xaf, 0xfe, 0xe4, 0xcd, 0x7f, 0x7e, 0x51, 0x98, 0x25, 0x33, 0x4a, 0xce,
-	0xc7, 0x28, 0xf2, 0x2b, 0x09, 0x46, 0x5b, 0x3b, 0xd8, 0xcd, 0x3c, 0xf3, 0x6d, 0x1f, 0x2d, 0xe4,
-	0xc5, 0xde, 0x84, 0x11, 0xce, 0x12, 0x87, 0x73, 0x93, 0x2c, 0x28, 0xb9, 0x9f, 0xa3, 0x3c, 0xa5,
-	0x81, 0xcd, 0xa1, 0x49, 0x7e, 0x23, 0x01, 0xb4, 0x5e, 0x3f, 0x64, 0xb1, 0xc7, 0xb7, 0x94, 0x40,
-	0xd7, 0xdf, 0x3b, 0x8d, 0x3e, 0xe0, 0xf0, 0xee, 0x92, 0xdb, 0xd9, 0xf0, 0xaa, 0x2c, 0xde, 0xd1,
-	0x5b, 0x00, 0x95, 0x86, 0x58, 0xa6, 0x9b, 0xe4, 0x2f, 0x12, 0x8c, 0xa5, 0xd6, 0x62, 0xa2, 0xe4,
-	0xb8, 0xcf, 0x5a, 0xe1, 0xe5, 0x8f, 0x7a, 0x57, 0x40, 0xc8, 0x65, 0x0e, 0x79, 0x9d, 0xbc, 0xc8,
-	0x86, 0x5c, 0xe7, 0x4a, 0x39, 0xa8, 0x95, 0x46, 0x44, 0x7a, 0x53, 0x69, 0xf0, 0x29, 0xa2, 0x49,
-	0x7e, 0x56, 0x00, 0xba, 0xdd, 0xc3, 0x32, 0x94, 0x4f, 0x6e, 0xcf, 0x5b, 0xa6, 0xfc, 0xcd, 0xe3,
-	0x1b, 0x42, 0x36, 0xd6, 0x39, 0x1b, 0xcf, 0xc8, 0x13, 0xe5, 0x18, 0x5f, 0x2e, 0x95, 0x06, 0x1f,
-	0xa3, 0x9b, 0xe4, 0xc7, 0x05, 0xb8, 0xd6, 0xdd, 0xf9, 0x8a, 0x69, 0xe6, 0x52, 0xd1, 0xcf, 0xc2,
-	0x9d, 0x4b, 0x45, 0x5f, 0xbb, 0x33, 0x7d, 0xc2, 0xa9, 0x78, 0x44, 0x1e, 0x1c, 0x87, 0x0a, 0xf2,
-	0x46, 0x82, 0xc9, 0xec, 0x15, 0x88, 0xdc, 0xef, 0xf2, 0x6c, 0xe5, 0x2d, 0x80, 0xf2, 0x83, 0xf7,
-	0x53, 0xc6, 0xd8, 0x1e, 0xf1, 0xd8, 0x96, 0xc9, 0x5d, 0xa5, 0xaf, 0xaf, 0xda, 0x71, 0x62, 0xff,
-	0x26, 0xc1, 0x74, 0xb6, 0x8b, 0x30, 0x99, 0xf7, 0xf3, 0x73, 0xf0, 0xfe, 0x81, 0x75, 0x5d, 0x52,
-	0xe9, 0x5d, 0x1e, 0xd8, 0x47, 0xa4, 0xd8, 0x5f, 0x60, 0xe4, 0xf7, 0x12, 0x8c, 0xa5, 0x76, 0x19,
-	0x52, 0xca, 0x27, 0x38, 0x6b, 0x4b, 0x93, 0x6f, 0xf5, 0xa5, 0x83, 0x90, 0x6f, 0x73, 0xc8, 0x45,
-	0xb2, 0xa8, 0xf4, 0xf0, 0x5b, 0x46, 0x9c, 0x81, 0xdf, 0x4a, 0x70, 0x21, 0x65, 0x2f, 0x24, 0xbe,
-	0x94, 0xcf, 0x5d, 0xdf, 0x98, 0x3b, 0x2d, 0x89, 0x74, 0x91, 0x63, 0xbe, 0x4e, 0xae, 0xf6, 0x82,
-	0x99, 0x7c, 0x2e, 0xc1, 0x68, 0xbc, 0x51, 0xe5, 0x76, 0xc7, 0xf6, 0xd5, 0x2e, 0xb7, 0x3b, 0x1e,
-	0x59, 0xd2, 0xba, 0xb5, 0x9f, 0xc0, 0x63, 0xae, 0xf8, 0xb9, 0x44, 0x69, 0xe0, 0x86, 0xd8, 0x4c,
-	0x34, 0xca, 0x3f, 0x49, 0xf0, 0x41, 0xc6, 0x0a, 0x45, 0xee, 0xe4, 0x60, 0xe8, 0xbc, 0xaf, 0xc9,
-	0x77, 0xfb, 0x55, 0xc3, 0x20, 0x1e, 0xf2, 0x20, 0x3e, 0x26, 0x77, 0xb2, 0x83, 0xf0, 0xb8, 0x6a,
-	0xeb, 0x43, 0xb0, 0x6a, 0x1a, 0x9e, 0x9f, 0x88, 0xe2, 0x8f, 0x12, 0x9c, 0x6f, 0xdb, 0xa2, 0xc8,
-	0x52, 0x0e, 0x94, 0xec, 0x2d, 0x4e, 0x2e, 0xf5, 0xa3, 0x82, 0xc8, 0x57, 0x39, 0xf2, 0x07, 0xe4,
-	0x5e, 0x87, 0xaa, 0x88, 0xd4, 0x70, 0x1d, 0x53, 0x1a, 0xd1, 0x38, 0xd9, 0x54, 0x1a, 0x62, 0x11,
-	0x6c, 0x92, 0xbf, 0x4a, 0x30, 0x91, 0x39, 0xcb, 0x93, 0x8f, 0x7b, 0x18, 0x94, 0xb2, 0xe6, 0x58,
-	0x79, 0xb9, 0x7f, 0x45, 0x0c, 0xe8, 0x1b, 0x3c, 0xa0, 0x7b, 0x64, 0xb9, 0xcb, 0xdb, 0xa4, 0x26,
-	0xb4, 0x55, 0x31, 0x62, 0x27, 0x26, 0x02, 0xf2, 0x0f, 0x09, 0xa6, 0x3b, 0xce, 0xc8, 0xb9, 0x2f,
-	0xca, 0x6e, 0xe3, 0x79, 0xee, 0x8b, 0xb2, 0xeb, 0x58, 0xde, 0xad, 0xbb, 0x25, 0xd7, 0xb2, 0x23,
-	0xe1, 0xc5, 0x69, 0x23, 0x9f, 0x49, 0x00, 0xad, 0xd5, 0xf8, 0x04, 0x67, 0xcb, 0xa3, 0xfb, 0x36,
-	0x5d, 0xe0, 0x88, 0xaf, 0x90, 0xcb, 0x1d, 0x10, 0x57, 0xf6, 0xa3, 0x29, 0x6d, 0x75, 0xe5, 0x8b,
-	0xb7, 0xb3, 0xd2, 0x97, 0x6f, 0x67, 0xa5, 0x7f, 0xbf, 0x9d, 0x95, 0x7e, 0xfe, 0x6e, 0xf6, 0xd4,
-	0x97, 0xef, 0x66, 0x4f, 0xfd, 0xfd, 0xdd, 0xec, 0xa9, 0xef, 0xdf, 0xa8, 0x1a, 0xfe, 0x5e, 0xb0,
-	0x53, 0xd4, 0xed, 0x5a, 0xda, 0xcc, 0x41, 0x6c, 0xc8, 0x3f, 0x74, 0x98, 0xb7, 0x33, 0xcc, 0x7f,
-	0xd4, 0xbc, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5f, 0xe2, 0xef, 0x1c, 0x1f, 0x00,
-	0x00,
+	// 2244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0x1c, 0x59,
+	0x11, 0x4f, 0x8f, 0x1d, 0xc7, 0xae, 0xc4, 0x49, 0xf6, 0xad, 0xe3, 0x38, 0xbd, 0x8e, 0xe3, 0x74,
+	0x12, 0xc7, 0x26, 0xce, 0xf4, 0x7a, 0x12, 0x67, 0x4d, 0xe2, 0x04, 0xec, 0x38, 0x1f, 0x0e, 0x86,
+	0xd8, 0x6d, 0xcc, 0x81, 0x03, 0xad, 0x76, 0xcf, 0xf3, 0xb8, 0xe3, 0x9e, 0x7e, 0xed, 0xfe, 0x70,
+	0x6c, 0x2c, 0xc3, 0x0a, 0xc4, 0x75, 0x85, 0xc4, 0x72, 0xe0, 0xcc, 0x4a, 0x88, 0x03, 0xdc, 0x11,
+	0xdc, 0x10, 0x68, 0x0f, 0x08, 0xad, 0xb4, 0x17, 0x0e, 0x80, 0x50, 0x82, 0xc4, 0x99, 0xff, 0x00,
+	0xf5, 0x7b, 0xd5, 0x33, 0xdd, 0xe3, 0x9e, 0x9e, 0x99, 0xd8, 0xe2, 0xb2, 0xf1, 0xeb, 0xae, 0xaa,
+	0xf7, 0xab, 0x5f, 0x55, 0xbf, 0x7a, 0x55, 0xb3, 0x30, 0x6a, 0x1b, 0x3b, 0x86, 0x43, 0x03, 0x35,
+	0xfa, 0x57, 0x75, 0x0d, 0xcb, 0xb3, 0x9c, 0x8a, 0xba, 0x1d, 0x52, 0x6f, 0xaf, 0xe8, 0x7a, 0x2c,
+	0x60, 0x64, 0x00, 0x25, 0x8a, 0xd1, 0xbf, 0x45, 0x94, 0x90, 0x07, 0x2a, 0xac, 0xc2, 0xb8, 0x80,
+	0x1a, 0xfd, 0x25, 0x64, 0xe5, 0xe1, 0x0a, 0x63, 0x15, 0x9b, 0xaa, 0x86, 0x6b, 0xa9, 0x86, 0xe3,
+	0xb0, 0xc0, 0x08, 0x2c, 0xe6, 0xf8, 0xf8, 0xf6, 0x2b, 0x26, 0xf3, 0xab, 0xcc, 0x57, 0xd7, 0x0d,
+	0x9f, 0x8a, 0x2d, 0xd4, 0x9d, 0xa9, 0x75, 0x1a, 0x18, 0x53, 0xaa, 0x6b, 0x54, 0x2c, 0x87, 0x0b,
+	0xa3, 0xec, 0xd5, 0x4c, 0x5c, 0xae, 0xe1, 0x19, 0xd5, 0xd8, 0xdc, 0x44, 0xa6, 0x08, 0x75, 0x99,
+	0xb9, 0xa9, 0xbb, 0xc6, 0x5e, 0x95, 0x3a, 0x41, 0x2c, 0x3a, 0x9c, 0x12, 0xf5, 0x5d, 0x6a, 0xf2,
+	0xff, 0xe0, 0xdb, 0x2b, 0x69, 0x43, 0xb6, 0xe1, 0xf8, 0xaa, 0xcb, 0x6c, 0xcb, 0x44, 0x0a, 0xe4,
+	0x3b, 0xd9, 0x60, 0x3c, 0xb6, 0x63, 0x95, 0xa9, 0x17, 0x6f, 0xa6, 0xfb, 0x01, 0xf3, 0x8c, 0x0a,
+	0x45, 0xa5, 0xb9, 0x4c, 0xa5, 0xd0, 0xb1, 0xb6, 0x43, 0xda, 0xa8, 0xa2, 0x9b, 0xb6, 0x15, 0x2d,
+	0x63, 0x93, 0x68, 0xe2, 0x56, 0xca, 0x04, 0xf7, 0x0c, 0x15, 0x54, 0x3f, 0x30, 0xb6, 0xa8, 0x4e,
+	0x9d, 0x20, 0x8e, 0x93, 0x3c, 0x99, 0xf6, 0x31, 0x5c, 0xf7, 0x4d, 0xcf, 0x72, 0x23, 0x4a, 0x53,
+	0x0b, 0x94, 0xbe, 0x96, 0x46, 0xe7, 0xb1, 0x57, 0xd4, 0x0c, 0xfc, 0xf8, 0x0f, 0x14, 0xba, 0x99,
+	0x12, 0x2a, 0xb3, 0xd7, 0x4e, 0x60, 0x55, 0xa9, 0xba, 0x33, 0x55, 0xfb, 0x1b, 0x05, 0xb3, 0xb3,
+	0xc8, 0xa3, 0xb6, 0x91, 0x8d, 0x2e, 0x96, 0x28, 0x5b, 0xbe, 0x69, 0xb9, 0xb6, 0xe5, 0x50, 0xdd,
+	0xa3, 0x26, 0xf3, 0xca, 0x42, 0x5a, 0x19, 0x00, 0xb2, 0x12, 0xe5, 0xc7, 0x32, 0x8f, 0xb7, 0x46,
+	0xb7, 0x43, 0xea, 0x07, 0xca, 0x0a, 0xbc, 0x9f, 0x7a, 0xea, 0xbb, 0xcc, 0xf1, 0x29, 0xb9, 0x0f,
+	0x3d, 0x22, 0x2f, 0x86, 0xa4, 0x51, 0x69, 0xfc, 0x74, 0x69, 0xb8, 0x98, 0x95, 0xb1, 0x45, 0xa1,
+	0x35, 0xdf, 0xfd, 0xf9, 0x3f, 0xaf, 0x9c, 0xd0, 0x50, 0x43, 0x59, 0x81, 0x0b, 0xc2, 0x24, 0x12,
+	0x1f, 0xef, 0x45, 0x86, 0xe0, 0x94, 0xb9, 0x69, 0x58, 0xce, 0xe2, 0x02, 0xb7, 0xda, 0xa7, 0xc5,
+	0x4b, 0x32, 0x02, 0xe0, 0x6f, 0xb2, 0xd7, 0x4f, 0x3d, 0xf6, 0x7d, 0xea, 0x0c, 0x15, 0x46, 0xa5,
+	0xf1, 0x5e, 0x2d, 0xf1, 0x44, 0xd9, 0x82, 0xc1, 0x46, 0x93, 0x08, 0xf4, 0x1b, 0x00, 0x3c, 0x6c,
+	0x4f, 0xa2, 0xa8, 0x0d, 0x49, 0xa3, 0x5d, 0xe3, 0xa7, 0x4b, 0x37, 0xd2, 0x60, 0x93, 0x31, 0x2e,
+	0xae, 0xd6, 0x84, 0x11, 0x75, 0x42, 0xfd, 0x45, 0x77, 0x6f, 0xe1, 0x7c, 0x97, 0xf2, 0x02, 0x37,
+	0x7b, 0x46, 0x83, 0x65, 0xe1, 0x67, 0x6b, 0x07, 0x06, 0xa1, 0x47, 0xa4, 0x1b, 0x07, 0xdf, 0xa7,
+	0xe1, 0x4a, 0xf9, 0x4d, 0x01, 0x2e, 0x1e, 0x32, 0x86, 0xd0, 0x17, 0xa1, 0x2f, 0xce, 0x4d, 0xff,
+	0x5d, 0x90, 0xd7, 0xb5, 0xc9, 0x35, 0xe8, 0x37, 0x43, 0xcf, 0x8b, 0xd2, 0x9d, 0xeb, 0x70, 0x14,
+	0xdd, 0xda, 0x19, 0x7c, 0xf8, 0x24, 0x7a, 0x46, 0x66, 0xe0, 0x52, 0x94, 0x5e, 0xba, 0x4d, 0x37,
+	0x02, 0x3d, 0x60, 0xba, 0x43, 0x77, 0x03, 0x1d, 0x23, 0x39, 0xd4, 0xc5, 0x15, 0x2e, 0x44, 0x02,
+	0x4b, 0x74, 0x23, 0xf8, 0x36, 0xfb, 0x16, 0xdd, 0x8d, 0x11, 0x93, 0x69, 0xb8, 0x18, 0x7d, 0xda,
+	0xba, 0x6d, 0xf8, 0x81, 0x1e, 0xba, 0x65, 0x23, 0xa0, 0x65, 0x7d, 0xdd, 0x66, 0xe6, 0xd6, 0x50,
+	0x37, 0xd7, 0x1b, 0x88, 0x5e, 0x2f, 0x19, 0x7e, 0xb0, 0x26, 0x5e, 0xce, 0x47, 0xef, 0xc8, 0x14,
+	0x5c, 0xe0, 0x42, 0x3a, 0xdb, 0x48, 0x6f, 0x76, 0x92, 0x2b, 0x11, 0xfe, 0xf2, 0xe5, 0x46, 0x62,
+	0x27, 0xe5, 0x87, 0x70, 0x89, 0xd3, 0xf5, 0x1d, 0xea, 0x59, 0x1b, 0x7b, 0x47, 0xa5, 0x9f, 0xc8,
+	0xd0, 0x1b, 0x93, 0xc4, 0x3d, 0xec, 0xd3, 0x6a, 0x6b, 0x32, 0x00, 0x27, 0x93, 0x2e, 0x88, 0x85,
+	0xf2, 0x99, 0x04, 0x72, 0x16, 0x02, 0x8c, 0xd9, 0x00, 0x9c, 0xdc, 0x31, 0x6c, 0xab, 0xcc, 0x01,
+	0xf4, 0x6a, 0x62, 0x41, 0x26, 0xe0, 0x7c, 0xe4, 0x1a, 0x2d, 0xeb, 0xf5, 0x80, 0x0a, 0x42, 0xcf,
+	0x89, 0xe7, 0xb5, 0xbc, 0x25, 0xa3, 0x70, 0xc6, 0x0c, 0x75, 0x97, 0x7a, 0x18, 0x28, 0xb1, 0x39,
+	0x98, 0xe1, 0x32, 0xf5, 0x44, 0x98, 0x2e, 0x03, 0xe0, 0x89, 0xa1, 0x5b, 0x65, 0x4e, 0x55, 0x9f,
+	0xd6, 0x87, 0x4f, 0x16, 0xcb, 0x98, 0xa3, 0x8b, 0x30, 0x15, 0xa7, 0xd5, 0x1a, 0x3f, 0xfd, 0x96,
+	0xc5, 0xe1, 0xb7, 0x2a, 0x92, 0xe5, 0x31, 0x77, 0x3f, 0xde, 0x35, 0xe6, 0x6f, 0x00, 0x4e, 0x5a,
+	0x4e, 0x99, 0xee, 0x22, 0x7b, 0x62, 0xa1, 0xfc, 0x49, 0x82, 0x52, 0x27, 0xb6, 0x90, 0x89, 0x4f,
+	0x24, 0x50, 0xc2, 0x96, 0xe2, 0x78, 0x7c, 0xcc, 0x64, 0x1f, 0x1f, 0xad, 0xb7, 0xc3, 0x54, 0x6f,
+	0x63, 0x27, 0x65, 0x1f, 0x29, 0x99, 0xb3, 0xed, 0xf6, 0x29, 0x79, 0x0a, 0x50, 0x2f, 0x93, 0x08,
+	0x76, 0xac, 0x28, 0x6a, 0x6a, 0x31, 0xaa, 0xa9, 0x45, 0x51, 0xb6, 0xb1, 0xa6, 0x16, 0x97, 0x8d,
+	0x0a, 0x45, 0x5d, 0x2d, 0xa1, 0xa9, 0x7c, 0x52, 0x80, 0x52, 0x27, 0xbb, 0x77, 0x4a, 0x62, 0xd7,
+	0xff, 0x87, 0x44, 0xf2, 0x2c, 0xc5, 0x47, 0x81, 0xf3, 0x71, 0xb3, 0x25, 0x1f, 0xc2, 0x9b, 0x14,
+	0x21, 0x0f, 0xe1, 0x46, 0xed, 0xdc, 0x43, 0xe3, 0xe9, 0x8d, 0xf3, 0x93, 0xf2, 0x53, 0x09, 0xc6,
+	0x5a, 0xe9, 0x23, 0x87, 0xaf, 0x60, 0xd0, 0xcd, 0x94, 0xc0, 0x70, 0x4e, 0x36, 0x29, 0x5d, 0x99,
+	0x3a, 0x48, 0x55, 0x13, 0x8b, 0x0a, 0x43, 0xaf, 0xe6, 0x6c, 0x3b, 0xdf, 0xab, 0xe3, 0xca, 0xab,
+	0x7f, 0xc4, 0x3c, 0xe4, 0xec, 0xd8, 0x06, 0x0f, 0x5d, 0xc7, 0xcb, 0xc3, 0xf1, 0xa5, 0xc9, 0x5d,
+	0x18, 0x8e, 0xc3, 0xcc, 0x4f, 0x3f, 0xdc, 0xc7, 0xcf, 0xcf, 0x0e, 0x17, 0x2e, 0x37, 0xd1, 0x42,
+	0x2e, 0x5e, 0x42, 0x3f, 0x4d, 0xbe, 0xc0, 0x08, 0x5c, 0xcb, 0xa6, 0x20, 0x65, 0x03, 0x3d, 0x4f,
+	0xeb, 0x2b, 0x1b, 0x88, 0x73, 0xce, 0xb6, 0x33, 0x71, 0x1e, 0x57, 0xbc, 0x7f, 0x27, 0xc1, 0xe5,
+	0x26, 0x1b, 0x35, 0x77, 0xad, 0xeb, 0x28, 0xae, 0x1d, 0x5f, 0x2c, 0x0d, 0xbc, 0xf7, 0xad, 0xf9,
+	0xd4, 0xe3, 0xf7, 0x94, 0x44, 0xdd, 0x36, 0xca, 0x65, 0x8f, 0xfa, 0x7e, 0x5c, 0xb7, 0x71, 0x99,
+	0xac, 0xe8, 0x85, 0x74, 0x45, 0xaf, 0x55, 0xe7, 0xae, 0x64, 0x75, 0x7e, 0x0d, 0x83, 0x8d, 0x5b,
+	0x20, 0x2d, 0xcf, 0xa0, 0xd7, 0x64, 0x8e, 0x1f, 0x56, 0x6b, 0x35, 0xa7, 0xa3, 0xbb, 0x54, 0x4d,
+	0x39, 0xda, 0xb8, 0x6a, 0xec, 0x3e, 0x5e, 0xc3, 0x2b, 0x94, 0x58, 0x28, 0x0f, 0xe0, 0x0a, 0xdf,
+	0x78, 0x35, 0x30, 0x02, 0xcb, 0xac, 0x95, 0xf3, 0x25, 0xcb, 0x0f, 0x5a, 0xde, 0x4e, 0x94, 0x2a,
+	0x8c, 0x36, 0x57, 0x3e, 0xf6, 0xcb, 0xa0, 0xb2, 0x02, 0x1f, 0xf0, 0xed, 0x9e, 0x6c, 0x6c, 0x50,
+	0x33, 0xb0, 0x76, 0xe8, 0x32, 0xef, 0xbb, 0x62, 0x9c, 0x72, 0x03, 0x53, 0x7d, 0x09, 0xe7, 0x07,
+	0xa1, 0x27, 0xba, 0xc9, 0xd5, 0xc2, 0x81, 0x2b, 0xe5, 0xe7, 0x12, 0x0c, 0x67, 0xdb, 0x44, 0xf8,
+	0x25, 0xe8, 0x11, 0xdd, 0x1d, 0x92, 0x2f, 0x37, 0xa4, 0x63, 0xd4, 0xff, 0x15, 0x51, 0x07, 0x25,
+	0xc9, 0x1c, 0x9c, 0x75, 0xa9, 0x53, 0xb6, 0x9c, 0x8a, 0x8e, 0xba, 0x85, 0x96, 0xba, 0xfd, 0xa8,
+	0x21, 0x96, 0xca, 0x7f, 0x25, 0xbc, 0x5e, 0xaf, 0x96, 0xb7, 0x1a, 0xaf, 0x6a, 0xcf, 0xe0, 0x54,
+	0x7c, 0xdf, 0x14, 0x98, 0x6e, 0x67, 0x7f, 0x22, 0x4d, 0xae, 0xe7, 0x5a, 0xac, 0x4d, 0x2e, 0x40,
+	0x4f, 0xd5, 0xd8, 0xd5, 0xcd, 0x30, 0x99, 0x12, 0x21, 0xb9, 0x05, 0xdd, 0x11, 0x3b, 0x3c, 0x41,
+	0x4f, 0x97, 0x2e, 0xa6, 0x8d, 0x47, 0x6f, 0x8a, 0xab, 0x2e, 0x35, 0x35, 0x2e, 0x44, 0x16, 0xe1,
+	0x5c, 0xdc, 0xde, 0xe9, 0xd8, 0x58, 0x75, 0x73, 0xbd, 0xd1, 0xb4, 0x5e, 0x2c, 0x54, 0xdc, 0x99,
+	0xc2, 0xe6, 0x4a, 0x3b, 0x1b, 0x3f, 0x13, 0x6b, 0xe5, 0x6b, 0x70, 0x35, 0xd5, 0x0b, 0x7d, 0x93,
+	0x39, 0xc1, 0xa6, 0xbd, 0xb7, 0x6c, 0xec, 0xb1, 0x30, 0x48, 0x04, 0xd9, 0x4d, 0x5e, 0xc1, 0x12,
+	0x17, 0x5f, 0x65, 0x0b, 0xc8, 0x6a, 0xa2, 0x79, 0x15, 0x8a, 0x44, 0x81, 0x33, 0xc9, 0x96, 0x16,
+	0xb5, 0x52, 0xcf, 0xc8, 0x25, 0xe8, 0xe5, 0x39, 0x1d, 0x5d, 0x4c, 0x53, 0xdf, 0x6b, 0x39, 0xca,
+	0x1c, 0xa3, 0xca, 0x42, 0x27, 0xc0, 0x0f, 0x16, 0x57, 0xca, 0x0f, 0x40, 0xc9, 0x43, 0x5b, 0xbf,
+	0x56, 0x07, 0x2c, 0x30, 0x6c, 0xbe, 0x6b, 0xb7, 0x26, 0x16, 0x64, 0x1e, 0x4e, 0x95, 0x69, 0x60,
+	0x58, 0xb6, 0x3f, 0x54, 0xe0, 0x5f, 0xc4, 0x78, 0x76, 0x04, 0x0f, 0x7b, 0xa3, 0xc5, 0x8a, 0xca,
+	0x02, 0x9c, 0x4d, 0x54, 0x38, 0x16, 0xe6, 0x52, 0x93, 0xf0, 0xa2, 0x90, 0xf2, 0xe2, 0x15, 0xf4,
+	0x3f, 0x16, 0x1f, 0x33, 0x1a, 0x49, 0x32, 0x21, 0xa5, 0x99, 0x78, 0x14, 0xe5, 0x5d, 0x24, 0x14,
+	0xa3, 0xbe, 0xde, 0xb2, 0xf0, 0x72, 0xc4, 0xa8, 0xa4, 0x3c, 0xc6, 0x3b, 0x46, 0xd2, 0xab, 0x66,
+	0x31, 0x6e, 0xf6, 0x21, 0x2b, 0x07, 0x30, 0xd6, 0xca, 0x48, 0x2e, 0xf5, 0x0f, 0x1b, 0xa9, 0x6f,
+	0x52, 0x5f, 0x52, 0xac, 0xd4, 0x59, 0xb7, 0xf0, 0xb3, 0x8c, 0x7d, 0x5c, 0x61, 0x7e, 0x1b, 0x99,
+	0x99, 0x53, 0x0e, 0xa2, 0x37, 0x76, 0xe8, 0x07, 0xb5, 0x3e, 0x2e, 0x5e, 0x2a, 0xff, 0x91, 0xe0,
+	0x74, 0x62, 0x9b, 0x9c, 0x26, 0x31, 0x61, 0xa3, 0x90, 0xb2, 0x41, 0x16, 0xa0, 0x6b, 0x9b, 0xf9,
+	0xf8, 0x25, 0x4f, 0x36, 0x3b, 0x26, 0x0c, 0xdb, 0x0a, 0xf6, 0x5e, 0x6e, 0xac, 0x52, 0x6f, 0xc7,
+	0x32, 0xa9, 0x46, 0x5d, 0xe6, 0x05, 0x78, 0xfa, 0x46, 0xea, 0xe4, 0x7b, 0xf0, 0x5e, 0x7c, 0x9e,
+	0x89, 0x81, 0xd9, 0x36, 0x8b, 0xbf, 0xf2, 0xce, 0x6d, 0x4a, 0xda, 0x39, 0x34, 0xc6, 0x4b, 0xf8,
+	0x0a, 0xf3, 0x95, 0x35, 0x18, 0x3a, 0x4c, 0x2a, 0x46, 0xf1, 0xab, 0xc2, 0x03, 0x51, 0x38, 0xae,
+	0xe6, 0x27, 0xdc, 0x0a, 0xf3, 0x13, 0xb0, 0x95, 0x35, 0xbc, 0x71, 0x2c, 0xd4, 0xe6, 0x46, 0xcf,
+	0xad, 0xa8, 0xcc, 0xec, 0x1d, 0x29, 0x62, 0xca, 0x26, 0x8c, 0x34, 0x33, 0x8b, 0x98, 0x9f, 0xc2,
+	0x29, 0x31, 0xa0, 0x8a, 0x71, 0x8f, 0x65, 0xe3, 0xae, 0x5b, 0xd0, 0xb8, 0x38, 0x82, 0x8f, 0x95,
+	0x4b, 0x1f, 0x7f, 0x00, 0x27, 0xf9, 0x56, 0xe4, 0xc7, 0x12, 0xf4, 0x88, 0x53, 0x92, 0x8c, 0xe7,
+	0x1c, 0xf6, 0xa9, 0x09, 0x98, 0x3c, 0xd1, 0x86, 0xa4, 0x40, 0xac, 0x5c, 0xff, 0xd1, 0x97, 0xff,
+	0xfe, 0x59, 0x61, 0x84, 0x0c, 0xab, 0x39, 0x93, 0x54, 0xf2, 0x0b, 0x09, 0xfa, 0xea, 0x0d, 0xff,
+	0xad, 0x3c, 0xf3, 0x0d, 0x13, 0x32, 0x79, 0xb2, 0x3d, 0x61, 0x84, 0x33, 0xc5, 0xe1, 0xdc, 0x22,
+	0x13, 0x6a, 0xee, 0x2c, 0xd5, 0x57, 0xf7, 0x31, 0x28, 0x07, 0xe4, 0x57, 0x12, 0x40, 0xbd, 0xd6,
+	0x91, 0xc9, 0x36, 0x4b, 0xa2, 0x40, 0xd7, 0x59, 0x01, 0x55, 0x66, 0x39, 0xbc, 0x7b, 0xe4, 0x6e,
+	0x36, 0xbc, 0x0a, 0xad, 0x0d, 0x84, 0xea, 0x00, 0xd5, 0x7d, 0x31, 0xb9, 0x39, 0x20, 0x7f, 0x96,
+	0xa0, 0x3f, 0x35, 0x83, 0x21, 0x6a, 0xce, 0xf6, 0x59, 0xf3, 0x22, 0xf9, 0xc3, 0xf6, 0x15, 0x10,
+	0xb2, 0xc6, 0x21, 0x2f, 0x91, 0x17, 0xd9, 0x90, 0x77, 0xb8, 0x52, 0x0e, 0x6a, 0x75, 0x3f, 0x26,
+	0xfd, 0x40, 0xdd, 0xe7, 0x57, 0xd6, 0x03, 0xf2, 0x93, 0x02, 0x28, 0x6b, 0x6d, 0x74, 0xde, 0xf9,
+	0xe4, 0xb6, 0x3d, 0xd2, 0x90, 0x9f, 0x1f, 0xdd, 0x10, 0xb2, 0xb1, 0xc4, 0xd9, 0x78, 0x4a, 0x16,
+	0xd4, 0x23, 0x8c, 0xdd, 0xd5, 0x7d, 0xde, 0xb3, 0x1d, 0x90, 0x8f, 0x0b, 0x70, 0xa3, 0xf5, 0xe6,
+	0x73, 0xb6, 0x9d, 0x4b, 0x45, 0x27, 0xd3, 0x1d, 0xf9, 0xf9, 0xd1, 0x0d, 0x21, 0x15, 0x0b, 0x9c,
+	0x8a, 0x47, 0x64, 0xf6, 0x28, 0x54, 0x90, 0x2f, 0x25, 0x18, 0xcc, 0xee, 0xb7, 0xc9, 0x83, 0x16,
+	0xdf, 0x56, 0xde, 0xb4, 0x41, 0x9e, 0x7d, 0x37, 0x65, 0xf4, 0xed, 0x11, 0xf7, 0x6d, 0x86, 0xdc,
+	0x53, 0x3b, 0xfa, 0x49, 0xa6, 0x16, 0xd8, 0xbf, 0x4a, 0x70, 0x29, 0x7b, 0x8b, 0x28, 0x98, 0x0f,
+	0xf2, 0x63, 0xf0, 0xee, 0x8e, 0xb5, 0x9c, 0x88, 0x28, 0xf7, 0xb8, 0x63, 0x1f, 0x92, 0x62, 0x67,
+	0x8e, 0x91, 0xdf, 0x4a, 0xd0, 0x9f, 0x6a, 0x9c, 0x49, 0x29, 0x9f, 0xe0, 0xac, 0x91, 0x80, 0x7c,
+	0xa7, 0x23, 0x1d, 0x84, 0x7c, 0x97, 0x43, 0x2e, 0x92, 0x49, 0xb5, 0x8d, 0x1f, 0xe2, 0x6a, 0x11,
+	0xf8, 0xb5, 0x04, 0xe7, 0x53, 0xf6, 0x22, 0xe2, 0x4b, 0xf9, 0xdc, 0x75, 0x8c, 0xb9, 0xd9, 0x44,
+	0x42, 0x99, 0xe4, 0x98, 0xc7, 0xc8, 0xf5, 0x76, 0x30, 0x93, 0xcf, 0x24, 0xe8, 0xab, 0xb5, 0xef,
+	0xb9, 0xd5, 0xb1, 0x71, 0x8e, 0x20, 0x4f, 0xb6, 0x27, 0xdc, 0x5e, 0xf9, 0x09, 0xfd, 0x68, 0x06,
+	0x1f, 0x69, 0xa8, 0xfb, 0x38, 0x8e, 0x38, 0x48, 0x14, 0xca, 0x3f, 0x4a, 0xf0, 0x7e, 0x46, 0xbf,
+	0x4e, 0xa6, 0x73, 0x30, 0x34, 0x1f, 0x0e, 0xc8, 0xf7, 0x3a, 0x55, 0x43, 0x27, 0x1e, 0x72, 0x27,
+	0x3e, 0x22, 0xd3, 0xd9, 0x4e, 0xf8, 0x5c, 0xb5, 0xfe, 0xab, 0x83, 0x6e, 0x5b, 0x7e, 0x90, 0xf0,
+	0xe2, 0x0f, 0x12, 0x9c, 0x6b, 0x68, 0xd9, 0xc9, 0x54, 0x0e, 0x94, 0xec, 0x91, 0x81, 0x5c, 0xea,
+	0x44, 0x05, 0x91, 0xcf, 0x73, 0xe4, 0xb3, 0xe4, 0x7e, 0x93, 0xac, 0x88, 0xd5, 0xb0, 0xf7, 0x57,
+	0xf7, 0xe3, 0xde, 0xe5, 0x40, 0xdd, 0x17, 0x53, 0x87, 0x03, 0xf2, 0x17, 0x09, 0x2e, 0x64, 0x36,
+	0x8e, 0xe4, 0xa3, 0x36, 0x2e, 0x4a, 0x59, 0x4d, 0x93, 0x3c, 0xd3, 0xb9, 0x22, 0x3a, 0xf4, 0x75,
+	0xee, 0xd0, 0x7d, 0x32, 0xd3, 0xe2, 0x34, 0xa9, 0x0a, 0x6d, 0x5d, 0xf4, 0x73, 0x89, 0x1b, 0x01,
+	0xf9, 0xbb, 0x04, 0x97, 0x9a, 0x36, 0x64, 0xb9, 0x07, 0x65, 0xab, 0x5e, 0x50, 0x9e, 0x7d, 0x37,
+	0xe5, 0xf6, 0xaa, 0x5b, 0x72, 0x06, 0x70, 0xc8, 0xbd, 0x5a, 0xd8, 0xc8, 0x2f, 0x1b, 0x3a, 0xb1,
+	0xdb, 0x6d, 0x50, 0x5d, 0x6f, 0x0c, 0xe5, 0x62, 0xbb, 0xe2, 0x08, 0x7a, 0x9a, 0x83, 0x56, 0xc9,
+	0xed, 0x16, 0xf1, 0xd8, 0x66, 0x7e, 0x32, 0x08, 0xbf, 0x97, 0xe0, 0xbd, 0x43, 0x3d, 0x09, 0xc9,
+	0x3b, 0xf8, 0x9a, 0x35, 0x46, 0xf2, 0xdd, 0xce, 0x94, 0x10, 0xf7, 0x03, 0x8e, 0x7b, 0x9a, 0xdc,
+	0x51, 0x5b, 0xfd, 0x7c, 0xbf, 0x29, 0x34, 0x93, 0xe8, 0x3f, 0x95, 0x00, 0xea, 0xb3, 0xae, 0x63,
+	0xbc, 0xbf, 0x1f, 0x1e, 0xa0, 0x29, 0x13, 0x1c, 0xe8, 0x35, 0x72, 0xb5, 0x49, 0x56, 0x94, 0xb7,
+	0xe2, 0x9b, 0xf0, 0xfc, 0xdc, 0xe7, 0x6f, 0x46, 0xa4, 0x2f, 0xde, 0x8c, 0x48, 0xff, 0x7a, 0x33,
+	0x22, 0xfd, 0xf4, 0xed, 0xc8, 0x89, 0x2f, 0xde, 0x8e, 0x9c, 0xf8, 0xdb, 0xdb, 0x91, 0x13, 0xdf,
+	0xbd, 0x59, 0xb1, 0x82, 0xcd, 0x70, 0xbd, 0x68, 0xb2, 0x6a, 0xda, 0xcc, 0x6e, 0xcd, 0x50, 0xb0,
+	0xe7, 0x52, 0x7f, 0xbd, 0x87, 0xff, 0x5f, 0x0a, 0x77, 0xfe, 0x37, 0x00, 0x51, 0x35, 0x3e, 0xf2,
+	0x3d, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1992,6 +2280,10 @@ type QueryClient interface {
 	ProviderMonthlyPayout(ctx context.Context, in *QueryProviderMonthlyPayoutRequest, opts ...grpc.CallOption) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(ctx context.Context, in *QuerySubscriptionMonthlyPayoutRequest, opts ...grpc.CallOption) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the aggregated QoS excellence of a provider (per chain and cluster)
+	ProviderQos(ctx context.Context, in *QueryProviderQosRequest, opts ...grpc.CallOption) (*QueryProviderQosResponse, error)
+	// Queries the discipline history (freeze/unfreeze/jail) of a provider
+	DisciplineHistory(ctx context.Context, in *QueryDisciplineHistoryRequest, opts ...grpc.CallOption) (*QueryDisciplineHistoryResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error)
@@ -2140,6 +2432,24 @@ func (c *queryClient) SubscriptionMonthlyPayout(ctx context.Context, in *QuerySu
 	return out, nil
 }
 
+func (c *queryClient) ProviderQos(ctx context.Context, in *QueryProviderQosRequest, opts ...grpc.CallOption) (*QueryProviderQosResponse, error) {
+	out := new(QueryProviderQosResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProviderQos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisciplineHistory(ctx context.Context, in *QueryDisciplineHistoryRequest, opts ...grpc.CallOption) (*QueryDisciplineHistoryResponse, error) {
+	out := new(QueryDisciplineHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/DisciplineHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error) {
 	out := new(QuerySdkPairingResponse)
 	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/SdkPairing", in, out, opts...)
@@ -2181,6 +2491,10 @@ type QueryServer interface {
 	ProviderMonthlyPayout(context.Context, *QueryProviderMonthlyPayoutRequest) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(context.Context, *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the aggregated QoS excellence of a provider (per chain and cluster)
+	ProviderQos(context.Context, *QueryProviderQosRequest) (*QueryProviderQosResponse, error)
+	// Queries the discipline history (freeze/unfreeze/jail) of a provider
+	DisciplineHistory(context.Context, *QueryDisciplineHistoryRequest) (*QueryDisciplineHistoryResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(context.Context, *QueryGetPairingRequest) (*QuerySdkPairingResponse, error)
@@ -2235,6 +2549,12 @@ func (*UnimplementedQueryServer) ProviderMonthlyPayout(ctx context.Context, req
 func (*UnimplementedQueryServer) SubscriptionMonthlyPayout(ctx context.Context, req *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscriptionMonthlyPayout not implemented")
 }
+func (*UnimplementedQueryServer) ProviderQos(ctx context.Context, req *QueryProviderQosRequest) (*QueryProviderQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderQos not implemented")
+}
+func (*UnimplementedQueryServer) DisciplineHistory(ctx context.Context, req *QueryDisciplineHistoryRequest) (*QueryDisciplineHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisciplineHistory not implemented")
+}
 func (*UnimplementedQueryServer) SdkPairing(ctx context.Context, req *QueryGetPairingRequest) (*QuerySdkPairingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SdkPairing not implemented")
 }
@@ -2513,6 +2833,42 @@ func _Query_SubscriptionMonthlyPayout_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProviderQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProviderQos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderQos(ctx, req.(*QueryProviderQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisciplineHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisciplineHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisciplineHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/DisciplineHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisciplineHistory(ctx, req.(*QueryDisciplineHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SdkPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetPairingRequest)
 	if err := dec(in); err != nil {
@@ -2595,6 +2951,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscriptionMonthlyPayout",
 			Handler:    _Query_SubscriptionMonthlyPayout_Handler,
 		},
+		{
+			MethodName: "ProviderQos",
+			Handler:    _Query_ProviderQos_Handler,
+		},
+		{
+			MethodName: "DisciplineHistory",
+			Handler:    _Query_DisciplineHistory_Handler,
+		},
 		{
 			MethodName: "SdkPairing",
 			Handler:    _Query_SdkPairing_Handler,
@@ -3931,48 +4295,262 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderQosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProviderQosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProviderQosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ShowFrozen {
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderQos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingEpochQos != nil {
+		{
+			size, err := m.PendingEpochQos.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Qos.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderQosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderQosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderQosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Qos) > 0 {
+		for iNdEx := len(m.Qos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Qos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisciplineHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisciplineHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisciplineHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisciplineHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisciplineHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisciplineHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ShowFrozen {
 		n += 2
 	}
 	return n
@@ -4488,27 +5066,118 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProviderQosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ProviderQos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Qos.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingEpochQos != nil {
+		l = m.PendingEpochQos.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *QueryProviderQosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Qos) > 0 {
+		for _, e := range m.Qos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDisciplineHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisciplineHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -8039,6 +8708,617 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProviderQosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderQosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderQosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderQos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Qos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochQos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingEpochQos == nil {
+				m.PendingEpochQos = &QualityOfServiceReport{}
+			}
+			if err := m.PendingEpochQos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderQosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderQosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderQosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Qos = append(m.Qos, ProviderQos{})
+			if err := m.Qos[len(m.Qos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisciplineHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisciplineHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisciplineHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisciplineHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisciplineHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisciplineHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DisciplineRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,4 +27,5 @@ var (
 	ErrPolicyGeolocation                    = sdkerrors.Register(ModuleName, 16, "plan's geolocation is invalid")
 	ErrInvalidDenom                         = sdkerrors.Register(ModuleName, 17, commontypes.ErrInvalidDenomMsg)
 	ErrInvalidPlanProjects                  = sdkerrors.Register(ModuleName, 18, "plan's projects field is invalid")
+	ErrPolicyInvalidScoreStrategyWeights    = sdkerrors.Register(ModuleName, 19, "policy's score strategy weights are invalid")
 )
@@ -151,6 +151,10 @@ func (policy Policy) ValidateBasicPolicy(isPlanPolicy bool) error {
 		}
 		seen[addr] = true
 	}
+	if err := policy.ScoreStrategyWeights.Validate(); err != nil {
+		return err
+	}
+
 	for _, chainPolicy := range policy.ChainPolicies {
 		for _, requirement := range chainPolicy.GetRequirements() {
 			if requirement.Collection.ApiInterface == "" {
@@ -162,6 +166,18 @@ func (policy Policy) ValidateBasicPolicy(isPlanPolicy bool) error {
 	return nil
 }
 
+// Validate checks that the score strategy weights are in range (zero means default weight)
+func (w ScoreStrategyWeights) Validate() error {
+	names := []string{"stake", "qos", "geo"}
+	for i, weight := range []uint64{w.Stake, w.Qos, w.Geo} {
+		if weight > MAX_SCORE_STRATEGY_WEIGHT {
+			return sdkerrors.Wrapf(ErrPolicyInvalidScoreStrategyWeights, "%s weight (%d) is larger than the max weight (%d)",
+				names[i], weight, MAX_SCORE_STRATEGY_WEIGHT)
+		}
+	}
+	return nil
+}
+
 func GetStrictestChainPolicyForSpec(chainID string, policies []*Policy) (chainPolicyRet ChainPolicy, allowed bool) {
 	requirements := []ChainRequirement{}
 	for _, policy := range policies {
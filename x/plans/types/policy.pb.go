@@ -66,6 +66,7 @@ type Policy struct {
 	MaxProvidersToPair    uint64                  `protobuf:"varint,5,opt,name=max_providers_to_pair,json=maxProvidersToPair,proto3" json:"max_providers_to_pair"`
 	SelectedProvidersMode SELECTED_PROVIDERS_MODE `protobuf:"varint,6,opt,name=selected_providers_mode,json=selectedProvidersMode,proto3,enum=lavanet.lava.plans.SELECTED_PROVIDERS_MODE" json:"selected_providers_mode"`
 	SelectedProviders     []string                `protobuf:"bytes,7,rep,name=selected_providers,json=selectedProviders,proto3" json:"selected_providers"`
+	ScoreStrategyWeights  ScoreStrategyWeights    `protobuf:"bytes,8,opt,name=score_strategy_weights,json=scoreStrategyWeights,proto3" json:"score_strategy_weights"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -150,6 +151,74 @@ func (m *Policy) GetSelectedProviders() []string {
 	return nil
 }
 
+func (m *Policy) GetScoreStrategyWeights() ScoreStrategyWeights {
+	if m != nil {
+		return m.ScoreStrategyWeights
+	}
+	return ScoreStrategyWeights{}
+}
+
+// the weights of the pairing score requirements (score = stake^w1 * qos^w2 * geo^w3). zero means default weight (1)
+type ScoreStrategyWeights struct {
+	Stake uint64 `protobuf:"varint,1,opt,name=stake,proto3" json:"stake"`
+	Qos   uint64 `protobuf:"varint,2,opt,name=qos,proto3" json:"qos"`
+	Geo   uint64 `protobuf:"varint,3,opt,name=geo,proto3" json:"geo"`
+}
+
+func (m *ScoreStrategyWeights) Reset()         { *m = ScoreStrategyWeights{} }
+func (m *ScoreStrategyWeights) String() string { return proto.CompactTextString(m) }
+func (*ScoreStrategyWeights) ProtoMessage()    {}
+func (*ScoreStrategyWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2388e0faa8deb9b, []int{1}
+}
+func (m *ScoreStrategyWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreStrategyWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreStrategyWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreStrategyWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreStrategyWeights.Merge(m, src)
+}
+func (m *ScoreStrategyWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreStrategyWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreStrategyWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreStrategyWeights proto.InternalMessageInfo
+
+func (m *ScoreStrategyWeights) GetStake() uint64 {
+	if m != nil {
+		return m.Stake
+	}
+	return 0
+}
+
+func (m *ScoreStrategyWeights) GetQos() uint64 {
+	if m != nil {
+		return m.Qos
+	}
+	return 0
+}
+
+func (m *ScoreStrategyWeights) GetGeo() uint64 {
+	if m != nil {
+		return m.Geo
+	}
+	return 0
+}
+
 type ChainPolicy struct {
 	ChainId      string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id"`
 	Apis         []string           `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis"`
@@ -160,7 +229,7 @@ func (m *ChainPolicy) Reset()         { *m = ChainPolicy{} }
 func (m *ChainPolicy) String() string { return proto.CompactTextString(m) }
 func (*ChainPolicy) ProtoMessage()    {}
 func (*ChainPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2388e0faa8deb9b, []int{1}
+	return fileDescriptor_c2388e0faa8deb9b, []int{2}
 }
 func (m *ChainPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +289,7 @@ func (m *ChainRequirement) Reset()         { *m = ChainRequirement{} }
 func (m *ChainRequirement) String() string { return proto.CompactTextString(m) }
 func (*ChainRequirement) ProtoMessage()    {}
 func (*ChainRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2388e0faa8deb9b, []int{2}
+	return fileDescriptor_c2388e0faa8deb9b, []int{3}
 }
 func (m *ChainRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,6 +342,7 @@ func (m *ChainRequirement) GetMixed() bool {
 func init() {
 	proto.RegisterEnum("lavanet.lava.plans.SELECTED_PROVIDERS_MODE", SELECTED_PROVIDERS_MODE_name, SELECTED_PROVIDERS_MODE_value)
 	proto.RegisterType((*Policy)(nil), "lavanet.lava.plans.Policy")
+	proto.RegisterType((*ScoreStrategyWeights)(nil), "lavanet.lava.plans.ScoreStrategyWeights")
 	proto.RegisterType((*ChainPolicy)(nil), "lavanet.lava.plans.ChainPolicy")
 	proto.RegisterType((*ChainRequirement)(nil), "lavanet.lava.plans.ChainRequirement")
 }
@@ -280,50 +350,56 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/plans/policy.proto", fileDescriptor_c2388e0faa8deb9b) }
 
 var fileDescriptor_c2388e0faa8deb9b = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcb, 0x6e, 0xda, 0x4a,
-	0x18, 0x66, 0x02, 0x24, 0x30, 0x10, 0xc4, 0x99, 0x93, 0x8b, 0x4f, 0xce, 0x91, 0xcd, 0x89, 0x7a,
-	0x41, 0xad, 0x64, 0x2b, 0xe9, 0xa6, 0xdb, 0x18, 0x5b, 0x2a, 0x12, 0x69, 0xd0, 0x90, 0xa4, 0x51,
-	0x17, 0xb1, 0x06, 0x33, 0x25, 0x23, 0xd9, 0x8c, 0x6b, 0x9b, 0x88, 0xec, 0xfa, 0x08, 0x7d, 0x8c,
-	0x3e, 0x40, 0x57, 0x5d, 0x74, 0x9d, 0x65, 0x96, 0x5d, 0x59, 0x15, 0xd9, 0xf9, 0x29, 0x2a, 0x0f,
-	0x57, 0x27, 0x64, 0xc3, 0xfc, 0x97, 0xef, 0xfb, 0xff, 0x0f, 0xf8, 0x66, 0xa0, 0xe2, 0x90, 0x6b,
-	0x32, 0xa0, 0xa1, 0x96, 0x9c, 0x9a, 0xe7, 0x90, 0x41, 0xa0, 0x79, 0xdc, 0x61, 0xf6, 0x8d, 0xea,
-	0xf9, 0x3c, 0xe4, 0x08, 0x4d, 0x01, 0x6a, 0x72, 0xaa, 0x02, 0xb0, 0xb7, 0xd5, 0xe7, 0x7d, 0x2e,
-	0xda, 0x5a, 0x12, 0x4d, 0x90, 0x7b, 0xb2, 0xcd, 0x03, 0x97, 0x07, 0x5a, 0x97, 0x04, 0x54, 0xbb,
-	0x3e, 0xe8, 0xd2, 0x90, 0x1c, 0x68, 0x36, 0x67, 0x83, 0x69, 0xff, 0x45, 0x6a, 0x55, 0xe0, 0x51,
-	0x5b, 0x23, 0x1e, 0xb3, 0x6c, 0xee, 0x38, 0xd4, 0x0e, 0x19, 0x9f, 0xe2, 0xf6, 0x7f, 0xe6, 0xe0,
-	0x7a, 0x5b, 0x48, 0x40, 0x97, 0xb0, 0x62, 0x5f, 0x11, 0x36, 0xb0, 0x84, 0x24, 0x46, 0x03, 0x09,
-	0xd4, 0xb2, 0xf5, 0xd2, 0xa1, 0xa2, 0x3e, 0x56, 0xa5, 0x36, 0x12, 0xe4, 0x84, 0xa8, 0xef, 0xdc,
-	0x46, 0x4a, 0x26, 0x8e, 0x94, 0x07, 0x74, 0xbc, 0x69, 0xcf, 0x41, 0x8c, 0x06, 0xe8, 0x1d, 0xfc,
-	0xbb, 0x4f, 0xb9, 0xc3, 0x6d, 0x92, 0xec, 0xb7, 0x3c, 0x9f, 0x7f, 0x62, 0x0e, 0x95, 0xd6, 0x6a,
-	0xa0, 0x9e, 0xd7, 0x77, 0xe3, 0x48, 0x59, 0xd5, 0xc6, 0x68, 0xa9, 0xd8, 0x9e, 0xd4, 0xd0, 0x5b,
-	0x58, 0x09, 0x79, 0x48, 0x1c, 0xcb, 0x1e, 0x5a, 0x0e, 0x73, 0x59, 0x28, 0x65, 0x6b, 0xa0, 0x9e,
-	0xd3, 0x51, 0x22, 0x22, 0xdd, 0xc1, 0x65, 0x91, 0x37, 0x86, 0xad, 0x24, 0x4b, 0x98, 0xd4, 0xe3,
-	0xf6, 0xd5, 0x82, 0x99, 0x5b, 0x30, 0xd3, 0x1d, 0x5c, 0x16, 0xf9, 0x8c, 0xd9, 0x82, 0xdb, 0x2e,
-	0x19, 0x25, 0xb2, 0xae, 0x59, 0x8f, 0xfa, 0x81, 0x15, 0x72, 0xcb, 0x23, 0xcc, 0x97, 0xf2, 0x62,
-	0xc0, 0x3f, 0x71, 0xa4, 0xac, 0x06, 0x60, 0xe4, 0x92, 0x51, 0x7b, 0x56, 0x3d, 0xe5, 0x6d, 0xc2,
-	0x7c, 0xf4, 0x05, 0xc0, 0xdd, 0x80, 0x26, 0x7f, 0x05, 0xed, 0x2d, 0x51, 0x5c, 0xde, 0xa3, 0xd2,
-	0x7a, 0x0d, 0xd4, 0x2b, 0x87, 0xaf, 0x57, 0xfd, 0xea, 0x1d, 0xb3, 0x65, 0x36, 0x4e, 0x4d, 0xc3,
-	0x6a, 0xe3, 0x93, 0xf3, 0xa6, 0x61, 0xe2, 0x8e, 0x75, 0x7c, 0x62, 0x98, 0xfa, 0xbf, 0x71, 0xa4,
-	0x3c, 0x35, 0x0f, 0x6f, 0xcf, 0x1a, 0x73, 0x11, 0xc7, 0xbc, 0x47, 0x91, 0x09, 0xd1, 0x63, 0x86,
-	0xb4, 0x51, 0xcb, 0xd6, 0x8b, 0xfa, 0x4e, 0x1c, 0x29, 0x2b, 0xba, 0xf8, 0xaf, 0x47, 0xa3, 0xf6,
-	0xbf, 0x03, 0x58, 0x5a, 0x32, 0x03, 0x7a, 0x09, 0x0b, 0x13, 0x1b, 0xb0, 0x9e, 0x04, 0x6a, 0xa0,
-	0x5e, 0xd4, 0xcb, 0x71, 0xa4, 0xcc, 0x6b, 0x78, 0x43, 0x44, 0xcd, 0x1e, 0xfa, 0x0f, 0xe6, 0x88,
-	0xc7, 0x02, 0x69, 0x4d, 0x6c, 0x2c, 0xc4, 0x91, 0x22, 0x72, 0x2c, 0x3e, 0xd1, 0x25, 0x2c, 0xfb,
-	0xf4, 0xf3, 0x90, 0xf9, 0xd4, 0xa5, 0x83, 0x30, 0x90, 0xb2, 0xc2, 0x8a, 0xcf, 0x9e, 0xb4, 0x22,
-	0x5e, 0x80, 0xf5, 0xad, 0xa9, 0x1f, 0x53, 0x13, 0x70, 0x2a, 0xdb, 0xff, 0x01, 0x60, 0xf5, 0x21,
-	0x11, 0x9d, 0x41, 0xb8, 0xb8, 0x20, 0x42, 0x7d, 0xe9, 0xf0, 0xff, 0xf4, 0xca, 0xe4, 0x26, 0xa9,
-	0x8d, 0x39, 0xc8, 0x20, 0x21, 0xd1, 0xd1, 0x74, 0xdf, 0x12, 0x19, 0x2f, 0xc5, 0x48, 0x85, 0x90,
-	0x8e, 0x42, 0x3a, 0x08, 0x18, 0x1f, 0xcc, 0xbe, 0x6f, 0x25, 0xc1, 0x2f, 0xaa, 0x78, 0x29, 0x46,
-	0x0a, 0xcc, 0xbb, 0x6c, 0x44, 0x7b, 0xc2, 0xd5, 0x05, 0xbd, 0x18, 0x47, 0xca, 0xa4, 0x80, 0x27,
-	0xc7, 0xab, 0xf7, 0x70, 0xf7, 0x09, 0x27, 0xa0, 0x12, 0xdc, 0x38, 0x6a, 0xb5, 0x4e, 0x3e, 0x98,
-	0x46, 0x35, 0x83, 0x8a, 0x30, 0x7f, 0xdc, 0xbc, 0x30, 0x8d, 0x2a, 0x40, 0x9b, 0xb0, 0x68, 0x5e,
-	0x34, 0x5a, 0x67, 0x9d, 0xe6, 0xb9, 0x59, 0x5d, 0x43, 0x65, 0x58, 0x30, 0x9a, 0x9d, 0x23, 0xbd,
-	0x65, 0x1a, 0xd5, 0xac, 0xde, 0xf8, 0x36, 0x96, 0xc1, 0xed, 0x58, 0x06, 0x77, 0x63, 0x19, 0xfc,
-	0x1e, 0xcb, 0xe0, 0xeb, 0xbd, 0x9c, 0xb9, 0xbb, 0x97, 0x33, 0xbf, 0xee, 0xe5, 0xcc, 0xc7, 0xe7,
-	0x7d, 0x16, 0x5e, 0x0d, 0xbb, 0xaa, 0xcd, 0x5d, 0x2d, 0xf5, 0xaa, 0x8c, 0xa6, 0x4f, 0x58, 0x78,
-	0xe3, 0xd1, 0xa0, 0xbb, 0x2e, 0x1e, 0x94, 0x37, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x08, 0x9a,
-	0x8f, 0xe7, 0xe5, 0x04, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
+	0x10, 0xb6, 0xd6, 0x71, 0x62, 0xd3, 0xde, 0xc0, 0x65, 0xb3, 0x89, 0x76, 0x5b, 0x48, 0x6e, 0xd0,
+	0x1f, 0xa3, 0x05, 0x24, 0x6c, 0x7a, 0xe9, 0x75, 0x65, 0x09, 0xa8, 0x01, 0xa7, 0x31, 0xe8, 0xfd,
+	0x43, 0x0f, 0x2b, 0x30, 0x32, 0x2b, 0x13, 0x95, 0x4c, 0xad, 0x48, 0xa7, 0xce, 0xa5, 0xe8, 0x23,
+	0xf4, 0x31, 0xfa, 0x00, 0x3d, 0xf5, 0xd0, 0xf3, 0x1e, 0xf7, 0xd8, 0x93, 0x50, 0x38, 0x37, 0x3d,
+	0xc5, 0x82, 0x94, 0xfc, 0xb7, 0xb1, 0x2f, 0x24, 0x67, 0xbe, 0xef, 0x9b, 0x19, 0x91, 0xa3, 0x01,
+	0x66, 0x84, 0x6f, 0xf0, 0x94, 0x08, 0x5b, 0xee, 0x76, 0x12, 0xe1, 0x29, 0xb7, 0x13, 0x16, 0xd1,
+	0xe0, 0xd6, 0x4a, 0x52, 0x26, 0x18, 0x84, 0x25, 0xc1, 0x92, 0xbb, 0xa5, 0x08, 0x4f, 0x4e, 0x42,
+	0x16, 0x32, 0x05, 0xdb, 0xf2, 0x54, 0x30, 0x9f, 0x18, 0x01, 0xe3, 0x31, 0xe3, 0xf6, 0x35, 0xe6,
+	0xc4, 0xbe, 0x79, 0x7a, 0x4d, 0x04, 0x7e, 0x6a, 0x07, 0x8c, 0x4e, 0x4b, 0xfc, 0xeb, 0xad, 0x54,
+	0x3c, 0x21, 0x81, 0x8d, 0x13, 0xea, 0x07, 0x2c, 0x8a, 0x48, 0x20, 0x28, 0x2b, 0x79, 0xe7, 0xff,
+	0xd6, 0xc0, 0xe1, 0x50, 0x95, 0x00, 0xdf, 0x80, 0xe3, 0x60, 0x82, 0xe9, 0xd4, 0x57, 0x25, 0x51,
+	0xc2, 0x75, 0xad, 0x53, 0xed, 0x36, 0x2f, 0x4c, 0xeb, 0x7e, 0x55, 0x56, 0x4f, 0x32, 0x0b, 0xa1,
+	0x73, 0xfa, 0x2e, 0x33, 0x2b, 0x79, 0x66, 0x7e, 0x24, 0x47, 0x0f, 0x83, 0x15, 0x89, 0x12, 0x0e,
+	0x7f, 0x04, 0x9f, 0x86, 0x84, 0x45, 0x2c, 0xc0, 0x32, 0xbf, 0x9f, 0xa4, 0xec, 0x17, 0x1a, 0x11,
+	0xfd, 0x41, 0x47, 0xeb, 0xd6, 0x9c, 0xb3, 0x3c, 0x33, 0x77, 0xc1, 0x08, 0x6e, 0x38, 0x87, 0x85,
+	0x0f, 0xfe, 0x00, 0x8e, 0x05, 0x13, 0x38, 0xf2, 0x83, 0x99, 0x1f, 0xd1, 0x98, 0x0a, 0xbd, 0xda,
+	0xd1, 0xba, 0x07, 0x0e, 0x94, 0x45, 0x6c, 0x23, 0xa8, 0xa5, 0xec, 0xde, 0x6c, 0x20, 0x2d, 0xa9,
+	0x24, 0x09, 0x0b, 0x26, 0x6b, 0xe5, 0xc1, 0x5a, 0xb9, 0x8d, 0xa0, 0x96, 0xb2, 0x97, 0xca, 0x01,
+	0x78, 0x14, 0xe3, 0xb9, 0x2c, 0xeb, 0x86, 0x8e, 0x49, 0xca, 0x7d, 0xc1, 0xfc, 0x04, 0xd3, 0x54,
+	0xaf, 0xa9, 0x00, 0x8f, 0xf3, 0xcc, 0xdc, 0x4d, 0x40, 0x30, 0xc6, 0xf3, 0xe1, 0xd2, 0xfb, 0x9c,
+	0x0d, 0x31, 0x4d, 0xe1, 0x1f, 0x1a, 0x38, 0xe3, 0x44, 0x3e, 0x05, 0x19, 0x6f, 0x48, 0x62, 0x36,
+	0x26, 0xfa, 0x61, 0x47, 0xeb, 0x1e, 0x5f, 0x7c, 0xb7, 0xeb, 0xd6, 0x47, 0xde, 0xc0, 0xeb, 0x3d,
+	0xf7, 0x5c, 0x7f, 0x88, 0xae, 0x5e, 0xf6, 0x5d, 0x0f, 0x8d, 0xfc, 0xcb, 0x2b, 0xd7, 0x73, 0x3e,
+	0xcb, 0x33, 0x73, 0x5f, 0x3c, 0xf4, 0x68, 0x09, 0xac, 0x8a, 0xb8, 0x64, 0x63, 0x02, 0x3d, 0x00,
+	0xef, 0x2b, 0xf4, 0xa3, 0x4e, 0xb5, 0xdb, 0x70, 0x4e, 0xf3, 0xcc, 0xdc, 0x81, 0xa2, 0x4f, 0xee,
+	0x85, 0x82, 0xbf, 0x83, 0x53, 0x1e, 0xb0, 0x94, 0xf8, 0x5c, 0xa4, 0x58, 0x90, 0xf0, 0xd6, 0xff,
+	0x8d, 0xd0, 0x70, 0x22, 0xb8, 0x5e, 0xef, 0x68, 0xdd, 0xe6, 0x45, 0x77, 0xe7, 0x77, 0x48, 0xc5,
+	0xa8, 0x14, 0xbc, 0x2a, 0xf8, 0x8e, 0x51, 0xb6, 0xd1, 0x9e, 0x78, 0xe8, 0x84, 0xef, 0x50, 0x9d,
+	0xc7, 0xe0, 0x64, 0x57, 0x34, 0x68, 0x82, 0x1a, 0x17, 0xf8, 0x57, 0xa2, 0x6b, 0xea, 0x7d, 0x1a,
+	0x79, 0x66, 0x16, 0x0e, 0x54, 0x6c, 0xf0, 0x31, 0xa8, 0xbe, 0x65, 0x5c, 0xb5, 0xdf, 0x81, 0x73,
+	0x94, 0x67, 0xa6, 0x34, 0x91, 0x5c, 0x24, 0x14, 0x12, 0xa6, 0x57, 0xd7, 0x50, 0x48, 0x18, 0x92,
+	0xcb, 0xf9, 0xdf, 0x1a, 0x68, 0x6e, 0xf4, 0x3e, 0xfc, 0x06, 0xd4, 0x8b, 0xae, 0xa7, 0x63, 0x95,
+	0xa9, 0xe1, 0xb4, 0xf2, 0xcc, 0x5c, 0xf9, 0xd0, 0x91, 0x3a, 0xf5, 0xc7, 0xf0, 0x73, 0x70, 0x80,
+	0x13, 0x2a, 0xf3, 0xc9, 0x0b, 0xae, 0xe7, 0x99, 0xa9, 0x6c, 0xa4, 0x56, 0xf8, 0x06, 0xb4, 0x52,
+	0xf2, 0x76, 0x46, 0x53, 0x12, 0x93, 0xa9, 0xe0, 0x7a, 0x55, 0xfd, 0x79, 0x5f, 0xee, 0xfd, 0xf3,
+	0xd0, 0x9a, 0xec, 0x9c, 0x94, 0xf7, 0xb6, 0x15, 0x01, 0x6d, 0x59, 0xe7, 0xff, 0x68, 0xa0, 0xfd,
+	0xb1, 0x10, 0xbe, 0x00, 0x60, 0x3d, 0x0f, 0x54, 0xf5, 0xcd, 0x8b, 0x2f, 0xb6, 0x53, 0xca, 0xc1,
+	0x61, 0xf5, 0x56, 0x24, 0x17, 0x0b, 0xec, 0xc0, 0x32, 0xdf, 0x86, 0x18, 0x6d, 0x9c, 0xa1, 0x05,
+	0x00, 0x99, 0x0b, 0x32, 0xe5, 0x94, 0x4d, 0x97, 0xdf, 0x7b, 0x2c, 0xf9, 0x6b, 0x2f, 0xda, 0x38,
+	0xcb, 0x97, 0x8a, 0xe9, 0x9c, 0x8c, 0xd5, 0x7d, 0xd7, 0x8b, 0x97, 0x52, 0x0e, 0x54, 0x6c, 0xdf,
+	0xfe, 0x04, 0xce, 0xf6, 0x34, 0x3e, 0x6c, 0x82, 0xa3, 0x67, 0x83, 0xc1, 0xd5, 0x2b, 0xcf, 0x6d,
+	0x57, 0x60, 0x03, 0xd4, 0x2e, 0xfb, 0xaf, 0x3d, 0xb7, 0xad, 0xc1, 0x87, 0xa0, 0xe1, 0xbd, 0xee,
+	0x0d, 0x5e, 0x8c, 0xfa, 0x2f, 0xbd, 0xf6, 0x03, 0xd8, 0x02, 0x75, 0xb7, 0x3f, 0x7a, 0xe6, 0x0c,
+	0x3c, 0xb7, 0x5d, 0x75, 0x7a, 0x7f, 0x2d, 0x0c, 0xed, 0xdd, 0xc2, 0xd0, 0xde, 0x2f, 0x0c, 0xed,
+	0xff, 0x85, 0xa1, 0xfd, 0x79, 0x67, 0x54, 0xde, 0xdf, 0x19, 0x95, 0xff, 0xee, 0x8c, 0xca, 0xcf,
+	0x5f, 0x85, 0x54, 0x4c, 0x66, 0xd7, 0x56, 0xc0, 0x62, 0x7b, 0x6b, 0x88, 0xce, 0xcb, 0x89, 0x2d,
+	0x6e, 0x13, 0xc2, 0xaf, 0x0f, 0xd5, 0xfc, 0xfc, 0xfe, 0xc3, 0x00, 0x52, 0x14, 0xf9, 0x0f, 0xd4,
+	0x05, 0x00, 0x00,
 }
 
 func (this *Policy) Equal(that interface{}) bool {
@@ -376,6 +452,39 @@ func (this *Policy) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ScoreStrategyWeights.Equal(&that1.ScoreStrategyWeights) {
+		return false
+	}
+	return true
+}
+func (this *ScoreStrategyWeights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScoreStrategyWeights)
+	if !ok {
+		that2, ok := that.(ScoreStrategyWeights)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Stake != that1.Stake {
+		return false
+	}
+	if this.Qos != that1.Qos {
+		return false
+	}
+	if this.Geo != that1.Geo {
+		return false
+	}
 	return true
 }
 func (this *ChainPolicy) Equal(that interface{}) bool {
@@ -473,6 +582,16 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ScoreStrategyWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.SelectedProviders) > 0 {
 		for iNdEx := len(m.SelectedProviders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SelectedProviders[iNdEx])
@@ -524,6 +643,44 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScoreStrategyWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreStrategyWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreStrategyWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Geo != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Geo))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Qos != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Qos))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stake != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Stake))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -673,6 +830,26 @@ func (m *Policy) Size() (n int) {
 			n += 1 + l + sovPolicy(uint64(l))
 		}
 	}
+	l = m.ScoreStrategyWeights.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *ScoreStrategyWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stake != 0 {
+		n += 1 + sovPolicy(uint64(m.Stake))
+	}
+	if m.Qos != 0 {
+		n += 1 + sovPolicy(uint64(m.Qos))
+	}
+	if m.Geo != 0 {
+		n += 1 + sovPolicy(uint64(m.Geo))
+	}
 	return n
 }
 
@@ -917,6 +1094,146 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			}
 			m.SelectedProviders = append(m.SelectedProviders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreStrategyWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreStrategyWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScoreStrategyWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoreStrategyWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoreStrategyWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			m.Stake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qos", wireType)
+			}
+			m.Qos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Qos |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geo", wireType)
+			}
+			m.Geo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPolicy(dAtA[iNdEx:])
@@ -242,3 +242,23 @@ Policy:
 	require.NoError(t, err)
 	require.True(t, policy.Equal(expectedPolicy))
 }
+
+func TestDecodeScoreStrategyWeights(t *testing.T) {
+	input := `
+Policy:
+  geolocation_profile: 1
+  total_cu_limit: 1000
+  epoch_cu_limit: 100
+  max_providers_to_pair: 3
+  score_strategy_weights:
+    qos: 3
+    geo: 1
+`
+	policy, err := ParsePolicyFromYamlString(input)
+	require.NoError(t, err)
+	require.Equal(t, ScoreStrategyWeights{Stake: 0, Qos: 3, Geo: 1}, policy.ScoreStrategyWeights)
+	require.NoError(t, policy.ValidateBasicPolicy(false))
+
+	policy.ScoreStrategyWeights.Stake = MAX_SCORE_STRATEGY_WEIGHT + 1
+	require.Error(t, policy.ValidateBasicPolicy(false))
+}
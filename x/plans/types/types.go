@@ -6,6 +6,12 @@ const (
 	MAX_LEN_PLAN_TYPE        = 20
 )
 
+const (
+	// pairing score components are raised to the power of their weight, so the weight
+	// is kept small to prevent the pairing score from overflowing
+	MAX_SCORE_STRATEGY_WEIGHT uint64 = 3
+)
+
 const (
 	PlanAddEventName = "add_new_plan_to_storage"
 	PlanDelEventName = "del_plan_from_storage"
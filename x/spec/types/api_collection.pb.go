@@ -95,7 +95,8 @@ const (
 	PARSER_FUNC_PARSE_DICTIONARY            PARSER_FUNC = 3
 	PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED PARSER_FUNC = 4
 	// reserved
-	PARSER_FUNC_DEFAULT PARSER_FUNC = 6
+	PARSER_FUNC_DEFAULT         PARSER_FUNC = 6
+	PARSER_FUNC_PARSE_JSON_PATH PARSER_FUNC = 7
 )
 
 var PARSER_FUNC_name = map[int32]string{
@@ -105,6 +106,7 @@ var PARSER_FUNC_name = map[int32]string{
 	3: "PARSE_DICTIONARY",
 	4: "PARSE_DICTIONARY_OR_ORDERED",
 	6: "DEFAULT",
+	7: "PARSE_JSON_PATH",
 }
 
 var PARSER_FUNC_value = map[string]int32{
@@ -114,6 +116,7 @@ var PARSER_FUNC_value = map[string]int32{
 	"PARSE_DICTIONARY":            3,
 	"PARSE_DICTIONARY_OR_ORDERED": 4,
 	"DEFAULT":                     6,
+	"PARSE_JSON_PATH":             7,
 }
 
 func (x PARSER_FUNC) String() string {
@@ -641,13 +644,14 @@ func (m *Header) GetFunctionTag() FUNCTION_TAG {
 }
 
 type Api struct {
-	Enabled           bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Name              string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ComputeUnits      uint64       `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
-	ExtraComputeUnits uint64       `protobuf:"varint,4,opt,name=extra_compute_units,json=extraComputeUnits,proto3" json:"extra_compute_units,omitempty"`
-	Category          SpecCategory `protobuf:"bytes,6,opt,name=category,proto3" json:"category"`
-	BlockParsing      BlockParser  `protobuf:"bytes,7,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
-	TimeoutMs         uint64       `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Enabled              bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ComputeUnits         uint64       `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
+	ExtraComputeUnits    uint64       `protobuf:"varint,4,opt,name=extra_compute_units,json=extraComputeUnits,proto3" json:"extra_compute_units,omitempty"`
+	Category             SpecCategory `protobuf:"bytes,6,opt,name=category,proto3" json:"category"`
+	BlockParsing         BlockParser  `protobuf:"bytes,7,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
+	TimeoutMs            uint64       `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	EarliestBlockParsing *BlockParser `protobuf:"bytes,9,opt,name=earliest_block_parsing,json=earliestBlockParsing,proto3" json:"earliest_block_parsing,omitempty"`
 }
 
 func (m *Api) Reset()         { *m = Api{} }
@@ -732,6 +736,13 @@ func (m *Api) GetTimeoutMs() uint64 {
 	return 0
 }
 
+func (m *Api) GetEarliestBlockParsing() *BlockParser {
+	if m != nil {
+		return m.EarliestBlockParsing
+	}
+	return nil
+}
+
 type ParseDirective struct {
 	FunctionTag      FUNCTION_TAG `protobuf:"varint,1,opt,name=function_tag,json=functionTag,proto3,enum=lavanet.lava.spec.FUNCTION_TAG" json:"function_tag,omitempty"`
 	FunctionTemplate string       `protobuf:"bytes,2,opt,name=function_template,json=functionTemplate,proto3" json:"function_template,omitempty"`
@@ -968,95 +979,97 @@ func init() {
 }
 
 var fileDescriptor_c9f7567a181f534f = []byte{
-	// 1400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x13, 0x35, 0x25, 0xda, 0x96, 0x46, 0x7f, 0xcc, 0x6c, 0xfc, 0xcb, 0x4f, 0x49, 0x1d, 0xc9, 0x65,
-	0xd2, 0xd6, 0x70, 0x50, 0x1b, 0x75, 0x5a, 0xa0, 0x08, 0x0a, 0x14, 0x94, 0x44, 0x27, 0x6a, 0x6c,
-	0xc9, 0x58, 0xcb, 0x6e, 0xdd, 0x0b, 0xb1, 0x26, 0xd7, 0xf2, 0x22, 0x14, 0xc9, 0x92, 0x4b, 0xc3,
-	0xee, 0xb5, 0xb7, 0x9e, 0xfa, 0x29, 0x8a, 0x02, 0x05, 0x0a, 0xf4, 0xd0, 0xef, 0x90, 0x63, 0x8e,
-	0x3d, 0x19, 0x85, 0x73, 0x28, 0x9a, 0x63, 0xee, 0x05, 0x8a, 0x5d, 0x52, 0xb2, 0xe8, 0x28, 0x09,
-	0x72, 0x92, 0xe6, 0xcd, 0xdb, 0xb7, 0xb3, 0x33, 0xb3, 0xb3, 0x84, 0x0f, 0x5d, 0x72, 0x42, 0x3c,
-	0xca, 0xd7, 0xc5, 0xef, 0x7a, 0x14, 0x50, 0x7b, 0x9d, 0x04, 0xcc, 0xb2, 0x7d, 0xd7, 0xa5, 0x36,
-	0x67, 0xbe, 0xb7, 0x16, 0x84, 0x3e, 0xf7, 0xd1, 0xb5, 0x94, 0xb7, 0x26, 0x7e, 0xd7, 0x04, 0xef,
-	0xd6, 0xe2, 0xc0, 0x1f, 0xf8, 0xd2, 0xbb, 0x2e, 0xfe, 0x25, 0x44, 0xfd, 0xdf, 0x3c, 0x54, 0x8c,
-	0x80, 0xb5, 0xc6, 0x02, 0xa8, 0x06, 0xf3, 0xd4, 0x23, 0x87, 0x2e, 0x75, 0x6a, 0xca, 0xb2, 0xb2,
-	0x52, 0xc0, 0x23, 0x13, 0xed, 0xc0, 0xc2, 0xe5, 0x46, 0x96, 0x43, 0x38, 0xa9, 0xe5, 0x96, 0x95,
-	0x95, 0xd2, 0xc6, 0xfb, 0x6b, 0xaf, 0x6c, 0xb7, 0x76, 0xa9, 0xd8, 0x26, 0x9c, 0x34, 0xd5, 0xa7,
-	0xe7, 0x8d, 0x19, 0x5c, 0xb5, 0x33, 0x28, 0x5a, 0x05, 0x95, 0x04, 0x2c, 0xaa, 0xe5, 0x97, 0xf3,
-	0x2b, 0xa5, 0x8d, 0x1b, 0x53, 0x64, 0x8c, 0x80, 0x61, 0xc9, 0x41, 0xf7, 0x61, 0xfe, 0x98, 0x12,
-	0x87, 0x86, 0x51, 0x4d, 0x95, 0xf4, 0x9b, 0x53, 0xe8, 0x8f, 0x24, 0x03, 0x8f, 0x98, 0x68, 0x0b,
-	0x34, 0xe6, 0x1d, 0xd3, 0x90, 0x71, 0xe2, 0xd9, 0xd4, 0x92, 0x9b, 0xcd, 0xca, 0xd5, 0x6f, 0x8f,
-	0x19, 0x2f, 0x4c, 0x2c, 0x35, 0x44, 0x08, 0x5b, 0xa0, 0x05, 0x24, 0x8c, 0xa8, 0xe5, 0xb0, 0x50,
-	0xf0, 0x4e, 0x68, 0x54, 0x9b, 0x7b, 0xad, 0xda, 0x8e, 0xa0, 0xb6, 0x47, 0x4c, 0xbc, 0x10, 0x64,
-	0xec, 0x08, 0x7d, 0x01, 0x40, 0x4f, 0x39, 0xf5, 0x22, 0xe6, 0x7b, 0x51, 0x6d, 0x5e, 0xea, 0x2c,
-	0x4d, 0xd1, 0x31, 0x47, 0x24, 0x3c, 0xc1, 0x47, 0x26, 0x54, 0x4e, 0x68, 0xc8, 0x8e, 0x98, 0x4d,
-	0xb8, 0x14, 0x28, 0x48, 0x81, 0xc6, 0x14, 0x81, 0xfd, 0x09, 0x1e, 0xce, 0xae, 0xd2, 0xbf, 0x83,
-	0xe2, 0x58, 0x1f, 0x21, 0x50, 0x3d, 0x32, 0xa4, 0xb2, 0xee, 0x45, 0x2c, 0xff, 0xa3, 0x3b, 0x50,
-	0xb1, 0x63, 0x6b, 0x18, 0xbb, 0x9c, 0x05, 0x2e, 0xa3, 0xa1, 0x2c, 0x79, 0x0e, 0x97, 0xed, 0x78,
-	0x7b, 0x8c, 0xa1, 0x7b, 0xa0, 0x86, 0xb1, 0x4b, 0x6b, 0x79, 0xd9, 0x0e, 0xff, 0x9f, 0x12, 0x03,
-	0x8e, 0x5d, 0x8a, 0x25, 0x49, 0x5f, 0x02, 0x55, 0x58, 0x68, 0x11, 0x66, 0x0f, 0x5d, 0xdf, 0x7e,
-	0x22, 0xb7, 0x53, 0x71, 0x62, 0xe8, 0x3f, 0xe7, 0xa0, 0x3c, 0x19, 0xf0, 0xd4, 0xa0, 0xbe, 0x82,
-	0x85, 0x2b, 0x85, 0x78, 0x43, 0x27, 0x5e, 0xa9, 0x43, 0x35, 0x5b, 0x07, 0xf4, 0x19, 0xcc, 0x9d,
-	0x10, 0x37, 0xa6, 0xa3, 0x2e, 0xbc, 0xfd, 0x3a, 0x89, 0x7d, 0xc1, 0xc2, 0x29, 0x19, 0xed, 0x40,
-	0x21, 0xa2, 0x22, 0x97, 0xfc, 0xac, 0xa6, 0x2e, 0x2b, 0x2b, 0xd5, 0x8d, 0x4f, 0xdf, 0x92, 0xfa,
-	0x8c, 0xb1, 0x9b, 0xae, 0xc5, 0x63, 0x15, 0xfd, 0x63, 0x58, 0x9c, 0xc6, 0x40, 0x05, 0x50, 0x37,
-	0x09, 0x73, 0xb5, 0x19, 0x54, 0x82, 0xf9, 0xaf, 0x49, 0xe8, 0x31, 0x6f, 0xa0, 0x29, 0xfa, 0xf7,
-	0x00, 0x97, 0x61, 0xa1, 0x25, 0x28, 0x8e, 0x9b, 0x23, 0x4d, 0xd5, 0x25, 0x80, 0x3e, 0x80, 0x2a,
-	0x3d, 0x0d, 0xa8, 0xcd, 0xa9, 0x63, 0xc9, 0xf8, 0x65, 0xba, 0x8a, 0xb8, 0x32, 0x42, 0x13, 0x91,
-	0x8f, 0x60, 0xc1, 0x25, 0x9c, 0x46, 0xdc, 0x72, 0x58, 0x24, 0xdb, 0x5e, 0x56, 0x54, 0xc5, 0xd5,
-	0x04, 0x6e, 0xa7, 0xa8, 0xfe, 0x7b, 0x0e, 0xaa, 0xd9, 0xcb, 0x82, 0xf6, 0xa1, 0x22, 0x26, 0x11,
-	0xf3, 0x38, 0x0d, 0x8f, 0x88, 0x9d, 0xd6, 0xab, 0xf9, 0xc9, 0x8b, 0xf3, 0x46, 0xd6, 0xf1, 0xf2,
-	0xbc, 0xb1, 0x34, 0x24, 0x41, 0xc4, 0xc3, 0xd8, 0xe6, 0x71, 0x48, 0x1f, 0xe8, 0x19, 0xb7, 0x8e,
-	0xcb, 0x24, 0x60, 0x9d, 0x91, 0x29, 0x74, 0xa5, 0xcf, 0x23, 0xae, 0x15, 0x10, 0x7e, 0x9c, 0x44,
-	0x9e, 0xe8, 0x66, 0x1c, 0xaf, 0xea, 0x66, 0xdc, 0x3a, 0x2e, 0x8f, 0xec, 0x1d, 0xc2, 0x8f, 0xd1,
-	0x7d, 0x50, 0xf9, 0x59, 0x90, 0x1c, 0xb0, 0xd8, 0x6c, 0xbc, 0x38, 0x6f, 0x48, 0xfb, 0xe5, 0x79,
-	0xe3, 0x7a, 0x56, 0x45, 0xa0, 0x3a, 0x96, 0x4e, 0xf4, 0x00, 0xe6, 0x88, 0xe3, 0x58, 0xbe, 0x27,
-	0x4b, 0x5e, 0x6c, 0xde, 0x79, 0x71, 0xde, 0x48, 0x91, 0x97, 0xe7, 0x8d, 0xff, 0x5d, 0x39, 0x96,
-	0xc4, 0x75, 0x3c, 0x4b, 0x1c, 0xa7, 0xe7, 0xe9, 0x7f, 0x2b, 0x30, 0x97, 0x8c, 0xa7, 0xa9, 0x2d,
-	0xfd, 0x39, 0xa8, 0x4f, 0x98, 0xe7, 0xc8, 0xe3, 0x55, 0x37, 0xee, 0xbe, 0x76, 0xb6, 0xa5, 0x3f,
-	0xfd, 0xb3, 0x80, 0x62, 0xb9, 0x02, 0x35, 0xa1, 0x7c, 0x14, 0x7b, 0xc9, 0x50, 0xe6, 0x64, 0x20,
-	0x4f, 0x54, 0x9d, 0x3a, 0x08, 0x36, 0xf7, 0xba, 0xad, 0x7e, 0xa7, 0xd7, 0xb5, 0xfa, 0xc6, 0x43,
-	0x5c, 0x1a, 0x2d, 0xea, 0x93, 0x81, 0xfe, 0x18, 0xe0, 0x52, 0x17, 0x55, 0xa0, 0x18, 0x90, 0x28,
-	0xb2, 0x22, 0xea, 0x39, 0xda, 0x0c, 0xaa, 0x02, 0x48, 0x33, 0xa4, 0x81, 0x7b, 0xa6, 0x29, 0x63,
-	0xf7, 0xa1, 0xcf, 0x8f, 0xb5, 0x1c, 0x5a, 0x80, 0x92, 0x34, 0xd9, 0xc0, 0xf3, 0x43, 0xaa, 0xe5,
-	0xf5, 0x3f, 0x72, 0x90, 0x37, 0x02, 0xf6, 0x86, 0x97, 0x64, 0x94, 0x80, 0xdc, 0x95, 0x41, 0xe3,
-	0x0f, 0x83, 0x98, 0x53, 0x2b, 0xf6, 0x18, 0x8f, 0xd2, 0xd6, 0x2b, 0xa7, 0xe0, 0x9e, 0xc0, 0xd0,
-	0x1a, 0x5c, 0xa7, 0xa7, 0x3c, 0x24, 0x56, 0x96, 0xaa, 0x4a, 0xea, 0x35, 0xe9, 0x6a, 0x4d, 0xf2,
-	0x0d, 0x28, 0xd8, 0x84, 0xd3, 0x81, 0x1f, 0x9e, 0xd5, 0xe6, 0xe4, 0x84, 0x98, 0x96, 0x97, 0xdd,
-	0x80, 0xda, 0xad, 0x94, 0x96, 0xbe, 0x54, 0xe3, 0x65, 0xa8, 0x03, 0x15, 0x39, 0x99, 0x2c, 0x31,
-	0x37, 0x98, 0x37, 0xa8, 0xcd, 0x4b, 0x9d, 0xfa, 0x14, 0x9d, 0xa6, 0xe0, 0xc9, 0x4b, 0x19, 0xa6,
-	0x32, 0xe5, 0xc3, 0x11, 0xc4, 0xbc, 0x01, 0xba, 0x0d, 0xc0, 0xd9, 0x90, 0xfa, 0x31, 0xb7, 0x86,
-	0x62, 0x60, 0x8b, 0xa0, 0x8b, 0x29, 0xb2, 0x1d, 0xe9, 0xff, 0x28, 0x50, 0xcd, 0x0e, 0xab, 0x57,
-	0x6a, 0xab, 0xbc, 0x7b, 0x6d, 0xd1, 0x3d, 0xb8, 0x76, 0xa9, 0x41, 0x87, 0x81, 0xb8, 0xcb, 0x69,
-	0xe6, 0xb5, 0x31, 0x2f, 0xc5, 0xd1, 0x63, 0xa8, 0x86, 0x34, 0x8a, 0x5d, 0x3e, 0x3e, 0x6e, 0xfe,
-	0x1d, 0x8e, 0x5b, 0x49, 0xd6, 0x8e, 0xce, 0x7b, 0x13, 0x0a, 0xe2, 0x6e, 0xcb, 0x52, 0xcb, 0x0b,
-	0x83, 0xe7, 0x49, 0xc0, 0xba, 0x64, 0x48, 0xf5, 0xdf, 0x14, 0x28, 0x4d, 0xac, 0x17, 0xa9, 0x91,
-	0x73, 0x39, 0xb4, 0x48, 0x28, 0x8e, 0x99, 0x17, 0x03, 0x2c, 0x41, 0x8c, 0x70, 0x80, 0xbe, 0x14,
-	0x3d, 0x26, 0xdd, 0x22, 0xe2, 0xf4, 0x92, 0x4c, 0x8b, 0x69, 0xc7, 0xc0, 0xbb, 0x26, 0xb6, 0x44,
-	0x36, 0x70, 0xaa, 0xb8, 0x19, 0x7b, 0xb6, 0xe8, 0x2e, 0x87, 0x1e, 0x11, 0x71, 0xb0, 0x64, 0x00,
-	0xca, 0x7b, 0x8f, 0xcb, 0x29, 0x98, 0xcc, 0xbf, 0x5b, 0x50, 0xa0, 0x9e, 0xed, 0x3b, 0xe2, 0xd8,
-	0x49, 0xbc, 0x63, 0x5b, 0xff, 0x55, 0x81, 0xf2, 0x64, 0x9f, 0xa0, 0xbb, 0x42, 0x91, 0xd3, 0x70,
-	0xc8, 0x3c, 0x16, 0x71, 0x66, 0xa7, 0x3d, 0x9e, 0x05, 0xc5, 0x23, 0xe7, 0xfa, 0x36, 0x71, 0x65,
-	0xc8, 0x05, 0x9c, 0x18, 0x48, 0x87, 0x72, 0x14, 0x1f, 0x46, 0x76, 0xc8, 0x02, 0x91, 0x7d, 0x19,
-	0x4c, 0x01, 0x67, 0x30, 0x11, 0x4c, 0xc4, 0x09, 0xa7, 0x47, 0xb1, 0x2b, 0x83, 0xa9, 0xe0, 0xb1,
-	0x8d, 0x1a, 0x50, 0x3a, 0x26, 0xde, 0x80, 0x79, 0x03, 0xf1, 0x49, 0x53, 0x9b, 0x95, 0xcb, 0x21,
-	0x85, 0x8c, 0x80, 0xad, 0xea, 0x50, 0x34, 0xbf, 0xe9, 0x9b, 0xdd, 0xdd, 0x4e, 0xaf, 0x2b, 0x1e,
-	0x90, 0x6e, 0xaf, 0x6b, 0x26, 0x0f, 0x88, 0x81, 0x5b, 0x8f, 0x3a, 0xfb, 0xa6, 0xa6, 0xac, 0xfe,
-	0xa8, 0x40, 0x79, 0xb2, 0x6b, 0x50, 0x19, 0x0a, 0xed, 0xce, 0xae, 0xd1, 0xdc, 0x32, 0xdb, 0xda,
-	0x0c, 0xd2, 0xa0, 0xfc, 0xd0, 0xec, 0x5b, 0xcd, 0xad, 0x5e, 0xeb, 0x71, 0x77, 0x6f, 0x5b, 0x53,
-	0xd0, 0x22, 0x68, 0x63, 0xc4, 0x6a, 0x1e, 0x58, 0x02, 0xcd, 0xa1, 0x5b, 0x70, 0x63, 0xd7, 0xec,
-	0x5b, 0x5b, 0x46, 0xdf, 0xdc, 0xed, 0x5b, 0x9d, 0xae, 0xb5, 0x6d, 0xf6, 0x8d, 0xb6, 0xd1, 0x37,
-	0xb4, 0x3c, 0xba, 0x01, 0x28, 0xeb, 0x6b, 0xf6, 0xda, 0x07, 0x9a, 0x2a, 0xb4, 0xf7, 0x4d, 0xdc,
-	0xd9, 0xec, 0xb4, 0x0c, 0xb1, 0xbb, 0x36, 0xbb, 0xfa, 0x83, 0x02, 0xa5, 0x89, 0xda, 0xa1, 0x22,
-	0xcc, 0x9a, 0xdb, 0x3b, 0xfd, 0x83, 0x24, 0x10, 0xe9, 0x11, 0x5b, 0x1a, 0xf8, 0xa1, 0xa6, 0xa0,
-	0xeb, 0xb0, 0x90, 0x20, 0x2d, 0xa3, 0xdb, 0xeb, 0x76, 0x5a, 0xc6, 0x96, 0x96, 0x13, 0xd1, 0x25,
-	0x60, 0xbb, 0x23, 0x8f, 0x64, 0xe0, 0x03, 0x2d, 0x8f, 0x1a, 0xf0, 0xde, 0x55, 0xd4, 0xea, 0x61,
-	0xab, 0x87, 0xdb, 0x26, 0x36, 0xdb, 0x9a, 0x2a, 0x52, 0xd2, 0x36, 0x37, 0x8d, 0xbd, 0xad, 0xbe,
-	0x36, 0xd7, 0x6c, 0xfe, 0x72, 0x51, 0x57, 0x9e, 0x5e, 0xd4, 0x95, 0x67, 0x17, 0x75, 0xe5, 0xaf,
-	0x8b, 0xba, 0xf2, 0xd3, 0xf3, 0xfa, 0xcc, 0xb3, 0xe7, 0xf5, 0x99, 0x3f, 0x9f, 0xd7, 0x67, 0xbe,
-	0xbd, 0x3b, 0x60, 0xfc, 0x38, 0x3e, 0x5c, 0xb3, 0xfd, 0xe1, 0x7a, 0xe6, 0x3b, 0xfc, 0x34, 0xf9,
-	0x12, 0x17, 0x4f, 0x44, 0x74, 0x38, 0x27, 0x3f, 0xac, 0xef, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0xd6, 0x1c, 0x3e, 0x83, 0xab, 0x0b, 0x00, 0x00,
+	// 1433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0xd9, 0x96, 0x9e, 0xfe, 0x98, 0x99, 0x78, 0xbd, 0x4a, 0xd6, 0x91, 0xbc, 0x4c,
+	0x76, 0xd7, 0x70, 0xb0, 0x36, 0xd6, 0xd9, 0x02, 0x45, 0x50, 0xa0, 0xa0, 0x24, 0x3a, 0x51, 0x62,
+	0x4b, 0xc6, 0x88, 0x76, 0xeb, 0x5e, 0x88, 0x31, 0x35, 0x96, 0x07, 0xa1, 0x48, 0x96, 0x1c, 0x1a,
+	0x76, 0x3f, 0x42, 0x7b, 0xe9, 0xb1, 0x9f, 0xa0, 0x28, 0x50, 0xa0, 0x40, 0xbf, 0x45, 0x8e, 0x39,
+	0xf6, 0x64, 0x14, 0xce, 0xa1, 0x68, 0x8e, 0xb9, 0x17, 0x28, 0x66, 0x48, 0xfd, 0xa1, 0xa3, 0xa4,
+	0xc9, 0x49, 0x7a, 0xbf, 0xf7, 0x9b, 0xdf, 0xbc, 0x79, 0xef, 0xcd, 0x1b, 0xc2, 0xbf, 0x1d, 0x72,
+	0x46, 0x5c, 0xca, 0xb7, 0xc4, 0xef, 0x56, 0xe8, 0x53, 0x7b, 0x8b, 0xf8, 0xcc, 0xb2, 0x3d, 0xc7,
+	0xa1, 0x36, 0x67, 0x9e, 0xbb, 0xe9, 0x07, 0x1e, 0xf7, 0xd0, 0x8d, 0x84, 0xb7, 0x29, 0x7e, 0x37,
+	0x05, 0xef, 0xf6, 0xf2, 0xc0, 0x1b, 0x78, 0xd2, 0xbb, 0x25, 0xfe, 0xc5, 0x44, 0xed, 0x8f, 0x2c,
+	0x94, 0x75, 0x9f, 0x35, 0xc7, 0x02, 0xa8, 0x0a, 0x8b, 0xd4, 0x25, 0xc7, 0x0e, 0xed, 0x57, 0x95,
+	0x35, 0x65, 0x3d, 0x8f, 0x47, 0x26, 0xda, 0x87, 0xa5, 0xc9, 0x46, 0x56, 0x9f, 0x70, 0x52, 0xcd,
+	0xac, 0x29, 0xeb, 0xc5, 0xed, 0x7f, 0x6e, 0xbe, 0xb1, 0xdd, 0xe6, 0x44, 0xb1, 0x45, 0x38, 0x69,
+	0xe4, 0x9e, 0x5f, 0xd6, 0xe7, 0x70, 0xc5, 0x4e, 0xa1, 0x68, 0x03, 0x72, 0xc4, 0x67, 0x61, 0x35,
+	0xbb, 0x96, 0x5d, 0x2f, 0x6e, 0xaf, 0xcc, 0x90, 0xd1, 0x7d, 0x86, 0x25, 0x07, 0x3d, 0x80, 0xc5,
+	0x53, 0x4a, 0xfa, 0x34, 0x08, 0xab, 0x39, 0x49, 0xbf, 0x35, 0x83, 0xfe, 0x58, 0x32, 0xf0, 0x88,
+	0x89, 0x76, 0x41, 0x65, 0xee, 0x29, 0x0d, 0x18, 0x27, 0xae, 0x4d, 0x2d, 0xb9, 0xd9, 0xfc, 0x5a,
+	0xf6, 0xbd, 0x62, 0xc6, 0x4b, 0x53, 0x4b, 0x75, 0x11, 0xc2, 0x2e, 0xa8, 0x3e, 0x09, 0x42, 0x6a,
+	0xf5, 0x59, 0x20, 0x78, 0x67, 0x34, 0xac, 0x2e, 0xbc, 0x55, 0x6d, 0x5f, 0x50, 0x5b, 0x23, 0x26,
+	0x5e, 0xf2, 0x53, 0x76, 0x88, 0x3e, 0x01, 0xa0, 0xe7, 0x9c, 0xba, 0x21, 0xf3, 0xdc, 0xb0, 0xba,
+	0x28, 0x75, 0x56, 0x67, 0xe8, 0x18, 0x23, 0x12, 0x9e, 0xe2, 0x23, 0x03, 0xca, 0x67, 0x34, 0x60,
+	0x27, 0xcc, 0x26, 0x5c, 0x0a, 0xe4, 0xa5, 0x40, 0x7d, 0x86, 0xc0, 0xe1, 0x14, 0x0f, 0xa7, 0x57,
+	0x69, 0x5f, 0x42, 0x61, 0xac, 0x8f, 0x10, 0xe4, 0x5c, 0x32, 0xa4, 0xb2, 0xee, 0x05, 0x2c, 0xff,
+	0xa3, 0xbb, 0x50, 0xb6, 0x23, 0x6b, 0x18, 0x39, 0x9c, 0xf9, 0x0e, 0xa3, 0x81, 0x2c, 0x79, 0x06,
+	0x97, 0xec, 0x68, 0x6f, 0x8c, 0xa1, 0xfb, 0x90, 0x0b, 0x22, 0x87, 0x56, 0xb3, 0xb2, 0x1d, 0xfe,
+	0x3e, 0x23, 0x06, 0x1c, 0x39, 0x14, 0x4b, 0x92, 0xb6, 0x0a, 0x39, 0x61, 0xa1, 0x65, 0x98, 0x3f,
+	0x76, 0x3c, 0xfb, 0x99, 0xdc, 0x2e, 0x87, 0x63, 0x43, 0xfb, 0x3e, 0x03, 0xa5, 0xe9, 0x80, 0x67,
+	0x06, 0xf5, 0x04, 0x96, 0xae, 0x15, 0xe2, 0x1d, 0x9d, 0x78, 0xad, 0x0e, 0x95, 0x74, 0x1d, 0xd0,
+	0x47, 0xb0, 0x70, 0x46, 0x9c, 0x88, 0x8e, 0xba, 0xf0, 0xce, 0xdb, 0x24, 0x0e, 0x05, 0x0b, 0x27,
+	0x64, 0xb4, 0x0f, 0xf9, 0x90, 0x8a, 0x5c, 0xf2, 0x8b, 0x6a, 0x6e, 0x4d, 0x59, 0xaf, 0x6c, 0xff,
+	0xff, 0x2f, 0x52, 0x9f, 0x32, 0x7a, 0xc9, 0x5a, 0x3c, 0x56, 0xd1, 0xfe, 0x0b, 0xcb, 0xb3, 0x18,
+	0x28, 0x0f, 0xb9, 0x1d, 0xc2, 0x1c, 0x75, 0x0e, 0x15, 0x61, 0xf1, 0x33, 0x12, 0xb8, 0xcc, 0x1d,
+	0xa8, 0x8a, 0xf6, 0x15, 0xc0, 0x24, 0x2c, 0xb4, 0x0a, 0x85, 0x71, 0x73, 0x24, 0xa9, 0x9a, 0x00,
+	0xe8, 0x5f, 0x50, 0xa1, 0xe7, 0x3e, 0xb5, 0x39, 0xed, 0x5b, 0x32, 0x7e, 0x99, 0xae, 0x02, 0x2e,
+	0x8f, 0xd0, 0x58, 0xe4, 0x3f, 0xb0, 0xe4, 0x10, 0x4e, 0x43, 0x6e, 0xf5, 0x59, 0x28, 0xdb, 0x5e,
+	0x56, 0x34, 0x87, 0x2b, 0x31, 0xdc, 0x4a, 0x50, 0xed, 0xe7, 0x0c, 0x54, 0xd2, 0x97, 0x05, 0x1d,
+	0x42, 0x59, 0x4c, 0x22, 0xe6, 0x72, 0x1a, 0x9c, 0x10, 0x3b, 0xa9, 0x57, 0xe3, 0x7f, 0xaf, 0x2e,
+	0xeb, 0x69, 0xc7, 0xeb, 0xcb, 0xfa, 0xea, 0x90, 0xf8, 0x21, 0x0f, 0x22, 0x9b, 0x47, 0x01, 0x7d,
+	0xa8, 0xa5, 0xdc, 0x1a, 0x2e, 0x11, 0x9f, 0xb5, 0x47, 0xa6, 0xd0, 0x95, 0x3e, 0x97, 0x38, 0x96,
+	0x4f, 0xf8, 0x69, 0x35, 0x33, 0xd1, 0x4d, 0x39, 0xde, 0xd4, 0x4d, 0xb9, 0x35, 0x5c, 0x1a, 0xd9,
+	0xfb, 0x84, 0x9f, 0xa2, 0x07, 0x90, 0xe3, 0x17, 0x7e, 0x7c, 0xc0, 0x42, 0xa3, 0xfe, 0xea, 0xb2,
+	0x2e, 0xed, 0xd7, 0x97, 0xf5, 0x9b, 0x69, 0x15, 0x81, 0x6a, 0x58, 0x3a, 0xd1, 0x43, 0x58, 0x20,
+	0xfd, 0xbe, 0xe5, 0xb9, 0xb2, 0xe4, 0x85, 0xc6, 0xdd, 0x57, 0x97, 0xf5, 0x04, 0x79, 0x7d, 0x59,
+	0xff, 0xdb, 0xb5, 0x63, 0x49, 0x5c, 0xc3, 0xf3, 0xa4, 0xdf, 0xef, 0xba, 0xda, 0x6f, 0x0a, 0x2c,
+	0xc4, 0xe3, 0x69, 0x66, 0x4b, 0x7f, 0x0c, 0xb9, 0x67, 0xcc, 0xed, 0xcb, 0xe3, 0x55, 0xb6, 0xef,
+	0xbd, 0x75, 0xb6, 0x25, 0x3f, 0xe6, 0x85, 0x4f, 0xb1, 0x5c, 0x81, 0x1a, 0x50, 0x3a, 0x89, 0xdc,
+	0x78, 0x28, 0x73, 0x32, 0x90, 0x27, 0xaa, 0xcc, 0x1c, 0x04, 0x3b, 0x07, 0x9d, 0xa6, 0xd9, 0xee,
+	0x76, 0x2c, 0x53, 0x7f, 0x84, 0x8b, 0xa3, 0x45, 0x26, 0x19, 0x68, 0x4f, 0x01, 0x26, 0xba, 0xa8,
+	0x0c, 0x05, 0x9f, 0x84, 0xa1, 0x15, 0x52, 0xb7, 0xaf, 0xce, 0xa1, 0x0a, 0x80, 0x34, 0x03, 0xea,
+	0x3b, 0x17, 0xaa, 0x32, 0x76, 0x1f, 0x7b, 0xfc, 0x54, 0xcd, 0xa0, 0x25, 0x28, 0x4a, 0x93, 0x0d,
+	0x5c, 0x2f, 0xa0, 0x6a, 0x56, 0xfb, 0x26, 0x0b, 0x59, 0xdd, 0x67, 0xef, 0x78, 0x49, 0x46, 0x09,
+	0xc8, 0x5c, 0x1b, 0x34, 0xde, 0xd0, 0x8f, 0x38, 0xb5, 0x22, 0x97, 0xf1, 0x30, 0x69, 0xbd, 0x52,
+	0x02, 0x1e, 0x08, 0x0c, 0x6d, 0xc2, 0x4d, 0x7a, 0xce, 0x03, 0x62, 0xa5, 0xa9, 0x39, 0x49, 0xbd,
+	0x21, 0x5d, 0xcd, 0x69, 0xbe, 0x0e, 0x79, 0x9b, 0x70, 0x3a, 0xf0, 0x82, 0x8b, 0xea, 0x82, 0x9c,
+	0x10, 0xb3, 0xf2, 0xd2, 0xf3, 0xa9, 0xdd, 0x4c, 0x68, 0xc9, 0x4b, 0x35, 0x5e, 0x86, 0xda, 0x50,
+	0x96, 0x93, 0xc9, 0x12, 0x73, 0x83, 0xb9, 0x83, 0xea, 0xa2, 0xd4, 0xa9, 0xcd, 0xd0, 0x69, 0x08,
+	0x9e, 0xbc, 0x94, 0x41, 0x22, 0x53, 0x3a, 0x1e, 0x41, 0xcc, 0x1d, 0xa0, 0x3b, 0x00, 0x9c, 0x0d,
+	0xa9, 0x17, 0x71, 0x6b, 0x28, 0x06, 0xb6, 0x08, 0xba, 0x90, 0x20, 0x7b, 0x21, 0x32, 0x61, 0x85,
+	0x92, 0xc0, 0x61, 0xe2, 0x02, 0xa6, 0xb7, 0x2c, 0xbc, 0xcf, 0x96, 0x78, 0x79, 0xb4, 0xba, 0x31,
+	0xb5, 0xa9, 0xf6, 0xbb, 0x02, 0x95, 0xf4, 0x08, 0x7c, 0xa3, 0x63, 0x94, 0x0f, 0xef, 0x18, 0x74,
+	0x1f, 0x6e, 0x4c, 0x34, 0xe8, 0xd0, 0x17, 0x13, 0x22, 0xa9, 0xa7, 0x3a, 0xe6, 0x25, 0x38, 0x7a,
+	0x0a, 0x95, 0x80, 0x86, 0x91, 0xc3, 0xc7, 0x27, 0xca, 0x7e, 0x40, 0x12, 0xcb, 0xf1, 0xda, 0x51,
+	0x16, 0x6f, 0x41, 0x5e, 0x4c, 0x0c, 0xd9, 0x40, 0xf2, 0x1a, 0xe2, 0x45, 0xe2, 0xb3, 0x0e, 0x19,
+	0x52, 0xed, 0x27, 0x05, 0x8a, 0x53, 0xeb, 0x45, 0xc2, 0x7d, 0xf9, 0xcf, 0x22, 0x81, 0x38, 0x66,
+	0x56, 0x8c, 0xc5, 0x18, 0xd1, 0x83, 0x01, 0xfa, 0x14, 0x8a, 0xb1, 0x61, 0x89, 0x88, 0x93, 0xab,
+	0x37, 0x2b, 0xa6, 0x7d, 0x1d, 0xf7, 0x0c, 0x6c, 0x89, 0x6c, 0xe0, 0x44, 0x71, 0x27, 0x72, 0x6d,
+	0xd1, 0xb3, 0x7d, 0x7a, 0x42, 0xc4, 0xc1, 0xe2, 0xb1, 0x2a, 0xa7, 0x09, 0x2e, 0x25, 0x60, 0x3c,
+	0x55, 0x6f, 0x43, 0x9e, 0xba, 0xb6, 0xd7, 0x17, 0xc7, 0x8e, 0xe3, 0x1d, 0xdb, 0xda, 0x8f, 0x0a,
+	0x94, 0xa6, 0xbb, 0x0f, 0xdd, 0x13, 0x8a, 0x9c, 0x06, 0x43, 0xe6, 0xb2, 0x90, 0x33, 0x3b, 0xb9,
+	0x39, 0x69, 0x50, 0x3c, 0x9d, 0x8e, 0x67, 0x13, 0x47, 0x86, 0x9c, 0xc7, 0xb1, 0x81, 0x34, 0x28,
+	0x85, 0xd1, 0x71, 0x68, 0x07, 0xcc, 0x17, 0xd9, 0x97, 0xc1, 0xe4, 0x71, 0x0a, 0x13, 0xc1, 0x84,
+	0x9c, 0x70, 0x7a, 0x12, 0x39, 0x32, 0x98, 0x32, 0x1e, 0xdb, 0xa8, 0x0e, 0xc5, 0x53, 0xe2, 0x0e,
+	0x98, 0x3b, 0x10, 0x1f, 0x4a, 0xd5, 0x79, 0xb9, 0x1c, 0x12, 0x48, 0xf7, 0xd9, 0x86, 0x06, 0x05,
+	0xe3, 0x73, 0xd3, 0xe8, 0xf4, 0xda, 0xdd, 0x8e, 0x78, 0x96, 0x3a, 0xdd, 0x8e, 0x11, 0x3f, 0x4b,
+	0x3a, 0x6e, 0x3e, 0x6e, 0x1f, 0x1a, 0xaa, 0xb2, 0xf1, 0xb5, 0x02, 0xa5, 0xe9, 0xae, 0x41, 0x25,
+	0xc8, 0xb7, 0xda, 0x3d, 0xbd, 0xb1, 0x6b, 0xb4, 0xd4, 0x39, 0xa4, 0x42, 0xe9, 0x91, 0x61, 0x5a,
+	0x8d, 0xdd, 0x6e, 0xf3, 0x69, 0xe7, 0x60, 0x4f, 0x55, 0xd0, 0x32, 0xa8, 0x63, 0xc4, 0x6a, 0x1c,
+	0x59, 0x02, 0xcd, 0xa0, 0xdb, 0xb0, 0xd2, 0x33, 0x4c, 0x6b, 0x57, 0x37, 0x8d, 0x9e, 0x69, 0xb5,
+	0x3b, 0xd6, 0x9e, 0x61, 0xea, 0x2d, 0xdd, 0xd4, 0xd5, 0x2c, 0x5a, 0x01, 0x94, 0xf6, 0x35, 0xba,
+	0xad, 0x23, 0x35, 0x27, 0xb4, 0x0f, 0x0d, 0xdc, 0xde, 0x69, 0x37, 0x75, 0xb1, 0xbb, 0x3a, 0xbf,
+	0xf1, 0x9d, 0x02, 0xc5, 0xa9, 0xda, 0xa1, 0x02, 0xcc, 0x1b, 0x7b, 0xfb, 0xe6, 0x51, 0x1c, 0x88,
+	0xf4, 0x88, 0x2d, 0x75, 0xfc, 0x48, 0x55, 0xd0, 0x4d, 0x58, 0x8a, 0x91, 0xa6, 0xde, 0xe9, 0x76,
+	0xda, 0x4d, 0x7d, 0x57, 0xcd, 0x88, 0xe8, 0x62, 0xb0, 0xd5, 0x96, 0x47, 0xd2, 0xf1, 0x91, 0x9a,
+	0x45, 0x75, 0xf8, 0xc7, 0x75, 0xd4, 0xea, 0x62, 0xab, 0x8b, 0x5b, 0x06, 0x36, 0x5a, 0x6a, 0x4e,
+	0xa4, 0xa4, 0x65, 0xec, 0xe8, 0x07, 0xbb, 0xa6, 0xba, 0x30, 0x11, 0x7e, 0xd2, 0xeb, 0x76, 0xac,
+	0x7d, 0xdd, 0x7c, 0xac, 0x2e, 0x36, 0x1a, 0x3f, 0x5c, 0xd5, 0x94, 0xe7, 0x57, 0x35, 0xe5, 0xc5,
+	0x55, 0x4d, 0xf9, 0xf5, 0xaa, 0xa6, 0x7c, 0xfb, 0xb2, 0x36, 0xf7, 0xe2, 0x65, 0x6d, 0xee, 0x97,
+	0x97, 0xb5, 0xb9, 0x2f, 0xee, 0x0d, 0x18, 0x3f, 0x8d, 0x8e, 0x37, 0x6d, 0x6f, 0xb8, 0x95, 0xfa,
+	0xe4, 0x3f, 0x8f, 0x3f, 0xfa, 0xc5, 0x6b, 0x14, 0x1e, 0x2f, 0xc8, 0x6f, 0xf8, 0x07, 0x7f, 0x0e,
+	0x00, 0xc6, 0xb3, 0x9d, 0x0d, 0x16, 0x0c, 0x00, 0x00,
 }
 
 func (this *ApiCollection) Equal(that interface{}) bool {
@@ -1359,6 +1372,9 @@ func (this *Api) Equal(that interface{}) bool {
 	if this.TimeoutMs != that1.TimeoutMs {
 		return false
 	}
+	if !this.EarliestBlockParsing.Equal(that1.EarliestBlockParsing) {
+		return false
+	}
 	return true
 }
 func (this *ParseDirective) Equal(that interface{}) bool {
@@ -1885,6 +1901,18 @@ func (m *Api) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarliestBlockParsing != nil {
+		{
+			size, err := m.EarliestBlockParsing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApiCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.TimeoutMs != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.TimeoutMs))
 		i--
@@ -2320,6 +2348,10 @@ func (m *Api) Size() (n int) {
 	if m.TimeoutMs != 0 {
 		n += 1 + sovApiCollection(uint64(m.TimeoutMs))
 	}
+	if m.EarliestBlockParsing != nil {
+		l = m.EarliestBlockParsing.Size()
+		n += 1 + l + sovApiCollection(uint64(l))
+	}
 	return n
 }
 
@@ -3712,6 +3744,42 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestBlockParsing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarliestBlockParsing == nil {
+				m.EarliestBlockParsing = &BlockParser{}
+			}
+			if err := m.EarliestBlockParsing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
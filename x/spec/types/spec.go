@@ -26,10 +26,13 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 		APIInterfaceTendermintRPC: {},
 		APIInterfaceRest:          {},
 		APIInterfaceGrpc:          {},
+		APIInterfaceGraphQL:       {},
 	}
 	availavleEncodings := map[string]struct{}{
 		EncodingBase64: {},
 		EncodingHex:    {},
+		EncodingBech32: {},
+		EncodingBase58: {},
 	}
 
 	for _, char := range spec.Name {
@@ -10,6 +10,7 @@ const (
 	APIInterfaceTendermintRPC = "tendermintrpc"
 	APIInterfaceRest          = "rest"
 	APIInterfaceGrpc          = "grpc"
+	APIInterfaceGraphQL       = "graphql"
 )
 
 const (
@@ -22,6 +23,8 @@ const (
 const (
 	EncodingBase64 = "base64"
 	EncodingHex    = "hex"
+	EncodingBech32 = "bech32"
+	EncodingBase58 = "base58"
 )
 
 const (